@@ -0,0 +1,334 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"connectrpc.com/connect"
+	v1 "github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+var campaignCommands = map[string]*command{
+	"create": {usage: "캠페인 생성", args: "[campaign-id]", setup: setupCampaignCreate},
+	"get":    {usage: "캠페인 조회", args: "<campaign-id>", setup: setupCampaignGet},
+	"list":   {usage: "캠페인 목록", setup: setupCampaignList},
+	"update": {usage: "캠페인 쿠폰 수 늘리기", args: "<campaign-id>", setup: setupCampaignUpdate},
+	"delete": {usage: "캠페인 삭제", args: "<campaign-id>", setup: setupCampaignDelete},
+	"pause":  {usage: "캠페인 발급 일시 정지", args: "<campaign-id>", setup: setupCampaignPause},
+	"resume": {usage: "일시 정지한 캠페인 발급 재개", args: "<campaign-id>", setup: setupCampaignResume},
+}
+
+// setupCampaignCreate : 혜택, 발급 조건 등은 -f 로 CreateCampaignReq 형식의 JSON / YAML 파일을 받고, 나머지 옵션이 파일 값을 덮어씀
+//
+//	couponctl campaign create -start 2025-05-01 -end 2025-05-31 -max 1000 spring
+//	couponctl campaign create -f spring.yaml
+func setupCampaignCreate(fs *flag.FlagSet) runFunc {
+	file := fs.String("f", "", "CreateCampaignReq 형식의 JSON / YAML 파일 (benefit, rules, schedule, raffle, tiers)")
+	start := fs.String("start", "", "시작일 yyyy-mm-dd")
+	end := fs.String("end", "", "종료일 yyyy-mm-dd (포함)")
+	maxCoupon := fs.Int64("max", 0, "쿠폰 수")
+
+	return func(ctx context.Context, conn *connection, args []string) error {
+		req := &v1.CreateCampaignReq{}
+		if *file != "" {
+			if err := readMessageFile(*file, req); err != nil {
+				return err
+			}
+		}
+		if len(args) > 0 {
+			req.CampaignId = args[0]
+		}
+		fs.Visit(func(f *flag.Flag) {
+			switch f.Name {
+			case "start":
+				req.StartDate = *start
+			case "end":
+				req.ExpiredDate = *end
+			case "max":
+				req.MaxCoupon = *maxCoupon
+			}
+		})
+		if req.CampaignId == "" || req.StartDate == "" || req.ExpiredDate == "" {
+			return usageError(fs, "campaign id, start and end are required")
+		}
+
+		client, err := conn.campaignClient()
+		if err != nil {
+			return err
+		}
+		res, err := client.CreateCampaign(ctx, connect.NewRequest(req))
+		if err := resultError(res, err); err != nil {
+			return err
+		}
+
+		return printMessage(conn, res.Msg, func(t *table) {
+			t.row("campaign", req.CampaignId, "created")
+		})
+	}
+}
+
+// readMessageFile : 확장자가 .json 이 아니면 YAML 로 읽음 (JSON 도 YAML 이라 그대로 읽힘)
+func readMessageFile(path string, msg proto.Message) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if !strings.HasSuffix(path, ".json") {
+		var v any
+		if err := yaml.Unmarshal(data, &v); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		if data, err = json.Marshal(v); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	if err := protojson.Unmarshal(data, msg); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+func setupCampaignGet(fs *flag.FlagSet) runFunc {
+	codes := fs.Bool("codes", false, "table 출력에 쿠폰 코드 목록 포함")
+
+	return func(ctx context.Context, conn *connection, args []string) error {
+		if len(args) != 1 {
+			return usageError(fs, "campaign id is required")
+		}
+
+		client, err := conn.campaignClient()
+		if err != nil {
+			return err
+		}
+		res, err := client.GetCampaign(ctx, connect.NewRequest(&v1.GetCampaignReq{CampaignId: args[0]}))
+		if err := resultError(res, err); err != nil {
+			return err
+		}
+
+		info := res.Msg.Info
+		return printMessage(conn, res.Msg, func(t *table) {
+			t.row("CAMPAIGN", info.CampaignId)
+			t.row("START", info.StartDate)
+			t.row("EXPIRED", info.ExpiredDate)
+			t.row("COUPONS", info.CouponCount)
+			t.row("BENEFIT", benefitSummary(info.Benefit))
+			for _, rule := range info.Rules {
+				t.row("RULE", ruleSummary(rule))
+			}
+			if info.Schedule != nil {
+				t.row("SCHEDULE", scheduleSummary(info.Schedule))
+			}
+			if info.Raffle != nil {
+				t.row("RAFFLE", fmt.Sprintf("registration %s ~ %s, draw at %s", info.Raffle.RegistrationStart, info.Raffle.RegistrationEnd, info.Raffle.DrawAt))
+			}
+			for _, tier := range info.Tiers {
+				t.row("TIER", fmt.Sprintf("%s: quota %d, reserved %d, start %s", tier.Name, tier.Quota, tier.Reserved, orDash(tier.StartAt)))
+			}
+			t.row("NEXT RELEASE", orDash(info.NextReleaseAt))
+			if info.Paused {
+				t.row("PAUSED", "issuance paused")
+			}
+			if *codes {
+				for _, code := range info.AllCouponIds {
+					t.row("CODE", code)
+				}
+			}
+		})
+	}
+}
+
+func setupCampaignList(fs *flag.FlagSet) runFunc {
+	return func(ctx context.Context, conn *connection, args []string) error {
+		client, err := conn.campaignClient()
+		if err != nil {
+			return err
+		}
+		res, err := client.ListCampaigns(ctx, connect.NewRequest(&v1.ListCampaignsReq{}))
+		if err := resultError(res, err); err != nil {
+			return err
+		}
+
+		return printMessage(conn, res.Msg, func(t *table) {
+			t.header("CAMPAIGN", "START", "EXPIRED", "BENEFIT", "OPTIONS")
+			for _, info := range res.Msg.Campaigns {
+				t.row(info.CampaignId, info.StartDate, info.ExpiredDate, benefitSummary(info.Benefit), optionSummary(info))
+			}
+		})
+	}
+}
+
+func setupCampaignUpdate(fs *flag.FlagSet) runFunc {
+	maxCoupon := fs.Int64("max", 0, "늘릴 쿠폰 수 (지금보다 작을 수 없음)")
+
+	return func(ctx context.Context, conn *connection, args []string) error {
+		if len(args) != 1 || *maxCoupon <= 0 {
+			return usageError(fs, "campaign id and max are required")
+		}
+
+		client, err := conn.campaignClient()
+		if err != nil {
+			return err
+		}
+		res, err := client.UpdateCampaign(ctx, connect.NewRequest(&v1.UpdateCampaignReq{CampaignId: args[0], MaxCoupon: *maxCoupon}))
+		if err := resultError(res, err); err != nil {
+			return err
+		}
+
+		return printMessage(conn, res.Msg, func(t *table) {
+			t.row("campaign", args[0], "maxCoupon", *maxCoupon)
+		})
+	}
+}
+
+func setupCampaignDelete(fs *flag.FlagSet) runFunc {
+	force := fs.Bool("force", false, "사용자가 가진 쿠폰이 있어도 삭제")
+
+	return func(ctx context.Context, conn *connection, args []string) error {
+		if len(args) != 1 {
+			return usageError(fs, "campaign id is required")
+		}
+
+		client, err := conn.campaignClient()
+		if err != nil {
+			return err
+		}
+		res, err := client.DeleteCampaign(ctx, connect.NewRequest(&v1.DeleteCampaignReq{CampaignId: args[0], Force: *force}))
+		if err := resultError(res, err); err != nil {
+			return err
+		}
+
+		return printMessage(conn, res.Msg, func(t *table) {
+			t.row("campaign", args[0], "deleted")
+		})
+	}
+}
+
+// setupCampaignPause : 발급, 대기자 자동 발급, 추첨을 멈춤 (이미 발급된 쿠폰 사용은 그대로 가능)
+func setupCampaignPause(fs *flag.FlagSet) runFunc {
+	reason := fs.String("reason", "", "감사 이력에 남길 사유")
+
+	return func(ctx context.Context, conn *connection, args []string) error {
+		if len(args) != 1 {
+			return usageError(fs, "campaign id is required")
+		}
+
+		client, err := conn.campaignClient()
+		if err != nil {
+			return err
+		}
+		res, err := client.PauseCampaign(ctx, connect.NewRequest(&v1.PauseCampaignReq{CampaignId: args[0], Reason: *reason}))
+		if err := resultError(res, err); err != nil {
+			return err
+		}
+
+		return printMessage(conn, res.Msg, func(t *table) {
+			t.row("campaign", args[0], "paused")
+		})
+	}
+}
+
+func setupCampaignResume(fs *flag.FlagSet) runFunc {
+	return func(ctx context.Context, conn *connection, args []string) error {
+		if len(args) != 1 {
+			return usageError(fs, "campaign id is required")
+		}
+
+		client, err := conn.campaignClient()
+		if err != nil {
+			return err
+		}
+		res, err := client.ResumeCampaign(ctx, connect.NewRequest(&v1.ResumeCampaignReq{CampaignId: args[0]}))
+		if err := resultError(res, err); err != nil {
+			return err
+		}
+
+		return printMessage(conn, res.Msg, func(t *table) {
+			t.row("campaign", args[0], "resumed")
+		})
+	}
+}
+
+// resultMessage : BaseResponse 를 가진 응답
+type resultMessage interface {
+	GetResult() *v1.BaseResponse
+}
+
+// resultError : RPC 에러가 없어도 Result.Success 가 false 면 Message 를 에러로 반환
+func resultError[T any](res *connect.Response[T], err error) error {
+	if err != nil {
+		return err
+	}
+	if msg, ok := any(res.Msg).(resultMessage); ok {
+		if result := msg.GetResult(); result != nil && !result.Success {
+			return errors.New(result.Message)
+		}
+	}
+	return nil
+}
+
+func benefitSummary(b *v1.Benefit) string {
+	if b == nil {
+		return "-"
+	}
+	switch b.Type {
+	case "percent":
+		return fmt.Sprintf("%d%% off", b.PercentOff)
+	case "fixed":
+		return fmt.Sprintf("%d %s off", b.AmountOff, b.Currency)
+	case "bxgy":
+		return fmt.Sprintf("buy %d get %d", b.BuyQuantity, b.GetQuantity)
+	}
+	return b.Type
+}
+
+func ruleSummary(r *v1.Rule) string {
+	return strings.TrimSpace(fmt.Sprintf("%s %s %s", r.Attribute, r.Op, strings.Join(r.Values, ",")))
+}
+
+func scheduleSummary(s *v1.Schedule) string {
+	var parts []string
+	if len(s.Waves) > 0 {
+		parts = append(parts, fmt.Sprintf("%d waves", len(s.Waves)))
+	}
+	for _, w := range s.Windows {
+		parts = append(parts, fmt.Sprintf("%s~%s", w.Start, w.End))
+	}
+	if s.RateLimit > 0 {
+		parts = append(parts, fmt.Sprintf("%d per %ds", s.RateLimit, s.RatePeriodSeconds))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func optionSummary(info *v1.CampaignInfo) string {
+	var parts []string
+	if len(info.Rules) > 0 {
+		parts = append(parts, fmt.Sprintf("rules(%d)", len(info.Rules)))
+	}
+	if info.Schedule != nil {
+		parts = append(parts, "schedule")
+	}
+	if info.Raffle != nil {
+		parts = append(parts, "raffle")
+	}
+	if len(info.Tiers) > 0 {
+		parts = append(parts, fmt.Sprintf("tiers(%d)", len(info.Tiers)))
+	}
+	return orDash(strings.Join(parts, ","))
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1/v1connect"
+	"golang.org/x/net/http2"
+)
+
+// connection : 서버 주소와 인증 정보
+// 우선순위 : 명령행 옵션 > 환경변수 > config profile > 기본값
+type connection struct {
+	server      string
+	apiKey      string
+	token       string
+	tenant      string
+	tlsCA       string
+	tlsInsecure bool
+	output      string
+	profile     string
+	configPath  string
+}
+
+func bindConnectionFlags(fs *flag.FlagSet) *connection {
+	c := &connection{}
+	fs.StringVar(&c.server, "server", "", "서버 주소 (COUPONCTL_SERVER, 기본값: "+defaultServer+")")
+	fs.StringVar(&c.apiKey, "api-key", "", "X-Api-Key 헤더로 보낼 admin API key (COUPONCTL_API_KEY)")
+	fs.StringVar(&c.token, "token", "", "Authorization: Bearer 헤더로 보낼 JWT (COUPONCTL_TOKEN)")
+	fs.StringVar(&c.tenant, "tenant", "", "X-Tenant-Id 헤더로 보낼 tenant (COUPONCTL_TENANT)")
+	fs.StringVar(&c.tlsCA, "tls-ca", "", "https 서버 인증서 검증용 CA 파일 (기본값: 시스템 CA)")
+	fs.BoolVar(&c.tlsInsecure, "tls-insecure", false, "https 서버 인증서 검증 생략 (로컬 self-signed 인증서용)")
+	fs.StringVar(&c.output, "output", "", "출력 형식 : table, json, yaml (COUPONCTL_OUTPUT, 기본값: table)")
+	fs.StringVar(&c.profile, "profile", "", "사용할 config profile (COUPONCTL_PROFILE, 기본값: config 의 current)")
+	fs.StringVar(&c.configPath, "config", "", "config 파일 (COUPONCTL_CONFIG, 기본값: "+defaultConfigPath()+")")
+	return c
+}
+
+const defaultServer = "http://localhost:50051"
+
+// resolve : 명령행에서 지정하지 않은 값을 환경변수, profile 순서로 채움
+func (c *connection) resolve(fs *flag.FlagSet) error {
+	set := map[string]bool{}
+	fs.Visit(func(f *flag.Flag) { set[f.Name] = true })
+
+	fill := func(dst *string, name, env, fromProfile, fallback string) {
+		switch {
+		case set[name]:
+		case os.Getenv(env) != "":
+			*dst = os.Getenv(env)
+		case fromProfile != "":
+			*dst = fromProfile
+		default:
+			*dst = fallback
+		}
+	}
+
+	fill(&c.configPath, "config", "COUPONCTL_CONFIG", "", defaultConfigPath())
+	cfg, err := loadConfig(c.configPath)
+	if err != nil {
+		return err
+	}
+	fill(&c.profile, "profile", "COUPONCTL_PROFILE", cfg.Current, "")
+
+	p := &profile{}
+	if c.profile != "" {
+		var ok bool
+		if p, ok = cfg.Profiles[c.profile]; !ok {
+			return fmt.Errorf("profile %q not found in %s", c.profile, c.configPath)
+		}
+	}
+
+	fill(&c.server, "server", "COUPONCTL_SERVER", p.Server, defaultServer)
+	fill(&c.apiKey, "api-key", "COUPONCTL_API_KEY", p.APIKey, "")
+	fill(&c.token, "token", "COUPONCTL_TOKEN", p.Token, "")
+	fill(&c.tenant, "tenant", "COUPONCTL_TENANT", p.Tenant, "")
+	fill(&c.tlsCA, "tls-ca", "", p.TLSCA, "")
+	fill(&c.output, "output", "COUPONCTL_OUTPUT", p.Output, outputTable)
+	if !set["tls-insecure"] {
+		c.tlsInsecure = p.TLSInsecure
+	}
+
+	return validateOutput(c.output)
+}
+
+// httpClient : client streaming RPC 는 HTTP/2 가 필요해서 http 주소도 h2c 로 연결함
+func (c *connection) httpClient() (*http.Client, error) {
+	if !strings.HasPrefix(c.server, "https://") {
+		return &http.Client{Transport: &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, network, addr)
+			},
+		}}, nil
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12, InsecureSkipVerify: c.tlsInsecure}
+	if c.tlsCA != "" {
+		pem, err := os.ReadFile(c.tlsCA)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("%s: no certificate found", c.tlsCA)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	transport.ForceAttemptHTTP2 = true
+	return &http.Client{Transport: transport}, nil
+}
+
+// headers : 인증/tenant 헤더를 unary, streaming 요청 모두에 붙임
+func (c *connection) headers() connect.Interceptor {
+	return &headerInterceptor{conn: c}
+}
+
+type headerInterceptor struct {
+	conn *connection
+}
+
+func (i *headerInterceptor) set(h http.Header) {
+	if i.conn.apiKey != "" {
+		h.Set("X-Api-Key", i.conn.apiKey)
+	}
+	if i.conn.token != "" {
+		h.Set("Authorization", "Bearer "+i.conn.token)
+	}
+	if i.conn.tenant != "" {
+		h.Set("X-Tenant-Id", i.conn.tenant)
+	}
+}
+
+func (i *headerInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		i.set(req.Header())
+		return next(ctx, req)
+	}
+}
+
+func (i *headerInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		i.set(conn.RequestHeader())
+		return conn
+	}
+}
+
+func (i *headerInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+func (c *connection) campaignClient() (v1connect.CampaignServiceClient, error) {
+	client, err := c.httpClient()
+	if err != nil {
+		return nil, err
+	}
+	return v1connect.NewCampaignServiceClient(client, c.server, connect.WithInterceptors(c.headers())), nil
+}
+
+func (c *connection) couponClient() (v1connect.CouponServiceClient, error) {
+	client, err := c.httpClient()
+	if err != nil {
+		return nil, err
+	}
+	return v1connect.NewCouponServiceClient(client, c.server, connect.WithInterceptors(c.headers())), nil
+}
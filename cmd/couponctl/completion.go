@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// completeCommand : shell completion script 가 호출하는 숨은 명령, 입력중인 단어들을 받아서 후보를 한 줄에 하나씩 출력
+const completeCommand = "__complete"
+
+var completionScripts = map[string]string{
+	// source <(couponctl completion bash)
+	"bash": `_couponctl() {
+    local cur="${COMP_WORDS[COMP_CWORD]}"
+    local IFS=$'\n'
+    COMPREPLY=($(compgen -W "$("${COMP_WORDS[0]}" __complete "${COMP_WORDS[@]:1:COMP_CWORD}" 2>/dev/null)" -- "$cur"))
+}
+complete -o default -F _couponctl couponctl
+`,
+	// source <(couponctl completion zsh), compinit 이후에 불러야 함
+	"zsh": `#compdef couponctl
+_couponctl() {
+    local -a candidates
+    candidates=(${(f)"$(${words[1]} __complete "${(@)words[2,CURRENT]}" 2>/dev/null)"})
+    if (( ${#candidates} )); then
+        compadd -a candidates
+    else
+        _files
+    fi
+}
+compdef _couponctl couponctl
+`,
+	// couponctl completion fish | source
+	"fish": `complete -c couponctl -f -a '(couponctl __complete (commandline -opc)[2..-1] (commandline -ct))'
+`,
+}
+
+func setupCompletion(fs *flag.FlagSet) runFunc {
+	return func(ctx context.Context, conn *connection, args []string) error {
+		if len(args) != 1 {
+			return usageError(fs, "shell is required")
+		}
+		script, ok := completionScripts[args[0]]
+		if !ok {
+			return fmt.Errorf("unsupported shell %q (bash, zsh, fish)", args[0])
+		}
+		_, err := io.WriteString(os.Stdout, script)
+		return err
+	}
+}
+
+// complete : words 의 마지막은 입력중인 단어 (비어있을 수 있음)
+func complete(words []string) {
+	if len(words) == 0 {
+		words = []string{""}
+	}
+	typed, current := words[:len(words)-1], words[len(words)-1]
+
+	cmd := root
+	fs := flag.NewFlagSet("couponctl", flag.ContinueOnError)
+	bindConnectionFlags(fs)
+	valueOf := "" // 값을 받는 옵션 바로 다음이면 그 옵션 이름
+
+	for _, word := range typed {
+		if valueOf != "" {
+			valueOf = ""
+			continue
+		}
+		if strings.HasPrefix(word, "-") {
+			name := strings.TrimLeft(word, "-")
+			if !strings.Contains(name, "=") && takesValue(fs, name) {
+				valueOf = name
+			}
+			continue
+		}
+		if sub, ok := cmd.commands[word]; ok {
+			cmd = sub
+			if cmd.setup != nil {
+				fs = flag.NewFlagSet(word, flag.ContinueOnError)
+				cmd.setup(fs)
+			}
+		}
+	}
+
+	var candidates []string
+	switch {
+	case valueOf != "":
+		candidates = flagValues(valueOf)
+	case strings.HasPrefix(current, "-"):
+		fs.VisitAll(func(f *flag.Flag) {
+			candidates = append(candidates, "-"+f.Name)
+		})
+	case cmd.commands != nil:
+		candidates = commandNames(cmd)
+	case cmd == root.commands["completion"]:
+		candidates = []string{"bash", "fish", "zsh"}
+	case cmd == configCommands["use"] || cmd == configCommands["delete"] || cmd == configCommands["set"]:
+		candidates = profileNames()
+	}
+
+	for _, c := range candidates {
+		if strings.HasPrefix(c, current) {
+			fmt.Println(c)
+		}
+	}
+}
+
+func takesValue(fs *flag.FlagSet, name string) bool {
+	f := fs.Lookup(name)
+	if f == nil {
+		return false
+	}
+	b, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return !ok || !b.IsBoolFlag()
+}
+
+// flagValues : 정해진 값만 받는 옵션의 후보, 파일 등 나머지는 shell 기본 completion 에 맡김
+func flagValues(name string) []string {
+	switch name {
+	case "output":
+		return []string{outputJSON, outputTable, outputYAML}
+	case "profile":
+		return profileNames()
+	case "format":
+		return []string{"csv", "jsonl"}
+	case "time-field":
+		return []string{"issued", "redeemed"}
+	case "state":
+		return []string{"available", "expired", "held", "issued", "redeemed", "revoked"}
+	}
+	return nil
+}
+
+func profileNames() []string {
+	path := os.Getenv("COUPONCTL_CONFIG")
+	if path == "" {
+		path = defaultConfigPath()
+	}
+	cfg, err := loadConfig(path)
+	if err != nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(cfg.Profiles))
+	for name := range cfg.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,262 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// profile : 서버 하나에 대한 연결 정보, 비어있는 값은 기본값을 사용
+type profile struct {
+	Server      string `yaml:"server,omitempty" json:"server,omitempty"`
+	APIKey      string `yaml:"apiKey,omitempty" json:"apiKey,omitempty"`
+	Token       string `yaml:"token,omitempty" json:"token,omitempty"`
+	Tenant      string `yaml:"tenant,omitempty" json:"tenant,omitempty"`
+	TLSCA       string `yaml:"tlsCA,omitempty" json:"tlsCA,omitempty"`
+	TLSInsecure bool   `yaml:"tlsInsecure,omitempty" json:"tlsInsecure,omitempty"`
+	Output      string `yaml:"output,omitempty" json:"output,omitempty"`
+}
+
+// configFile : API key, 토큰이 들어있어서 0600 으로 저장함
+//
+//	current: prod
+//	profiles:
+//	  local:
+//	    server: http://localhost:50051
+//	  prod:
+//	    server: https://coupon.example.com
+//	    apiKey: ...
+type configFile struct {
+	Current  string              `yaml:"current,omitempty" json:"current,omitempty"`
+	Profiles map[string]*profile `yaml:"profiles,omitempty" json:"profiles,omitempty"`
+}
+
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "couponctl.yaml"
+	}
+	return filepath.Join(dir, "couponctl", "config.yaml")
+}
+
+// loadConfig : 파일이 없으면 빈 config
+func loadConfig(path string) (*configFile, error) {
+	cfg := &configFile{Profiles: map[string]*profile{}}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if cfg.Profiles == nil {
+		cfg.Profiles = map[string]*profile{}
+	}
+	return cfg, nil
+}
+
+// save : 임시 파일(0600)에 쓰고 rename 함
+// os.WriteFile 은 이미 있는 파일의 권한을 바꾸지 않아서, 0644 로 만들어진 파일에 토큰이 그대로 남지 않게 함
+func (cfg *configFile) save(path string) error {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(cfg); err != nil {
+		return err
+	}
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, ".config-*.yaml")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(buf.Bytes()); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+var configCommands = map[string]*command{
+	"view":   {usage: "profile 목록 (API key, 토큰은 가려서 보여줌)", setup: setupConfigView},
+	"set":    {usage: "profile 추가 / 변경", args: "<profile>", setup: setupConfigSet},
+	"use":    {usage: "기본으로 사용할 profile 지정", args: "<profile>", setup: setupConfigUse},
+	"delete": {usage: "profile 삭제", args: "<profile>", setup: setupConfigDelete},
+}
+
+func setupConfigView(fs *flag.FlagSet) runFunc {
+	return func(ctx context.Context, conn *connection, args []string) error {
+		cfg, err := loadConfig(conn.configPath)
+		if err != nil {
+			return err
+		}
+
+		masked := &configFile{Current: cfg.Current, Profiles: make(map[string]*profile, len(cfg.Profiles))}
+		for name, p := range cfg.Profiles {
+			m := *p
+			m.APIKey, m.Token = mask(m.APIKey), mask(m.Token)
+			masked.Profiles[name] = &m
+		}
+
+		return printValue(conn, masked, func(t *table) {
+			t.header("CURRENT", "PROFILE", "SERVER", "TENANT", "API KEY", "TOKEN", "OUTPUT")
+			names := make([]string, 0, len(masked.Profiles))
+			for name := range masked.Profiles {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				p, current := masked.Profiles[name], ""
+				if name == masked.Current {
+					current = "*"
+				}
+				t.row(current, name, p.Server, p.Tenant, p.APIKey, p.Token, p.Output)
+			}
+		})
+	}
+}
+
+// mask : 앞 4 글자만 보여줌
+func mask(secret string) string {
+	if len(secret) <= 4 {
+		if secret == "" {
+			return ""
+		}
+		return "****"
+	}
+	return secret[:4] + "****"
+}
+
+func setupConfigSet(fs *flag.FlagSet) runFunc {
+	p := &profile{}
+	fs.StringVar(&p.Server, "server", "", "서버 주소")
+	fs.StringVar(&p.APIKey, "api-key", "", "admin API key")
+	fs.StringVar(&p.Token, "token", "", "JWT")
+	fs.StringVar(&p.Tenant, "tenant", "", "tenant")
+	fs.StringVar(&p.TLSCA, "tls-ca", "", "https 서버 인증서 검증용 CA 파일")
+	fs.BoolVar(&p.TLSInsecure, "tls-insecure", false, "https 서버 인증서 검증 생략")
+	fs.StringVar(&p.Output, "output", "", "출력 형식 : table, json, yaml")
+
+	return func(ctx context.Context, conn *connection, args []string) error {
+		if len(args) != 1 {
+			return usageError(fs, "profile name is required")
+		}
+		if err := validateOutput(p.Output); p.Output != "" && err != nil {
+			return err
+		}
+
+		cfg, err := loadConfig(conn.configPath)
+		if err != nil {
+			return err
+		}
+
+		// 지정한 옵션만 바꿈
+		name := args[0]
+		current, exists := cfg.Profiles[name]
+		if !exists {
+			current = &profile{}
+			cfg.Profiles[name] = current
+		}
+		fs.Visit(func(f *flag.Flag) {
+			switch f.Name {
+			case "server":
+				current.Server = p.Server
+			case "api-key":
+				current.APIKey = p.APIKey
+			case "token":
+				current.Token = p.Token
+			case "tenant":
+				current.Tenant = p.Tenant
+			case "tls-ca":
+				current.TLSCA = p.TLSCA
+			case "tls-insecure":
+				current.TLSInsecure = p.TLSInsecure
+			case "output":
+				current.Output = p.Output
+			}
+		})
+		if cfg.Current == "" {
+			cfg.Current = name
+		}
+
+		if err := cfg.save(conn.configPath); err != nil {
+			return err
+		}
+		fmt.Printf("profile %q saved to %s\n", name, conn.configPath)
+		return nil
+	}
+}
+
+func setupConfigUse(fs *flag.FlagSet) runFunc {
+	return func(ctx context.Context, conn *connection, args []string) error {
+		if len(args) != 1 {
+			return usageError(fs, "profile name is required")
+		}
+
+		cfg, err := loadConfig(conn.configPath)
+		if err != nil {
+			return err
+		}
+		if _, exists := cfg.Profiles[args[0]]; !exists {
+			return fmt.Errorf("profile %q not found", args[0])
+		}
+
+		cfg.Current = args[0]
+		if err := cfg.save(conn.configPath); err != nil {
+			return err
+		}
+		fmt.Printf("using profile %q\n", args[0])
+		return nil
+	}
+}
+
+func setupConfigDelete(fs *flag.FlagSet) runFunc {
+	return func(ctx context.Context, conn *connection, args []string) error {
+		if len(args) != 1 {
+			return usageError(fs, "profile name is required")
+		}
+
+		cfg, err := loadConfig(conn.configPath)
+		if err != nil {
+			return err
+		}
+		if _, exists := cfg.Profiles[args[0]]; !exists {
+			return fmt.Errorf("profile %q not found", args[0])
+		}
+
+		delete(cfg.Profiles, args[0])
+		if cfg.Current == args[0] {
+			cfg.Current = ""
+		}
+		if err := cfg.save(conn.configPath); err != nil {
+			return err
+		}
+		fmt.Printf("profile %q deleted\n", args[0])
+		return nil
+	}
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestConnectionResolve(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	cfg := &configFile{
+		Current: "prod",
+		Profiles: map[string]*profile{
+			"prod":  {Server: "https://prod", APIKey: "prod-key", Tenant: "brand", Output: outputJSON, TLSInsecure: true},
+			"local": {Server: "http://local"},
+		},
+	}
+	if err := cfg.save(path); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		args   []string
+		env    map[string]string
+		want   connection
+		errors bool
+	}{
+		{
+			name: "current profile",
+			want: connection{server: "https://prod", apiKey: "prod-key", tenant: "brand", output: outputJSON, tlsInsecure: true, profile: "prod"},
+		},
+		{
+			name: "env over profile",
+			env:  map[string]string{"COUPONCTL_SERVER": "https://env", "COUPONCTL_TENANT": "other"},
+			want: connection{server: "https://env", apiKey: "prod-key", tenant: "other", output: outputJSON, tlsInsecure: true, profile: "prod"},
+		},
+		{
+			name: "flag over env",
+			args: []string{"-server", "https://flag", "-output", "yaml", "-tls-insecure=false"},
+			env:  map[string]string{"COUPONCTL_SERVER": "https://env"},
+			want: connection{server: "https://flag", apiKey: "prod-key", tenant: "brand", output: outputYAML, profile: "prod"},
+		},
+		{
+			name: "other profile falls back to defaults",
+			env:  map[string]string{"COUPONCTL_PROFILE": "local"},
+			want: connection{server: "http://local", output: outputTable, profile: "local"},
+		},
+		{
+			name:   "unknown profile",
+			args:   []string{"-profile", "staging"},
+			errors: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, env := range []string{"COUPONCTL_SERVER", "COUPONCTL_API_KEY", "COUPONCTL_TOKEN", "COUPONCTL_TENANT", "COUPONCTL_OUTPUT", "COUPONCTL_PROFILE"} {
+				t.Setenv(env, tt.env[env])
+			}
+			t.Setenv("COUPONCTL_CONFIG", path)
+
+			fs := flag.NewFlagSet("couponctl", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+			conn := bindConnectionFlags(fs)
+			if err := fs.Parse(tt.args); err != nil {
+				t.Fatal(err)
+			}
+
+			err := conn.resolve(fs)
+			if tt.errors {
+				if err == nil {
+					t.Fatal("resolve succeeded, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolve: %v", err)
+			}
+
+			tt.want.configPath = path
+			if *conn != tt.want {
+				t.Errorf("connection = %+v, want %+v", *conn, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigSave(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file mode bits are not supported")
+	}
+
+	path := filepath.Join(t.TempDir(), "couponctl", "config.yaml")
+	// 이전 버전이나 사용자가 만든 0644 파일에 저장해도 0600 이 되어야 함
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("current: old\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &configFile{Current: "prod", Profiles: map[string]*profile{"prod": {Server: "https://prod", Token: "secret"}}}
+	if err := cfg.save(path); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := info.Mode().Perm(); mode != 0o600 {
+		t.Errorf("config mode = %o, want 600", mode)
+	}
+	entries, _ := os.ReadDir(filepath.Dir(path))
+	if len(entries) != 1 {
+		t.Errorf("temp file left behind: %v", entries)
+	}
+
+	loaded, err := loadConfig(path)
+	if err != nil {
+		t.Fatalf("loadConfig: %v", err)
+	}
+	if loaded.Current != "prod" || loaded.Profiles["prod"].Token != "secret" {
+		t.Errorf("loaded = %+v", loaded)
+	}
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	v1 "github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1"
+)
+
+var couponCommands = map[string]*command{
+	"issue":  {usage: "쿠폰 발급", setup: setupCouponIssue},
+	"redeem": {usage: "쿠폰 사용 처리", setup: setupCouponRedeem},
+	"revoke": {usage: "쿠폰 회수 (admin)", setup: setupCouponRevoke},
+}
+
+// attributesFlag : -attr region=seoul -attr tier=gold 처럼 여러번 받음
+type attributesFlag map[string]string
+
+func (a attributesFlag) String() string {
+	pairs := make([]string, 0, len(a))
+	for k, v := range a {
+		pairs = append(pairs, k+"="+v)
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (a attributesFlag) Set(value string) error {
+	k, v, ok := strings.Cut(value, "=")
+	if !ok || k == "" {
+		return fmt.Errorf("attribute must be key=value: %q", value)
+	}
+	a[k] = v
+	return nil
+}
+
+func setupCouponIssue(fs *flag.FlagSet) runFunc {
+	campaignId := fs.String("campaign", "", "캠페인 ID (필수)")
+	userId := fs.String("user", "", "사용자 ID (인증된 client 는 토큰의 userId 로 대체됨)")
+	attributes := attributesFlag{}
+	fs.Var(attributes, "attr", "발급 조건을 평가할 사용자 속성 key=value, 여러번 지정 가능")
+	waitlist := fs.Bool("waitlist", false, "쿠폰이 소진된 경우 대기자로 등록")
+
+	return func(ctx context.Context, conn *connection, args []string) error {
+		if *campaignId == "" {
+			return usageError(fs, "campaign is required")
+		}
+
+		client, err := conn.couponClient()
+		if err != nil {
+			return err
+		}
+		res, err := client.IssueCoupon(ctx, connect.NewRequest(&v1.IssueCouponReq{
+			CampaignId:   *campaignId,
+			UserId:       *userId,
+			Attributes:   attributes,
+			JoinWaitlist: *waitlist,
+		}))
+		if err := resultError(res, err); err != nil {
+			return err
+		}
+
+		return printMessage(conn, res.Msg, func(t *table) {
+			t.header("CAMPAIGN", "USER", "COUPON", "TIER")
+			t.row(*campaignId, orDash(*userId), res.Msg.CouponCode, orDash(res.Msg.Tier))
+		})
+	}
+}
+
+func setupCouponRedeem(fs *flag.FlagSet) runFunc {
+	campaignId := fs.String("campaign", "", "캠페인 ID (필수)")
+	code := fs.String("code", "", "쿠폰 코드 (필수)")
+	userId := fs.String("user", "", "있으면 발급받은 사용자 본인인지 확인")
+	reservationId := fs.String("reservation", "", "예약(held)된 쿠폰의 reservationId")
+
+	return func(ctx context.Context, conn *connection, args []string) error {
+		if *campaignId == "" || *code == "" {
+			return usageError(fs, "campaign and code are required")
+		}
+
+		client, err := conn.couponClient()
+		if err != nil {
+			return err
+		}
+		res, err := client.RedeemCoupon(ctx, connect.NewRequest(&v1.RedeemCouponReq{
+			CampaignId:    *campaignId,
+			CouponCode:    *code,
+			UserId:        *userId,
+			ReservationId: *reservationId,
+		}))
+		if err := resultError(res, err); err != nil {
+			return err
+		}
+
+		return printMessage(conn, res.Msg, func(t *table) {
+			t.row("coupon", *code, "redeemed")
+		})
+	}
+}
+
+func setupCouponRevoke(fs *flag.FlagSet) runFunc {
+	campaignId := fs.String("campaign", "", "캠페인 ID (필수)")
+	code := fs.String("code", "", "쿠폰 코드 (필수)")
+	returnToPool := fs.Bool("return", false, "revoked 대신 available 로 돌려서 다시 발급될 수 있게 함")
+	reason := fs.String("reason", "", "감사 이력에 남길 사유")
+
+	return func(ctx context.Context, conn *connection, args []string) error {
+		if *campaignId == "" || *code == "" {
+			return usageError(fs, "campaign and code are required")
+		}
+
+		client, err := conn.couponClient()
+		if err != nil {
+			return err
+		}
+		res, err := client.RevokeCoupon(ctx, connect.NewRequest(&v1.RevokeCouponReq{
+			CampaignId:   *campaignId,
+			CouponCode:   *code,
+			ReturnToPool: *returnToPool,
+			Reason:       *reason,
+		}))
+		if err := resultError(res, err); err != nil {
+			return err
+		}
+
+		return printMessage(conn, res.Msg, func(t *table) {
+			t.row("coupon", *code, res.Msg.State)
+		})
+	}
+}
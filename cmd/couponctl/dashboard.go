@@ -0,0 +1,521 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1/v1connect"
+)
+
+// setupDashboard : 종료되지 않은 캠페인을 interval 마다 GetCampaignStats 로 조회해서 보여줌
+// 초당 발급 수 등은 이전 조회와의 차이로 계산하기 때문에 첫 화면에는 비어있음
+//
+//	couponctl dashboard -interval 1s
+func setupDashboard(fs *flag.FlagSet) runFunc {
+	interval := fs.Duration("interval", time.Second, "갱신 주기")
+
+	return func(ctx context.Context, conn *connection, args []string) error {
+		if *interval < 200*time.Millisecond {
+			return errors.New("interval must be at least 200ms")
+		}
+		in, out := int(os.Stdin.Fd()), int(os.Stdout.Fd())
+		if !isTerminal(in) || !isTerminal(out) {
+			return errors.New("dashboard needs an interactive terminal")
+		}
+
+		client, err := conn.campaignClient()
+		if err != nil {
+			return err
+		}
+
+		state, err := makeRaw(in)
+		if err != nil {
+			return err
+		}
+		defer state.restore()
+
+		d := &dashboard{
+			client:   client,
+			server:   conn.server,
+			interval: *interval,
+			out:      bufio.NewWriter(os.Stdout),
+			outFd:    out,
+			prev:     map[string]statSample{},
+			rates:    map[string]campaignRates{},
+		}
+		return d.run(ctx)
+	}
+}
+
+// statSample : 초당 수치 계산용 이전 조회 값
+type statSample struct {
+	at                         time.Time
+	issued, redeemed, failures int64
+}
+
+type campaignRates struct {
+	issue, redeem, failure float64 // 초당
+	errorRatio             float64 // 실패 / (발급 + 사용 + 실패)
+	known                  bool    // 이전 조회가 있어서 계산된 값인지
+}
+
+type pollResult struct {
+	detail string // 조회할때의 drill-down 캠페인
+	res    *v1.GetCampaignStatsRes
+	err    error
+}
+
+// pendingAction : y 를 눌러야 실행되는 pause / resume
+type pendingAction struct {
+	pause      bool
+	campaignId string
+}
+
+type dashboard struct {
+	client   v1connect.CampaignServiceClient
+	server   string
+	interval time.Duration
+	out      *bufio.Writer
+	outFd    int
+
+	campaigns []*v1.CampaignStats
+	selected  int
+	offset    int // 목록 스크롤 위치
+	detail    string
+	detailRow *v1.CampaignStats
+	prev      map[string]statSample
+	rates     map[string]campaignRates
+
+	pending   *pendingAction
+	status    string
+	updatedAt time.Time
+	polling   bool
+}
+
+func (d *dashboard) run(ctx context.Context) error {
+	// alternate screen, 커서 숨김
+	d.out.WriteString("\x1b[?1049h\x1b[?25l")
+	defer func() {
+		d.out.WriteString("\x1b[?25h\x1b[?1049l")
+		d.out.Flush()
+	}()
+
+	keys := make(chan string)
+	go readKeys(keys)
+
+	results := make(chan pollResult, 1)
+	poll := func() {
+		if d.polling {
+			return
+		}
+		d.polling = true
+		detail := d.detail
+		go func() {
+			pollCtx, cancel := context.WithTimeout(ctx, max(d.interval, 2*time.Second))
+			defer cancel()
+			res, err := d.client.GetCampaignStats(pollCtx, connect.NewRequest(&v1.GetCampaignStatsReq{CampaignId: detail}))
+			if err = resultError(res, err); err != nil {
+				results <- pollResult{detail: detail, err: err}
+				return
+			}
+			results <- pollResult{detail: detail, res: res.Msg}
+		}()
+	}
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	poll()
+
+	for {
+		d.render()
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			poll()
+		case r := <-results:
+			d.polling = false
+			d.apply(r)
+		case key, ok := <-keys:
+			if !ok {
+				return nil
+			}
+			quit, refresh := d.handleKey(ctx, key)
+			if quit {
+				return nil
+			}
+			if refresh {
+				poll()
+			}
+		}
+	}
+}
+
+// apply : 조회 결과로 화면 상태와 초당 수치를 갱신
+func (d *dashboard) apply(r pollResult) {
+	if r.err != nil {
+		d.status = "error: " + r.err.Error()
+		return
+	}
+
+	at, err := time.Parse(time.RFC3339Nano, r.res.Time)
+	if err != nil {
+		at = time.Now()
+	}
+	for _, c := range r.res.Campaigns {
+		d.updateRates(c, at)
+	}
+	d.updatedAt = at
+
+	switch {
+	case r.detail == "":
+		d.campaigns = r.res.Campaigns
+		d.selected = max(0, min(d.selected, len(d.campaigns)-1))
+	case r.detail == d.detail && len(r.res.Campaigns) == 1:
+		d.detailRow = r.res.Campaigns[0]
+	}
+	if strings.HasPrefix(d.status, "error: ") {
+		d.status = ""
+	}
+}
+
+func (d *dashboard) updateRates(c *v1.CampaignStats, at time.Time) {
+	sample := statSample{at: at, issued: c.Issued, redeemed: c.Redeemed, failures: c.Failures}
+	prev, ok := d.prev[c.CampaignId]
+	d.prev[c.CampaignId] = sample
+	if !ok {
+		return
+	}
+
+	elapsed := at.Sub(prev.at).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+
+	// 회수해서 발급 대기 목록으로 돌아온 쿠폰이 있으면 발급 수가 줄어들 수 있음
+	issued := max(0, sample.issued-prev.issued)
+	redeemed := max(0, sample.redeemed-prev.redeemed)
+	failures := max(0, sample.failures-prev.failures)
+
+	rates := campaignRates{
+		issue:   float64(issued) / elapsed,
+		redeem:  float64(redeemed) / elapsed,
+		failure: float64(failures) / elapsed,
+		known:   true,
+	}
+	if total := issued + redeemed + failures; total > 0 {
+		rates.errorRatio = float64(failures) / float64(total)
+	}
+	d.rates[c.CampaignId] = rates
+}
+
+// handleKey : quit 이면 종료, refresh 면 바로 다시 조회
+func (d *dashboard) handleKey(ctx context.Context, key string) (quit, refresh bool) {
+	if key == "ctrl-c" {
+		return true, false
+	}
+
+	if d.pending != nil {
+		action := d.pending
+		d.pending = nil
+		if key != "y" {
+			d.status = "cancelled"
+			return false, false
+		}
+		d.status = d.setPaused(ctx, action)
+		return false, true
+	}
+
+	switch key {
+	case "q":
+		return true, false
+	case "up", "k":
+		if d.detail == "" && d.selected > 0 {
+			d.selected--
+		}
+	case "down", "j":
+		if d.detail == "" && d.selected < len(d.campaigns)-1 {
+			d.selected++
+		}
+	case "enter":
+		if d.detail == "" && len(d.campaigns) > 0 {
+			// 목록 조회 결과에는 최근 이벤트가 없어서 다시 조회할 때까지 loading
+			d.detail, d.detailRow = d.campaigns[d.selected].CampaignId, nil
+			return false, true
+		}
+	case "esc", "b", "backspace":
+		if d.detail != "" {
+			d.detail, d.detailRow = "", nil
+			return false, true
+		}
+	case "p", "r":
+		if campaignId := d.current(); campaignId != "" {
+			d.pending = &pendingAction{pause: key == "p", campaignId: campaignId}
+		}
+	}
+	return false, false
+}
+
+// current : drill-down 중이면 그 캠페인, 아니면 선택된 캠페인
+func (d *dashboard) current() string {
+	if d.detail != "" {
+		return d.detail
+	}
+	if d.selected < len(d.campaigns) {
+		return d.campaigns[d.selected].CampaignId
+	}
+	return ""
+}
+
+func (d *dashboard) setPaused(ctx context.Context, action *pendingAction) string {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var err error
+	if action.pause {
+		res, rpcErr := d.client.PauseCampaign(ctx, connect.NewRequest(&v1.PauseCampaignReq{CampaignId: action.campaignId, Reason: "paused from couponctl dashboard"}))
+		err = resultError(res, rpcErr)
+	} else {
+		res, rpcErr := d.client.ResumeCampaign(ctx, connect.NewRequest(&v1.ResumeCampaignReq{CampaignId: action.campaignId}))
+		err = resultError(res, rpcErr)
+	}
+
+	switch {
+	case err != nil:
+		return "error: " + err.Error()
+	case action.pause:
+		return action.campaignId + " paused"
+	default:
+		return action.campaignId + " resumed"
+	}
+}
+
+func (d *dashboard) render() {
+	width, height := terminalSize(d.outFd)
+	var lines []string
+
+	updated := "-"
+	if !d.updatedAt.IsZero() {
+		updated = d.updatedAt.Local().Format("15:04:05")
+	}
+	lines = append(lines, fmt.Sprintf("couponctl dashboard  %s  updated %s  every %s", d.server, updated, d.interval))
+	lines = append(lines, "")
+
+	if d.detail == "" {
+		lines = append(lines, d.listLines(height-len(lines)-3)...)
+	} else {
+		lines = append(lines, d.detailLines(height-len(lines)-3)...)
+	}
+
+	// 아래쪽 상태줄, 키 안내
+	for len(lines) < height-2 {
+		lines = append(lines, "")
+	}
+	status := d.status
+	if d.pending != nil {
+		verb := "resume"
+		if d.pending.pause {
+			verb = "pause"
+		}
+		status = fmt.Sprintf("%s issuance of %s? (y/n)", verb, d.pending.campaignId)
+	}
+	lines = append(lines, status)
+	if d.detail == "" {
+		lines = append(lines, "↑/↓ select  enter events  p pause  r resume  q quit")
+	} else {
+		lines = append(lines, "esc back  p pause  r resume  q quit")
+	}
+
+	d.out.WriteString("\x1b[H")
+	for i, line := range lines {
+		if i >= height {
+			break
+		}
+		d.out.WriteString(truncate(line, width))
+		d.out.WriteString("\x1b[K")
+		if i < len(lines)-1 {
+			d.out.WriteString("\r\n")
+		}
+	}
+	d.out.WriteString("\x1b[J")
+	d.out.Flush()
+}
+
+const listFormat = "%-20s %-8s %18s %8s %8s %7s %6s %8s %10s"
+
+func (d *dashboard) listLines(rows int) []string {
+	lines := []string{fmt.Sprintf(listFormat, "CAMPAIGN", "STATE", "REMAINING", "ISSUE/s", "REDEEM/s", "ERR/s", "ERR%", "WAITLIST", "EXPIRES IN")}
+	if len(d.campaigns) == 0 {
+		return append(lines, "(no active campaigns)")
+	}
+
+	// 선택된 줄이 보이도록 스크롤
+	rows = max(1, rows-1)
+	if d.selected < d.offset {
+		d.offset = d.selected
+	}
+	if d.selected >= d.offset+rows {
+		d.offset = d.selected - rows + 1
+	}
+
+	now := time.Now()
+	for i := d.offset; i < len(d.campaigns) && i < d.offset+rows; i++ {
+		c := d.campaigns[i]
+		r := d.rates[c.CampaignId]
+		line := fmt.Sprintf(listFormat,
+			truncate(c.CampaignId, 20),
+			campaignState(c, now),
+			fmt.Sprintf("%d/%d %s", c.Remaining, c.MaxCoupon, percent(c.Remaining, c.MaxCoupon)),
+			rate(r, r.issue),
+			rate(r, r.redeem),
+			rate(r, r.failure),
+			ratio(r),
+			fmt.Sprint(c.Waitlist),
+			expiresIn(c, now),
+		)
+		if i == d.selected {
+			line = "\x1b[7m" + line + "\x1b[0m"
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func (d *dashboard) detailLines(rows int) []string {
+	c := d.detailRow
+	if c == nil {
+		return []string{d.detail + " : loading"}
+	}
+
+	now := time.Now()
+	r := d.rates[c.CampaignId]
+	lines := []string{
+		fmt.Sprintf("%s  %s  remaining %d/%d %s  issued %d  redeemed %d  waitlist %d  failures %d",
+			c.CampaignId, campaignState(c, now), c.Remaining, c.MaxCoupon, percent(c.Remaining, c.MaxCoupon), c.Issued, c.Redeemed, c.Waitlist, c.Failures),
+		fmt.Sprintf("issue %s/s  redeem %s/s  errors %s/s (%s)  expires in %s",
+			rate(r, r.issue), rate(r, r.redeem), rate(r, r.failure), ratio(r), expiresIn(c, now)),
+		"",
+		fmt.Sprintf("%-12s %-18s %-16s %-16s %s", "TIME", "EVENT", "COUPON", "USER", "REASON"),
+	}
+
+	if len(c.RecentEvents) == 0 {
+		return append(lines, "(no recent events since server start)")
+	}
+	for _, e := range c.RecentEvents {
+		if len(lines) >= rows {
+			break
+		}
+		at := e.Time
+		if t, err := time.Parse(time.RFC3339Nano, e.Time); err == nil {
+			at = t.Local().Format("15:04:05.000")
+		}
+		lines = append(lines, fmt.Sprintf("%-12s %-18s %-16s %-16s %s", at, e.Type, orDash(e.CouponCode), orDash(e.UserId), e.Reason))
+	}
+	return lines
+}
+
+func campaignState(c *v1.CampaignStats, now time.Time) string {
+	start, _ := time.Parse(time.RFC3339, c.StartDate)
+	end, _ := time.Parse(time.RFC3339, c.ExpiredDate)
+	switch {
+	case c.Paused:
+		return "PAUSED"
+	case now.After(end):
+		return "ended"
+	case now.Before(start):
+		return "pending"
+	case c.Remaining == 0:
+		return "soldout"
+	}
+	return "active"
+}
+
+func expiresIn(c *v1.CampaignStats, now time.Time) string {
+	end, err := time.Parse(time.RFC3339, c.ExpiredDate)
+	if err != nil || !now.Before(end) {
+		return "-"
+	}
+
+	left := end.Sub(now).Round(time.Second)
+	switch {
+	case left >= 48*time.Hour:
+		return fmt.Sprintf("%dd%dh", int(left.Hours())/24, int(left.Hours())%24)
+	case left >= time.Hour:
+		return fmt.Sprintf("%dh%02dm", int(left.Hours()), int(left.Minutes())%60)
+	}
+	return left.String()
+}
+
+func percent(n, total int64) string {
+	if total == 0 {
+		return "(-)"
+	}
+	return fmt.Sprintf("(%d%%)", n*100/total)
+}
+
+func rate(r campaignRates, v float64) string {
+	if !r.known {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f", v)
+}
+
+func ratio(r campaignRates) string {
+	if !r.known {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", r.errorRatio*100)
+}
+
+// truncate : 화면 폭보다 긴 줄은 자름 (글자 단위, 전각 문자는 고려하지 않음)
+// escape sequence 가 들어간 선택 줄은 목록 폭이 고정이라 자르지 않음
+func truncate(s string, width int) string {
+	if width <= 0 || strings.Contains(s, "\x1b") {
+		return s
+	}
+	if runes := []rune(s); len(runes) > width {
+		return string(runes[:width])
+	}
+	return s
+}
+
+// readKeys : raw mode 의 stdin 을 읽어서 키 이름으로 넘김, stdin 이 닫히면 channel 을 닫음
+func readKeys(keys chan<- string) {
+	defer close(keys)
+
+	buf := make([]byte, 16)
+	for {
+		n, err := os.Stdin.Read(buf)
+		if err != nil {
+			return
+		}
+
+		switch in := string(buf[:n]); in {
+		case "\x1b[A", "\x1bOA":
+			keys <- "up"
+		case "\x1b[B", "\x1bOB":
+			keys <- "down"
+		case "\x1b":
+			keys <- "esc"
+		case "\r", "\n":
+			keys <- "enter"
+		case "\x7f", "\b":
+			keys <- "backspace"
+		case "\x03":
+			keys <- "ctrl-c"
+		default:
+			if n == 1 {
+				keys <- strings.ToLower(in)
+			}
+		}
+	}
+}
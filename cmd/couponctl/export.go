@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"connectrpc.com/connect"
+	v1 "github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1"
+)
+
+// setupExport : ExportCampaign 으로 받은 내용을 그대로 파일(또는 stdout) 에 씀
+//
+//	couponctl export -campaign spring -state redeemed -from "2025-05-01 00:00" -o redeemed.csv
+func setupExport(fs *flag.FlagSet) runFunc {
+	campaignId := fs.String("campaign", "", "내보낼 캠페인 ID (필수)")
+	format := fs.String("format", "csv", "csv, jsonl")
+	states := fs.String("state", "", "쉼표로 구분한 쿠폰 상태 (available, issued, held, redeemed, revoked, expired), 비어있으면 전체")
+	timeField := fs.String("time-field", "issued", "from / to 를 적용할 시각 : issued, redeemed")
+	from := fs.String("from", "", "이 시각 이후 (RFC3339 또는 yyyy-mm-dd hh:mm)")
+	to := fs.String("to", "", "이 시각 이전, 포함하지 않음")
+	output := fs.String("o", "-", "저장할 파일, - 면 stdout")
+
+	return func(ctx context.Context, conn *connection, args []string) error {
+		if *campaignId == "" {
+			return usageError(fs, "campaign is required")
+		}
+
+		req := &v1.ExportCampaignReq{
+			CampaignId: *campaignId,
+			Format:     *format,
+			TimeField:  *timeField,
+			From:       *from,
+			To:         *to,
+		}
+		if *states != "" {
+			for _, state := range strings.Split(*states, ",") {
+				req.States = append(req.States, strings.TrimSpace(state))
+			}
+		}
+
+		client, err := conn.campaignClient()
+		if err != nil {
+			return err
+		}
+
+		var out io.Writer = os.Stdout
+		if *output != "-" {
+			f, err := os.Create(*output)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			out = f
+		}
+
+		stream, err := client.ExportCampaign(ctx, connect.NewRequest(req))
+		if err != nil {
+			return err
+		}
+		defer stream.Close()
+
+		written := 0
+		for stream.Receive() {
+			n, err := out.Write(stream.Msg().Data)
+			if err != nil {
+				return err
+			}
+			written += n
+		}
+		if err := stream.Err(); err != nil {
+			return err
+		}
+
+		if *output != "-" {
+			fmt.Fprintf(os.Stderr, "%d bytes written to %s\n", written, *output)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	v1 "github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1"
+)
+
+// setupImport : CSV 의 한 컬럼을 쿠폰 코드로 읽어서 ImportCoupons 로 나눠 보냄
+//
+//	couponctl import -campaign giftcard -column code codes.csv
+func setupImport(fs *flag.FlagSet) runFunc {
+	campaignId := fs.String("campaign", "", "가져올 캠페인 ID (필수)")
+	column := fs.String("column", "1", "쿠폰 코드 컬럼 : 1 부터 시작하는 번호 또는 header 의 컬럼 이름")
+	skipHeader := fs.Bool("skip-header", false, "첫 줄을 header 로 보고 건너뜀 (column 을 이름으로 지정하면 항상 건너뜀)")
+	pattern := fs.String("pattern", "", "코드가 맞아야 하는 정규식 (서버에서 확인)")
+	dryRun := fs.Bool("dry-run", false, "가져오지 않고 검증 결과만 확인")
+	batch := fs.Int("batch", 1000, "메시지 하나에 담을 코드 수")
+	rejects := fs.String("rejects", "", "거절된 행을 CSV 로 저장할 파일")
+
+	return func(ctx context.Context, conn *connection, args []string) error {
+		if *campaignId == "" || len(args) != 1 {
+			return usageError(fs, "campaign and file are required")
+		}
+		if *batch <= 0 {
+			return errors.New("batch must be positive")
+		}
+
+		in := os.Stdin
+		if path := args[0]; path != "-" {
+			f, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			in = f
+		}
+
+		client, err := conn.campaignClient()
+		if err != nil {
+			return err
+		}
+
+		reader := csv.NewReader(in)
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+
+		index, err := strconv.Atoi(*column)
+		byName := err != nil
+		if byName || *skipHeader {
+			header, err := reader.Read()
+			if err != nil {
+				return fmt.Errorf("read header: %w", err)
+			}
+			if byName {
+				if index = findColumn(header, *column); index == 0 {
+					return fmt.Errorf("column %q not found in header %v", *column, header)
+				}
+			}
+		}
+		if index <= 0 {
+			return errors.New("column must be 1 or greater")
+		}
+
+		stream := client.ImportCoupons(ctx)
+		msg := &v1.ImportCouponsReq{CampaignId: *campaignId, Pattern: *pattern, DryRun: *dryRun}
+		sent := 0
+
+		for {
+			record, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				stream.CloseAndReceive()
+				return err
+			}
+
+			line, _ := reader.FieldPos(0)
+			code := ""
+			if index <= len(record) {
+				code = record[index-1]
+			}
+			msg.Rows = append(msg.Rows, &v1.ImportCouponRow{Line: int64(line), Code: code})
+
+			if len(msg.Rows) >= *batch {
+				if err := stream.Send(msg); err != nil {
+					break // 실제 에러는 CloseAndReceive 에서 받음
+				}
+				sent += len(msg.Rows)
+				msg = &v1.ImportCouponsReq{}
+			}
+		}
+		if len(msg.Rows) > 0 || sent == 0 {
+			if err := stream.Send(msg); err == nil {
+				sent += len(msg.Rows)
+			}
+		}
+
+		res, err := stream.CloseAndReceive()
+		if err != nil {
+			return err
+		}
+		if !res.Msg.Result.Success {
+			return errors.New(res.Msg.Result.Message)
+		}
+
+		verb := "imported"
+		if *dryRun {
+			verb = "importable (dry-run)"
+		}
+		fmt.Printf("rows: %d, %s: %d, rejected: %d, campaign maxCoupon: %d\n", sent, verb, res.Msg.Imported, len(res.Msg.Rejected), res.Msg.MaxCoupon)
+
+		if len(res.Msg.Rejected) == 0 {
+			return nil
+		}
+		if *rejects != "" {
+			return writeRejects(*rejects, res.Msg.Rejected)
+		}
+		for _, r := range res.Msg.Rejected {
+			fmt.Printf("  line %d\t%s\t%s\t%s\n", r.Line, r.Code, r.Reason, r.Detail)
+		}
+		return nil
+	}
+}
+
+func findColumn(header []string, name string) int {
+	for i, h := range header {
+		if strings.EqualFold(strings.TrimSpace(h), name) {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+func writeRejects(path string, rejected []*v1.RejectedCoupon) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	w.Write([]string{"line", "code", "reason", "detail"})
+	for _, r := range rejected {
+		w.Write([]string{strconv.FormatInt(r.Line, 10), r.Code, r.Reason, r.Detail})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return err
+	}
+
+	fmt.Printf("rejected rows written to %s\n", path)
+	return nil
+}
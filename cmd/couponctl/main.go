@@ -0,0 +1,150 @@
+// couponctl : 쿠폰 서비스 관리용 CLI
+//
+//	couponctl [연결 옵션] <command> [sub command] [옵션]
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+)
+
+// runFunc : 옵션을 파싱한 뒤 남은 위치 인자를 받음
+type runFunc func(ctx context.Context, conn *connection, args []string) error
+
+// command : setup 은 자기 옵션을 등록하고 실행 함수를 반환함, 하위 명령만 있는 명령은 setup 이 nil
+// completion 도 setup 으로 옵션 목록을 만들기 때문에 setup 안에서는 옵션 등록 외의 일을 하지 않음
+type command struct {
+	usage    string
+	args     string // usage 에 표시할 위치 인자
+	setup    func(fs *flag.FlagSet) runFunc
+	commands map[string]*command
+}
+
+var root = &command{
+	commands: map[string]*command{
+		"campaign":  {usage: "캠페인 생성 / 조회 / 목록 / 쿠폰 수 변경 / 삭제", commands: campaignCommands},
+		"coupon":    {usage: "쿠폰 발급 / 사용 / 회수", commands: couponCommands},
+		"dashboard": {usage: "진행중인 캠페인 실시간 모니터링 (발급률, 에러율, 일시 정지)", setup: setupDashboard},
+		"export":    {usage: "캠페인 쿠폰을 CSV / JSON Lines 로 내보내기", setup: setupExport},
+		"import":    {usage: "CSV 파일의 쿠폰 코드를 캠페인으로 가져오기", args: "<file.csv | ->", setup: setupImport},
+		"config":    {usage: "서버 주소, 인증 정보 profile 관리", commands: configCommands},
+		"completion": {
+			usage: "shell completion script 출력 (bash, zsh, fish)",
+			args:  "<bash | zsh | fish>",
+			setup: setupCompletion,
+		},
+	},
+}
+
+func main() {
+	global := flag.NewFlagSet("couponctl", flag.ExitOnError)
+	conn := bindConnectionFlags(global)
+	global.Usage = func() {
+		fmt.Fprintf(global.Output(), "usage: couponctl [연결 옵션] <command> [옵션]\n\ncommands:\n")
+		printCommands(global, root)
+		fmt.Fprintf(global.Output(), "\n연결 옵션:\n")
+		global.PrintDefaults()
+	}
+	global.Parse(os.Args[1:])
+
+	args := global.Args()
+	if len(args) > 0 && args[0] == completeCommand {
+		complete(args[1:])
+		return
+	}
+
+	cmd, path, args := resolve(root, args)
+	if cmd == root || cmd.setup == nil {
+		usage := global.Usage
+		if cmd != root {
+			usage = func() {
+				fmt.Fprintf(os.Stderr, "usage: couponctl %s <command> [옵션]\n\ncommands:\n", strings.Join(path, " "))
+				printCommands(global, cmd)
+			}
+		}
+		if len(args) > 0 {
+			fmt.Fprintf(os.Stderr, "unknown command %q\n", args[0])
+		}
+		usage()
+		os.Exit(2)
+	}
+
+	name := strings.Join(path, " ")
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	run := cmd.setup(fs)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: couponctl %s\n", strings.TrimSpace(name+" [옵션] "+cmd.args))
+		fs.PrintDefaults()
+	}
+	args = parseArgs(fs, args)
+
+	if err := conn.resolve(global); err != nil {
+		fmt.Fprintf(os.Stderr, "couponctl: %v\n", err)
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, conn, args); err != nil {
+		fmt.Fprintf(os.Stderr, "couponctl %s: %v\n", name, err)
+		os.Exit(1)
+	}
+}
+
+// resolve : 하위 명령 이름을 따라 내려가고, 찾은 명령과 그 경로, 남은 인자를 반환
+func resolve(cmd *command, args []string) (*command, []string, []string) {
+	var path []string
+	for len(args) > 0 && cmd.commands != nil {
+		sub, ok := cmd.commands[args[0]]
+		if !ok {
+			break
+		}
+		cmd, path, args = sub, append(path, args[0]), args[1:]
+	}
+	return cmd, path, args
+}
+
+// parseArgs : 위치 인자 뒤에 오는 옵션도 받음 (couponctl config set local -server ...), "--" 뒤는 모두 위치 인자
+func parseArgs(fs *flag.FlagSet, args []string) []string {
+	var positional []string
+	for {
+		fs.Parse(args)
+		rest := fs.Args()
+		if len(rest) == 0 {
+			return positional
+		}
+		if consumed := len(args) - len(rest); consumed > 0 && args[consumed-1] == "--" {
+			return append(positional, rest...)
+		}
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+}
+
+func printCommands(fs *flag.FlagSet, cmd *command) {
+	for _, name := range commandNames(cmd) {
+		fmt.Fprintf(fs.Output(), "  %-12s %s\n", name, cmd.commands[name].usage)
+	}
+}
+
+func commandNames(cmd *command) []string {
+	names := make([]string, 0, len(cmd.commands))
+	for name := range cmd.commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// usageError : 필수 인자가 빠진 경우 등
+func usageError(fs *flag.FlagSet, msg string) error {
+	fs.Usage()
+	return fmt.Errorf("%s", msg)
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"slices"
+	"testing"
+)
+
+func TestParseArgs(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		positional []string
+		server     string
+		force      bool
+	}{
+		{name: "flags first", args: []string{"-server", "http://a", "local"}, positional: []string{"local"}, server: "http://a"},
+		{name: "flags after positional", args: []string{"local", "-server", "http://a"}, positional: []string{"local"}, server: "http://a"},
+		{name: "mixed", args: []string{"c1", "-force", "c2", "-server=http://b", "c3"}, positional: []string{"c1", "c2", "c3"}, server: "http://b", force: true},
+		{name: "double dash keeps the rest", args: []string{"c1", "--", "-server", "c2"}, positional: []string{"c1", "-server", "c2"}},
+		{name: "no args"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+			server := fs.String("server", "", "")
+			force := fs.Bool("force", false, "")
+
+			positional := parseArgs(fs, tt.args)
+			if !slices.Equal(positional, tt.positional) {
+				t.Errorf("positional = %q, want %q", positional, tt.positional)
+			}
+			if *server != tt.server || *force != tt.force {
+				t.Errorf("server = %q, force = %v, want %q, %v", *server, *force, tt.server, tt.force)
+			}
+		})
+	}
+}
+
+func TestResolveCommand(t *testing.T) {
+	cmd, path, args := resolve(root, []string{"config", "set", "local", "-server", "http://a"})
+	if cmd != configCommands["set"] || !slices.Equal(path, []string{"config", "set"}) || !slices.Equal(args, []string{"local", "-server", "http://a"}) {
+		t.Fatalf("resolve = %v, %q, %q", cmd, path, args)
+	}
+
+	// 모르는 하위 명령은 상위 명령에서 멈춤
+	if cmd, path, _ := resolve(root, []string{"campaign", "nope"}); cmd != root.commands["campaign"] || !slices.Equal(path, []string{"campaign"}) {
+		t.Fatalf("resolve unknown = %v, %q", cmd, path)
+	}
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+// 출력 형식
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+func validateOutput(format string) error {
+	switch format {
+	case outputTable, outputJSON, outputYAML:
+		return nil
+	}
+	return fmt.Errorf("unknown output format %q (table, json, yaml)", format)
+}
+
+// table : 컬럼을 공백으로 맞춰서 stdout 에 출력
+type table struct {
+	w *tabwriter.Writer
+}
+
+func (t *table) header(cols ...string) {
+	fmt.Fprintln(t.w, strings.Join(cols, "\t"))
+}
+
+func (t *table) row(cols ...any) {
+	s := make([]string, len(cols))
+	for i, col := range cols {
+		s[i] = fmt.Sprint(col)
+	}
+	fmt.Fprintln(t.w, strings.Join(s, "\t"))
+}
+
+// printMessage : 응답 message 를 -output 형식으로 출력, table 이면 render 로 그림
+// json / yaml 은 API 응답과 같은 필드 이름을 사용함
+func printMessage(conn *connection, msg proto.Message, render func(t *table)) error {
+	if conn.output == outputTable {
+		return printTable(render)
+	}
+
+	data, err := protojson.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return printJSON(conn.output, data)
+}
+
+// printValue : proto 가 아닌 값 (config 등)
+func printValue(conn *connection, v any, render func(t *table)) error {
+	if conn.output == outputTable {
+		return printTable(render)
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return printJSON(conn.output, data)
+}
+
+func printTable(render func(t *table)) error {
+	t := &table{w: tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)}
+	render(t)
+	return t.w.Flush()
+}
+
+// printJSON : yaml 은 JSON 을 yaml.Node 로 읽어서 필드 순서를 유지함
+func printJSON(format string, data []byte) error {
+	if format == outputJSON {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, data, "", "  "); err != nil {
+			return err
+		}
+		buf.WriteByte('\n')
+		_, err := buf.WriteTo(os.Stdout)
+		return err
+	}
+
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return err
+	}
+	blockStyle(&node)
+
+	enc := yaml.NewEncoder(os.Stdout)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// blockStyle : JSON 의 {} [] "" 표기를 yaml 기본 표기로 바꿈 (문자열이 다른 타입으로 읽히는 경우는 encoder 가 따옴표를 붙임)
+func blockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
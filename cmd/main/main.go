@@ -1,39 +1,348 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"log"
+	"log/slog"
 	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
+	"connectrpc.com/connect"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/audit"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/auth"
 	"github.com/dev-jiemu/coupon-issuance-poc/pkg/cache"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/certs"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/config"
 	"github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1/v1connect"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/health"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/logging"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/metrics"
 	"github.com/dev-jiemu/coupon-issuance-poc/pkg/service"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/storage"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/tenant"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/tracing"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/webhook"
 
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 )
 
 func main() {
+	// 0. 설정 : 기본값 < 설정 파일 < 환경변수 < flag
+	cfg, err := config.Load(os.Args[0], os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("invalid config:\n%v", err)
+	}
+
+	// 여기서부터는 slog (json) 로 출력
+	if err := logging.Setup(os.Stderr, cfg.Log.Options()); err != nil {
+		log.Fatalf("invalid log config: %v", err)
+	}
+	slog.Info("effective config", "config", cfg.Redacted())
+
+	// exporter 를 설정하지 않으면 span 을 만들지 않음
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing.Options())
+	if err != nil {
+		fatal("failed to set up tracing", err)
+	}
+
 	// 1. 서버 최초 가동 : campaign 관리할 매니저 객체 생성
 	cache.Manager = cache.NewCampaignManager()
+	cache.Manager.SetDefaultQuota(cfg.RateLimit.Default.TenantQuota())
+	for tenantId, quota := range cfg.RateLimit.Tenants {
+		cache.Manager.SetTenantQuota(tenantId, quota.TenantQuota())
+	}
+	cache.Manager.SetReservationTTL(cfg.Reservation.DefaultTTL, cfg.Reservation.MaxTTL)
+
+	if cfg.Metrics.Enabled {
+		cache.Manager.SetLockWaitObserver(metrics.ObserveLockWait)
+		if err := metrics.RegisterCampaignCollector(cache.Manager, cfg.Metrics.MaxCampaignSeries); err != nil {
+			fatal("failed to register metrics", err)
+		}
+	}
+
+	// 캠페인/쿠폰 상태 변경 감사 이력
+	auditLog, err := audit.Open(cfg.Audit.Path, cfg.Audit.MaxEntries)
+	if err != nil {
+		fatal("failed to open audit log", err)
+	}
+	cache.Manager.SetAuditor(auditLog.Record)
+	if cfg.Audit.Path == "" {
+		slog.Warn("audit.path is empty, audit log is kept in memory only and lost on restart")
+	}
+
+	store, err := storage.New(cfg.Storage.Backend, cfg.Storage.Path)
+	if err != nil {
+		fatal("failed to open storage", err)
+	}
 
-	// 2. service handlers
+	// storage 복구가 끝나기 전까지 readiness 는 false
+	checker := health.NewChecker(v1connect.CampaignServiceName, v1connect.CouponServiceName, v1connect.AuditServiceName, v1connect.WebhookServiceName, v1connect.RaffleServiceName)
+
+	// 2. tracing -> metric -> 요청 로그 -> 인증 -> tenant interceptor 순서
+	interceptors := []connect.Interceptor{tracing.NewInterceptor()}
+	if cfg.Metrics.Enabled {
+		interceptors = append(interceptors, metrics.NewInterceptor())
+	}
+	interceptors = append(interceptors, logging.NewInterceptor())
+	if cfg.Auth.Enabled {
+		authConfig, err := cfg.Auth.AuthenticatorConfig()
+		if err != nil {
+			fatal("invalid auth config", err)
+		}
+
+		authenticator, err := auth.NewAuthenticator(authConfig)
+		if err != nil {
+			fatal("invalid auth config", err)
+		}
+		interceptors = append(interceptors, auth.NewInterceptor(authenticator))
+	} else {
+		slog.Warn("auth is disabled, every RPC is open to anyone")
+	}
+	if cfg.TLS.AdminRequireClientCert {
+		interceptors = append(interceptors, certs.NewClientCertInterceptor(func(procedure string) bool {
+			return auth.RequiredRole(procedure) == auth.RoleAdmin
+		}))
+	}
+	interceptors = append(interceptors, tenant.NewInterceptor())
+	handlerOpts := []connect.HandlerOption{connect.WithInterceptors(interceptors...)}
+
+	// 3. service handlers
 	campaignServer := service.NewCampaignServer()
 	couponServer := service.NewCouponServer()
+	auditServer := service.NewAuditServer(auditLog)
+	webhookServer := service.NewWebhookServer()
+	raffleServer := service.NewRaffleServer()
 
-	// 3. Set up mux and handlers
+	// 4. Set up mux and handlers
 	mux := http.NewServeMux()
 
 	// Campaign service routes
-	campaignPath, campaignHandler := v1connect.NewCampaignServiceHandler(campaignServer)
-	mux.Handle(campaignPath, campaignHandler)
+	campaignPath, campaignHandler := v1connect.NewCampaignServiceHandler(campaignServer, handlerOpts...)
+	mux.Handle(campaignPath, checker.RequireStarted(campaignHandler))
 
 	// Coupon service routes
-	couponPath, couponHandler := v1connect.NewCouponServiceHandler(couponServer)
-	mux.Handle(couponPath, couponHandler)
-
-	log.Println("RPC server starting on localhost:50051")
-	http.ListenAndServe(
-		"localhost:50051",
-		h2c.NewHandler(mux, &http2.Server{}),
-	)
+	couponPath, couponHandler := v1connect.NewCouponServiceHandler(couponServer, handlerOpts...)
+	mux.Handle(couponPath, checker.RequireStarted(couponHandler))
+
+	// Audit service routes
+	auditPath, auditHandler := v1connect.NewAuditServiceHandler(auditServer, handlerOpts...)
+	mux.Handle(auditPath, checker.RequireStarted(auditHandler))
+
+	// Webhook service routes
+	webhookPath, webhookHandler := v1connect.NewWebhookServiceHandler(webhookServer, handlerOpts...)
+	mux.Handle(webhookPath, checker.RequireStarted(webhookHandler))
+
+	// Raffle service routes
+	rafflePath, raffleHandler := v1connect.NewRaffleServiceHandler(raffleServer, handlerOpts...)
+	mux.Handle(rafflePath, checker.RequireStarted(raffleHandler))
+
+	// Health routes : 인증 없이 접근 가능
+	healthPath, healthHandler := checker.NewHandler()
+	mux.Handle(healthPath, healthHandler)
+	mux.HandleFunc("/healthz", checker.Healthz)
+	mux.HandleFunc("/readyz", checker.Readyz)
+
+	// Prometheus scrape : 인증 없이 접근 가능
+	if cfg.Metrics.Enabled {
+		mux.Handle(cfg.Metrics.Path, metrics.Handler())
+	}
+
+	// 복구나 listener 준비 중에 들어온 종료 signal 도 놓치지 않도록 먼저 등록함
+	signals, stopSignals := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stopSignals()
+
+	// cleartext(h2c) listener 와 TLS listener 를 같이 띄울 수 있음
+	var servers []*http.Server
+	serverErr := make(chan error, 2)
+	background, stopBackground := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+
+	if cfg.Server.Listen != "" {
+		server := &http.Server{
+			Addr:    cfg.Server.Listen,
+			Handler: mux,
+		}
+		if cfg.Server.H2C {
+			// 같은 http2.Server 를 등록해야 Shutdown 때 h2c 연결에도 GOAWAY 를 보냄
+			h2s := &http2.Server{}
+			if err := http2.ConfigureServer(server, h2s); err != nil {
+				fatal("failed to configure h2c", err)
+			}
+			server.Handler = h2c.NewHandler(mux, h2s)
+		}
+		servers = append(servers, server)
+
+		go func() {
+			slog.Info("RPC server starting", "listen", cfg.Server.Listen, "h2c", cfg.Server.H2C)
+			serverErr <- server.ListenAndServe()
+		}()
+	}
+
+	if cfg.TLS.Enabled() {
+		clientAuth, _ := certs.ParseClientAuth(cfg.TLS.ClientAuth)
+		reloader, err := certs.NewReloader(cfg.TLS.CertFile, cfg.TLS.KeyFile, cfg.TLS.ClientCAFile, clientAuth)
+		if err != nil {
+			fatal("failed to load tls certificate", err)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			reloader.Watch(background, cfg.TLS.ReloadInterval)
+		}()
+
+		server := &http.Server{
+			Addr:      cfg.TLS.Listen,
+			Handler:   certs.WithClientCert(mux),
+			TLSConfig: reloader.TLSConfig(),
+		}
+		servers = append(servers, server)
+
+		go func() {
+			slog.Info("RPC server starting", "listen", cfg.TLS.Listen, "tls", true, "clientAuth", cfg.TLS.ClientAuth)
+			serverErr <- server.ListenAndServeTLS("", "")
+		}()
+	}
+
+	// 5. 저장된 상태 복구 후 ready
+	snapshot, err := store.Load()
+	if err != nil {
+		fatal("failed to load storage", err)
+	}
+	if snapshot != nil {
+		cache.Manager.Restore(snapshot)
+		slog.Info("restored from storage", "tenants", len(snapshot.Tenants), "backend", cfg.Storage.Backend, "savedAt", snapshot.SavedAt)
+	}
+
+	if cfg.Storage.Backend != "memory" {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			flushLoop(background, store, cfg.Storage.FlushInterval)
+		}()
+	}
+
+	if cfg.Janitor.Interval > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cache.Manager.RunJanitor(background, cfg.Janitor.Interval, cfg.Janitor.Retention)
+		}()
+	}
+
+	// 결제 결과가 오지 않은 쿠폰 예약 해제
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		cache.Manager.RunReservationSweeper(background, cfg.Reservation.SweepInterval)
+	}()
+
+	// 추첨 캠페인 추첨, 사용하지 않은 당첨 쿠폰 회수
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		cache.Manager.RunDrawScheduler(background, cfg.Raffle.DrawInterval)
+	}()
+
+	// 복구된 outbox 부터 이어서 전송
+	if cfg.Webhook.Enabled {
+		dispatcher := webhook.NewDispatcher(cache.Manager, cfg.Webhook.Options())
+		if cfg.Metrics.Enabled {
+			dispatcher.SetObserver(metrics.ObserveWebhookDelivery)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			dispatcher.Run(background)
+		}()
+	}
+
+	checker.SetReady(true)
+
+	// 6. 종료 signal 대기
+	select {
+	case err := <-serverErr:
+		fatal("RPC server stopped", err)
+	case <-signals.Done():
+	}
+
+	// 7. graceful shutdown : readiness 내림 -> drain 대기 -> 처리중인 요청 완료 대기 -> storage flush
+	slog.Info("shutting down", "drainDelay", cfg.Server.DrainDelay)
+	checker.SetReady(false)
+	time.Sleep(cfg.Server.DrainDelay)
+	checker.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+
+	// h2c 연결은 Shutdown 이 기다려주지 않으므로 RPC 단위로 먼저 기다림
+	if err := checker.Drain(ctx); err != nil {
+		slog.Error("failed to wait for in-flight RPCs", "error", err)
+	}
+	var shutdown sync.WaitGroup
+	for _, server := range servers {
+		shutdown.Add(1)
+		go func(server *http.Server) {
+			defer shutdown.Done()
+			if err := server.Shutdown(ctx); err != nil {
+				slog.Error("failed to wait for in-flight requests", "listen", server.Addr, "error", err)
+				server.Close()
+			}
+		}(server)
+	}
+	shutdown.Wait()
+
+	stopBackground()
+	wg.Wait()
+
+	if err := store.Save(cache.Manager.Snapshot()); err != nil {
+		fatal("failed to flush storage", err)
+	}
+	if err := auditLog.Close(); err != nil {
+		slog.Error("failed to close audit log", "error", err)
+	}
+	if err := shutdownTracing(ctx); err != nil {
+		slog.Error("failed to flush tracing spans", "error", err)
+	}
+	slog.Info("RPC server stopped")
+}
+
+// flushLoop : 주기적으로 snapshot 저장, 마지막 저장은 종료 시점에 main 에서 함
+func flushLoop(ctx context.Context, store storage.Store, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, span := tracing.Start(ctx, "storage flush")
+			err := store.Save(cache.Manager.Snapshot())
+			if err != nil {
+				slog.Error("failed to save storage", "error", err)
+			}
+			tracing.End(span, err)
+		}
+	}
+}
+
+// fatal : log.Fatalf 대신 slog 형식으로 남기고 종료
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
 }
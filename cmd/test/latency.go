@@ -0,0 +1,267 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// HDR histogram 과 같은 방식 : 2 의 거듭제곱 구간마다 64 개의 하위 구간, 상대 오차 1.6% 이하
+const (
+	subBucketBits  = 7
+	subBucketCount = 1 << subBucketBits // 값이 이보다 작으면 1µs 단위
+	subBucketHalf  = subBucketCount / 2
+	maxShift       = 40 // 2^47 µs 까지, 넘으면 마지막 구간
+	bucketCount    = subBucketCount + maxShift*subBucketHalf
+)
+
+// histogram : 마이크로초 단위 응답 시간 분포
+type histogram struct {
+	counts [bucketCount]int64
+	total  int64
+	sum    int64
+	max    int64
+}
+
+func bucketIndex(v int64) int {
+	if v < subBucketCount {
+		return int(max(v, 0))
+	}
+	shift := bits.Len64(uint64(v)) - subBucketBits
+	if shift > maxShift {
+		return bucketCount - 1
+	}
+	return subBucketCount + (shift-1)*subBucketHalf + int(v>>shift) - subBucketHalf
+}
+
+// bucketUpper : 구간에 들어가는 가장 큰 값
+func bucketUpper(index int) int64 {
+	if index < subBucketCount {
+		return int64(index)
+	}
+	shift := (index-subBucketCount)/subBucketHalf + 1
+	mantissa := int64((index-subBucketCount)%subBucketHalf + subBucketHalf)
+	return (mantissa+1)<<shift - 1
+}
+
+func (h *histogram) record(micros int64) {
+	h.counts[bucketIndex(micros)]++
+	h.total++
+	h.sum += micros
+	h.max = max(h.max, micros)
+}
+
+// percentile : q (0~100) 번째 값, 구간의 상한을 반환하지만 max 를 넘지 않음
+func (h *histogram) percentile(q float64) int64 {
+	if h.total == 0 {
+		return 0
+	}
+	rank := int64(q/100*float64(h.total) + 0.5)
+	rank = min(max(rank, 1), h.total)
+
+	var seen int64
+	for i, count := range h.counts {
+		seen += count
+		if seen >= rank {
+			return min(bucketUpper(i), h.max)
+		}
+	}
+	return h.max
+}
+
+// summary : n=.. mean=.. p50=.. p90=.. p99=.. p99.9=.. max=..
+func (h *histogram) summary() string {
+	if h.total == 0 {
+		return "n=0"
+	}
+	return fmt.Sprintf("n=%d mean=%s p50=%s p90=%s p99=%s p99.9=%s max=%s",
+		h.total, formatMicros(h.sum/h.total), formatMicros(h.percentile(50)), formatMicros(h.percentile(90)),
+		formatMicros(h.percentile(99)), formatMicros(h.percentile(99.9)), formatMicros(h.max))
+}
+
+// distribution : 2 배씩 늘어나는 구간별 요청 수 막대 그래프
+func (h *histogram) distribution() []string {
+	if h.total == 0 {
+		return nil
+	}
+
+	type row struct {
+		upper int64
+		count int64
+	}
+	// 250µs 부터 2 배씩, 구간의 상한으로 나눔
+	var rows []row
+	for i, count := range h.counts {
+		if count == 0 {
+			continue
+		}
+		index, upper := 0, int64(250)
+		for upper < bucketUpper(i) {
+			index, upper = index+1, upper*2
+		}
+		for len(rows) <= index {
+			rows = append(rows, row{upper: 250 << len(rows)})
+		}
+		rows[index].count += count
+	}
+
+	// 앞쪽의 빈 구간은 생략
+	first, last := 0, len(rows)-1
+	for first < last && rows[first].count == 0 {
+		first++
+	}
+
+	var peak int64
+	for _, r := range rows[first : last+1] {
+		peak = max(peak, r.count)
+	}
+	lines := make([]string, 0, last-first+1)
+	for _, r := range rows[first : last+1] {
+		bar := strings.Repeat("#", int(r.count*40/peak))
+		lines = append(lines, fmt.Sprintf("  <= %8s %8d %6.2f%% %s", formatMicros(r.upper), r.count, float64(r.count)/float64(h.total)*100, bar))
+	}
+	return lines
+}
+
+func formatMicros(micros int64) string {
+	switch {
+	case micros < 1000:
+		return fmt.Sprintf("%dµs", micros)
+	case micros < 1000_000:
+		return fmt.Sprintf("%.2fms", float64(micros)/1000)
+	}
+	return fmt.Sprintf("%.2fs", float64(micros)/1000_000)
+}
+
+// apiError : RPC 는 성공했지만 Result.Success 가 false 인 응답
+type apiError struct {
+	message string
+	code    string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("API 오류: %s (코드: %s)", e.message, e.code)
+}
+
+// errorCode : 실패 분류, 응답을 받은 실패는 "ok: <ErrorCode>" (ErrorCode 가 없는 서버면 괄호 안의 상세 내용을 뺀 메시지)
+func errorCode(err error) string {
+	var ae *apiError
+	if errors.As(err, &ae) {
+		if ae.code != "" {
+			return "ok: " + ae.code
+		}
+		message, _, _ := strings.Cut(ae.message, " (")
+		return "ok: " + message
+	}
+	return connect.CodeOf(err).String()
+}
+
+// rpcLatency : RPC 하나의 전체 / 마지막 보고 이후 응답 시간, 실패 코드별 수
+type rpcLatency struct {
+	success, failure                 histogram
+	intervalSuccess, intervalFailure histogram
+	codes                            map[string]int64
+}
+
+// latencyRecorder : RPC 별 응답 시간 기록
+type latencyRecorder struct {
+	rpcs  map[string]*rpcLatency
+	mutex sync.Mutex
+}
+
+func newLatencyRecorder() *latencyRecorder {
+	return &latencyRecorder{rpcs: make(map[string]*rpcLatency)}
+}
+
+func (r *latencyRecorder) record(rpc string, latency time.Duration, err error) {
+	micros := latency.Microseconds()
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	stats, ok := r.rpcs[rpc]
+	if !ok {
+		stats = &rpcLatency{codes: make(map[string]int64)}
+		r.rpcs[rpc] = stats
+	}
+
+	if err == nil {
+		stats.success.record(micros)
+		stats.intervalSuccess.record(micros)
+		return
+	}
+	stats.failure.record(micros)
+	stats.intervalFailure.record(micros)
+	stats.codes[errorCode(err)]++
+}
+
+func (r *latencyRecorder) names() []string {
+	names := make([]string, 0, len(r.rpcs))
+	for name := range r.rpcs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// intervalReport : 마지막 보고 이후의 응답 시간, 보고한 뒤 구간 기록은 비움
+func (r *latencyRecorder) intervalReport() []string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var lines []string
+	for _, name := range r.names() {
+		stats := r.rpcs[name]
+		if stats.intervalSuccess.total > 0 {
+			lines = append(lines, fmt.Sprintf("  %-16s 성공 %s", name, stats.intervalSuccess.summary()))
+		}
+		if stats.intervalFailure.total > 0 {
+			lines = append(lines, fmt.Sprintf("  %-16s 실패 %s", name, stats.intervalFailure.summary()))
+		}
+		stats.intervalSuccess, stats.intervalFailure = histogram{}, histogram{}
+	}
+	return lines
+}
+
+// printSummary : 전체 테스트의 RPC 별 응답 시간 분포와 실패 코드
+func (r *latencyRecorder) printSummary() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	fmt.Println("\n--- RPC 별 응답 시간 ---")
+	for _, name := range r.names() {
+		stats := r.rpcs[name]
+		fmt.Printf("%s\n", name)
+		fmt.Printf("  성공 %s\n", stats.success.summary())
+		fmt.Printf("  실패 %s\n", stats.failure.summary())
+		for _, line := range stats.success.distribution() {
+			fmt.Println(line)
+		}
+	}
+
+	fmt.Println("\n--- RPC 별 실패 코드 ---")
+	for _, name := range r.names() {
+		stats := r.rpcs[name]
+		if len(stats.codes) == 0 {
+			continue
+		}
+
+		codes := make([]string, 0, len(stats.codes))
+		for code := range stats.codes {
+			codes = append(codes, code)
+		}
+		sort.Slice(codes, func(i, j int) bool {
+			return stats.codes[codes[i]] > stats.codes[codes[j]] || stats.codes[codes[i]] == stats.codes[codes[j]] && codes[i] < codes[j]
+		})
+		fmt.Printf("%s\n", name)
+		for _, code := range codes {
+			fmt.Printf("  %-40s %d\n", code, stats.codes[code])
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBucketIndex(t *testing.T) {
+	tests := []struct {
+		value int64
+		index int
+		upper int64
+	}{
+		{value: -5, index: 0, upper: 0},
+		{value: 0, index: 0, upper: 0},
+		{value: 127, index: 127, upper: 127},
+		// 128 부터는 2µs 단위
+		{value: 128, index: 128, upper: 129},
+		{value: 129, index: 128, upper: 129},
+		{value: 255, index: 191, upper: 255},
+		// 256 부터는 4µs 단위
+		{value: 256, index: 192, upper: 259},
+		{value: 1<<47 - 1, index: bucketCount - 1, upper: 1<<47 - 1},
+		// 범위를 넘으면 마지막 구간
+		{value: 1 << 47, index: bucketCount - 1, upper: 1<<47 - 1},
+		{value: math.MaxInt64, index: bucketCount - 1, upper: 1<<47 - 1},
+	}
+
+	for _, tt := range tests {
+		index := bucketIndex(tt.value)
+		if index != tt.index || bucketUpper(index) != tt.upper {
+			t.Errorf("value %d: index %d (upper %d), want %d (upper %d)", tt.value, index, bucketUpper(index), tt.index, tt.upper)
+		}
+	}
+}
+
+// 구간 상한은 값보다 작지 않고, 상대 오차는 1/64 이하
+func TestBucketUpperBound(t *testing.T) {
+	prev := 0
+	for v := int64(0); v < 1<<20; v += 1 + v/512 {
+		index := bucketIndex(v)
+		upper := bucketUpper(index)
+		if index < prev {
+			t.Fatalf("bucketIndex(%d) = %d, smaller than previous %d", v, index, prev)
+		}
+		if upper < v || float64(upper-v) > float64(v)/64 {
+			t.Fatalf("value %d: bucket upper %d", v, upper)
+		}
+		prev = index
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	var h histogram
+	if h.percentile(99) != 0 {
+		t.Fatal("empty histogram percentile should be 0")
+	}
+
+	// 1 ~ 1000µs 가 하나씩
+	for v := int64(1); v <= 1000; v++ {
+		h.record(v)
+	}
+
+	tests := []struct {
+		q    float64
+		want int64
+	}{
+		{q: 0, want: 1},
+		{q: 10, want: 100},
+		{q: 50, want: 503},   // 500 ~ 503 구간
+		{q: 99, want: 991},   // 984 ~ 991 구간
+		{q: 100, want: 1000}, // 구간 상한(1007)이 아니라 max
+	}
+	for _, tt := range tests {
+		if got := h.percentile(tt.q); got != tt.want {
+			t.Errorf("p%v = %d, want %d", tt.q, got, tt.want)
+		}
+	}
+	if h.total != 1000 || h.sum != 500500 || h.max != 1000 {
+		t.Errorf("total %d, sum %d, max %d", h.total, h.sum, h.max)
+	}
+}
@@ -2,13 +2,17 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"flag"
 	"fmt"
 	"github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1"
 	"github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1/v1connect"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/tracing"
 	"log"
 	"math/rand"
 	"net/http"
+	"os"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -25,15 +29,93 @@ var (
 	testTime     = flag.Duration("time", 1*time.Minute, "테스트 실행 시간")
 	startDateStr = flag.String("start-date", "", "캠페인 시작 날짜 (yyyy-mm-dd 형식, 기본값: 현재 날짜)")
 	endDateStr   = flag.String("end-date", "", "캠페인 종료 날짜 (yyyy-mm-dd 형식, 기본값: 하루 뒤)")
+	apiKey       = flag.String("api-key", "", "X-Api-Key 헤더로 보낼 API key (캠페인 생성에는 admin key 필요)")
+	bearerToken  = flag.String("token", "", "Authorization: Bearer 헤더로 보낼 JWT")
+	tenantId     = flag.String("tenant", "", "X-Tenant-Id 헤더로 보낼 tenant (기본값: 서버 기본 tenant)")
+	tlsCA        = flag.String("tls-ca", "", "https 서버 인증서 검증용 CA 파일 (기본값: 시스템 CA)")
+	tlsCert      = flag.String("tls-cert", "", "mTLS client 인증서 파일")
+	tlsKey       = flag.String("tls-key", "", "mTLS client 개인키 파일")
+	tlsInsecure  = flag.Bool("tls-insecure", false, "https 서버 인증서 검증 생략 (로컬 self-signed 인증서용)")
+	traceExport  = flag.String("trace-exporter", "none", "요청 span exporter (none, stdout, otlp), 서버로 trace context 전달")
+	traceAddr    = flag.String("trace-endpoint", "", "OTLP/HTTP collector 주소 (host:port)")
+	traceNoTLS   = flag.Bool("trace-insecure", false, "OTLP collector 로 TLS 없이 전송")
+	maxCoupons   = flag.Int64("max-coupon", 0, "캠페인별 쿠폰 수 (기본값: users 의 75%)")
+
+	// open loop : 서버 응답 속도와 관계없이 정해진 시각에 요청을 보냄
+	loadMode   = flag.String("mode", "closed", "closed (사용자마다 응답을 받고 100~300ms 뒤 다음 요청), open (정해진 초당 요청 수)")
+	targetRate = flag.Float64("rate", 500, "open : steady 구간의 초당 요청 수")
+	rampUp     = flag.Duration("ramp-up", 10*time.Second, "open : 0 에서 rate 까지 올리는 시간")
+	rampDown   = flag.Duration("ramp-down", 10*time.Second, "open : rate 에서 0 까지 내리는 시간")
+	numWorkers = flag.Int("workers", 1000, "open : 동시에 보낼 수 있는 최대 요청 수, 모두 사용중이면 그만큼 대기열에서 기다림")
+	issueRatio = flag.Float64("issue-ratio", 0.5, "open : 요청 중 IssueCoupon 비율 (나머지는 GetCampaign)")
 )
 
+// https 서버 주소면 TLS(+mTLS) 설정을 사용하는 transport 생성
+func newTransport() (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// 기본값(2)이면 동시 요청이 많을때 매번 연결을 새로 맺어서 연결 비용까지 응답 시간에 들어감
+	transport.MaxIdleConnsPerHost = max(*numUsers, *numWorkers)
+	if !strings.HasPrefix(*serverAddr, "https://") {
+		return transport, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: *tlsInsecure,
+	}
+
+	if *tlsCA != "" {
+		pem, err := os.ReadFile(*tlsCA)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("%s: 인증서를 찾을 수 없습니다", *tlsCA)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if *tlsCert != "" || *tlsKey != "" {
+		cert, err := tls.LoadX509KeyPair(*tlsCert, *tlsKey)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	transport.ForceAttemptHTTP2 = true
+	return transport, nil
+}
+
+// 인증/tenant 헤더를 모든 요청에 붙이는 client interceptor
+func authInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if *apiKey != "" {
+				req.Header().Set("X-Api-Key", *apiKey)
+			}
+			if *bearerToken != "" {
+				req.Header().Set("Authorization", "Bearer "+*bearerToken)
+			}
+			if *tenantId != "" {
+				req.Header().Set("X-Tenant-Id", *tenantId)
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
 // 결과 측정을 위한 카운터
 type Metrics struct {
 	// 총 요청 및 결과
 	totalRequests   int64
 	successRequests int64
 	failedRequests  int64
-	latencySum      int64 // 마이크로초 단위
+
+	// RPC 별 응답 시간 (실패 포함)
+	latency *latencyRecorder
 
 	// 요청 유형별 성공/실패 카운터
 	couponIssueSuccess    int64
@@ -53,6 +135,7 @@ type LoadTester struct {
 	campaignClient v1connect.CampaignServiceClient
 	couponClient   v1connect.CouponServiceClient
 	metrics        *Metrics
+	ledger         *couponLedger // 발급된 쿠폰 코드, 테스트가 끝난 뒤 검증
 	wg             *sync.WaitGroup
 	done           chan struct{}
 	campaigns      []string // 생성된 캠페인 ID 목록
@@ -65,11 +148,13 @@ func NewLoadTester(httpClient *http.Client) *LoadTester {
 
 	return &LoadTester{
 		// Connect RPC 클라이언트 생성
-		campaignClient: v1connect.NewCampaignServiceClient(httpClient, baseURL),
-		couponClient:   v1connect.NewCouponServiceClient(httpClient, baseURL),
+		campaignClient: v1connect.NewCampaignServiceClient(httpClient, baseURL, connect.WithInterceptors(tracing.NewInterceptor(), authInterceptor())),
+		couponClient:   v1connect.NewCouponServiceClient(httpClient, baseURL, connect.WithInterceptors(tracing.NewInterceptor(), authInterceptor())),
 		metrics: &Metrics{
+			latency:            newLatencyRecorder(),
 			exhaustedCampaigns: make(map[string]bool),
 		},
+		ledger:    newCouponLedger(),
 		wg:        &sync.WaitGroup{},
 		done:      make(chan struct{}),
 		campaigns: make([]string, 0, *numCampaigns),
@@ -88,56 +173,19 @@ func (lt *LoadTester) simulateUser(userId int, campaignId string) {
 			return
 		default:
 			// 쿠폰 발급 요청
-			start := time.Now()
-			err := lt.issueCoupon(campaignId)
-			latency := time.Since(start).Microseconds()
-
-			// 총 요청 수 증가
-			atomic.AddInt64(&lt.metrics.totalRequests, 1)
-
+			err := lt.issueCoupon(campaignId, userID)
+			if lt.countIssue(campaignId, err) {
+				return // 쿠폰이 더 이상 없으면 이 사용자의 테스트 종료
+			}
 			if err != nil {
-				// 쿠폰이 더 이상 없는 경우 처리
-				if strings.Contains(err.Error(), "no more available coupon") {
-					// 이 캠페인이 이미 소진 처리되었는지 확인
-					lt.metrics.exhaustedMutex.Lock()
-					if !lt.metrics.exhaustedCampaigns[campaignId] {
-						lt.metrics.exhaustedCampaigns[campaignId] = true
-						log.Printf("캠페인 %s의 쿠폰이 모두 소진되었습니다. (총 %d/%d 캠페인 소진)",
-							campaignId, len(lt.metrics.exhaustedCampaigns), len(lt.campaigns))
-					}
-					lt.metrics.exhaustedMutex.Unlock()
-
-					// 실패 요청으로 카운트
-					atomic.AddInt64(&lt.metrics.failedRequests, 1)
-					atomic.AddInt64(&lt.metrics.couponIssueFail, 1)
-					return // 이 사용자의 테스트 종료
-				}
-
 				log.Printf("사용자 %s 쿠폰 발급 실패: %v", userID, err)
-				atomic.AddInt64(&lt.metrics.failedRequests, 1)
-				atomic.AddInt64(&lt.metrics.couponIssueFail, 1)
-			} else {
-				atomic.AddInt64(&lt.metrics.successRequests, 1)
-				atomic.AddInt64(&lt.metrics.couponIssueSuccess, 1)
-				atomic.AddInt64(&lt.metrics.latencySum, latency)
 			}
 
 			// 캠페인 정보 조회
-			start = time.Now()
 			err = lt.getCampaign(campaignId)
-			latency = time.Since(start).Microseconds()
-
-			// 총 요청 수 증가
-			atomic.AddInt64(&lt.metrics.totalRequests, 1)
-
+			lt.countQuery(err)
 			if err != nil {
 				log.Printf("사용자 %s 캠페인 조회 실패: %v", userID, err)
-				atomic.AddInt64(&lt.metrics.failedRequests, 1)
-				atomic.AddInt64(&lt.metrics.campaignQueryFail, 1)
-			} else {
-				atomic.AddInt64(&lt.metrics.successRequests, 1)
-				atomic.AddInt64(&lt.metrics.campaignQuerySuccess, 1)
-				atomic.AddInt64(&lt.metrics.latencySum, latency)
 			}
 
 			// 잠시 대기 후 다음 요청 실행
@@ -147,6 +195,49 @@ func (lt *LoadTester) simulateUser(userId int, campaignId string) {
 	}
 }
 
+// countIssue : 쿠폰 발급 결과 카운트, 쿠폰이 소진되어 실패한 경우 true
+func (lt *LoadTester) countIssue(campaignId string, err error) bool {
+	// 총 요청 수 증가
+	atomic.AddInt64(&lt.metrics.totalRequests, 1)
+
+	if err == nil {
+		atomic.AddInt64(&lt.metrics.successRequests, 1)
+		atomic.AddInt64(&lt.metrics.couponIssueSuccess, 1)
+		return false
+	}
+
+	atomic.AddInt64(&lt.metrics.failedRequests, 1)
+	atomic.AddInt64(&lt.metrics.couponIssueFail, 1)
+
+	// 쿠폰이 더 이상 없는 경우 처리
+	if !soldOut(err) {
+		return false
+	}
+
+	// 이 캠페인이 이미 소진 처리되었는지 확인
+	lt.metrics.exhaustedMutex.Lock()
+	if !lt.metrics.exhaustedCampaigns[campaignId] {
+		lt.metrics.exhaustedCampaigns[campaignId] = true
+		log.Printf("캠페인 %s의 쿠폰이 모두 소진되었습니다. (총 %d/%d 캠페인 소진)",
+			campaignId, len(lt.metrics.exhaustedCampaigns), len(lt.campaigns))
+	}
+	lt.metrics.exhaustedMutex.Unlock()
+	return true
+}
+
+// countQuery : 캠페인 조회 결과 카운트
+func (lt *LoadTester) countQuery(err error) {
+	atomic.AddInt64(&lt.metrics.totalRequests, 1)
+
+	if err != nil {
+		atomic.AddInt64(&lt.metrics.failedRequests, 1)
+		atomic.AddInt64(&lt.metrics.campaignQueryFail, 1)
+		return
+	}
+	atomic.AddInt64(&lt.metrics.successRequests, 1)
+	atomic.AddInt64(&lt.metrics.campaignQuerySuccess, 1)
+}
+
 // 캠페인 생성 요청
 func (lt *LoadTester) createCampaign(campaignNum int) (string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -176,6 +267,9 @@ func (lt *LoadTester) createCampaign(campaignNum int) (string, error) {
 
 	// MaxCoupon을 users 수보다 적게 설정 (75%로 설정)
 	maxCoupon := int64(*numUsers * 3 / 4)
+	if *maxCoupons > 0 {
+		maxCoupon = *maxCoupons
+	}
 	if maxCoupon < 1 {
 		maxCoupon = 1 // 최소 1개 이상
 	}
@@ -190,47 +284,51 @@ func (lt *LoadTester) createCampaign(campaignNum int) (string, error) {
 	// 총 요청 수 증가
 	atomic.AddInt64(&lt.metrics.totalRequests, 1)
 
+	start := time.Now()
 	resp, err := lt.campaignClient.CreateCampaign(ctx, req)
-	if err != nil {
-		atomic.AddInt64(&lt.metrics.failedRequests, 1)
-		atomic.AddInt64(&lt.metrics.campaignCreateFail, 1)
-		return "", err
+	if err == nil && !resp.Msg.Result.Success {
+		err = &apiError{message: resp.Msg.Result.Message, code: resp.Msg.Result.ErrorCode}
 	}
+	lt.metrics.latency.record("CreateCampaign", time.Since(start), err)
 
-	// 성공 여부 확인
-	if !resp.Msg.Result.Success {
+	if err != nil {
 		atomic.AddInt64(&lt.metrics.failedRequests, 1)
 		atomic.AddInt64(&lt.metrics.campaignCreateFail, 1)
-		return "", fmt.Errorf("API 오류: %s (코드: %s)", resp.Msg.Result.Message, resp.Msg.Result.ErrorCode)
+		return "", err
 	}
 
 	atomic.AddInt64(&lt.metrics.successRequests, 1)
 	atomic.AddInt64(&lt.metrics.campaignCreateSuccess, 1)
+	lt.ledger.addCampaign(campaignId, maxCoupon)
 
 	log.Printf("캠페인 생성 완료 (ID: %s, 최대 쿠폰 수: %d)", campaignId, maxCoupon)
 	return campaignId, nil
 }
 
 // 쿠폰 발급 요청
-func (lt *LoadTester) issueCoupon(campaignId string) error {
+func (lt *LoadTester) issueCoupon(campaignId, userId string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	req := connect.NewRequest(&v1.IssueCouponReq{
 		CampaignId: campaignId,
+		UserId:     userId,
 	})
 
+	start := time.Now()
 	resp, err := lt.couponClient.IssueCoupon(ctx, req)
-	if err != nil {
-		return err
+	if err == nil && !resp.Msg.Result.Success {
+		err = &apiError{message: resp.Msg.Result.Message, code: resp.Msg.Result.ErrorCode}
 	}
+	lt.metrics.latency.record("IssueCoupon", time.Since(start), err)
 
-	// 성공 여부 확인
-	if !resp.Msg.Result.Success {
-		return fmt.Errorf("API 오류: %s (코드: %s)", resp.Msg.Result.Message, resp.Msg.Result.ErrorCode)
+	var couponCode string
+	if err == nil {
+		couponCode = resp.Msg.CouponCode
 	}
+	lt.ledger.record(campaignId, userId, couponCode, start, err)
 
-	return nil
+	return err
 }
 
 // 캠페인 조회 요청
@@ -242,17 +340,14 @@ func (lt *LoadTester) getCampaign(campaignId string) error {
 		CampaignId: campaignId,
 	})
 
+	start := time.Now()
 	resp, err := lt.campaignClient.GetCampaign(ctx, req)
-	if err != nil {
-		return err
+	if err == nil && !resp.Msg.Result.Success {
+		err = &apiError{message: resp.Msg.Result.Message, code: resp.Msg.Result.ErrorCode}
 	}
+	lt.metrics.latency.record("GetCampaign", time.Since(start), err)
 
-	// 성공 여부 확인
-	if !resp.Msg.Result.Success {
-		return fmt.Errorf("API 오류: %s (코드: %s)", resp.Msg.Result.Message, resp.Msg.Result.ErrorCode)
-	}
-
-	return nil
+	return err
 }
 
 // 테스트 실행
@@ -284,6 +379,11 @@ func (lt *LoadTester) RunTest() {
 		return
 	}
 
+	if *loadMode == "open" {
+		lt.runOpenLoop()
+		return
+	}
+
 	// 사용자 시작 (캠페인 수에 따라 캠페인을 분배)
 	for i := 0; i < *numUsers; i++ {
 		// 캠페인 ID 선택 (라운드 로빈 방식)
@@ -321,6 +421,9 @@ func (lt *LoadTester) RunTest() {
 			log.Printf("진행: %.1f%% | 요청: %d | 성공: %d | 실패: %d | RPS: %.1f | 쿠폰 소진 캠페인: %d/%d",
 				time.Since(startTime).Seconds()/testTime.Seconds()*100,
 				total, success, failed, rps, exhaustedCount, len(lt.campaigns))
+			for _, line := range lt.metrics.latency.intervalReport() {
+				log.Print(line)
+			}
 
 			// 모든 캠페인의 쿠폰이 소진되면 테스트 종료
 			if exhaustedCount >= len(lt.campaigns) {
@@ -357,11 +460,6 @@ func (lt *LoadTester) printResults(elapsedSeconds float64) {
 	campaignCreateSuccess := atomic.LoadInt64(&lt.metrics.campaignCreateSuccess)
 	campaignCreateFail := atomic.LoadInt64(&lt.metrics.campaignCreateFail)
 
-	var avgLatency int64
-	if success > 0 {
-		avgLatency = atomic.LoadInt64(&lt.metrics.latencySum) / success
-	}
-
 	rps := float64(total) / elapsedSeconds
 
 	// 소진된 캠페인 수 확인
@@ -375,12 +473,12 @@ func (lt *LoadTester) printResults(elapsedSeconds float64) {
 	fmt.Printf("성공 요청: %d (%.2f%%)\n", success, float64(success)/float64(total)*100)
 	fmt.Printf("실패 요청: %d (%.2f%%)\n", failed, float64(failed)/float64(total)*100)
 	fmt.Printf("초당 요청 수(RPS): %.2f\n", rps)
-	fmt.Printf("평균 응답 시간: %d µs\n", avgLatency)
 	fmt.Printf("쿠폰 소진 캠페인 수: %d/%d\n", exhaustedCount, len(lt.campaigns))
 	fmt.Println("\n--- 요청 유형별 성공/실패 ---")
 	fmt.Printf("캠페인 생성: %d 성공, %d 실패\n", campaignCreateSuccess, campaignCreateFail)
 	fmt.Printf("쿠폰 발급: %d 성공, %d 실패\n", couponSuccess, couponFail)
 	fmt.Printf("캠페인 조회: %d 성공, %d 실패\n", campaignQuerySuccess, campaignQueryFail)
+	lt.metrics.latency.printSummary()
 	fmt.Println("====================================")
 }
 
@@ -393,12 +491,46 @@ func main() {
 		*numCampaigns = 1
 	}
 
+	switch {
+	case *loadMode != "closed" && *loadMode != "open":
+		log.Fatalf("mode 는 closed, open 중 하나여야 합니다: %s", *loadMode)
+	case *loadMode == "open" && (*targetRate <= 0 || *numWorkers <= 0 || *rampUp < 0 || *rampDown < 0):
+		log.Fatalf("open mode 에는 0 보다 큰 rate, workers 가 필요합니다")
+	case *issueRatio < 0 || *issueRatio > 1:
+		log.Fatalf("issue-ratio 는 0 ~ 1 사이여야 합니다: %v", *issueRatio)
+	}
+
+	// 요청마다 client span 을 만들고 traceparent 헤더로 서버에 전달
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.Options{
+		ServiceName: "coupon-load-tester",
+		Exporter:    *traceExport,
+		Endpoint:    *traceAddr,
+		Insecure:    *traceNoTLS,
+		SampleRatio: 1,
+	})
+	if err != nil {
+		log.Fatalf("tracing 설정 오류: %v", err)
+	}
+	defer shutdownTracing(context.Background())
+
 	// HTTP 클라이언트 생성
+	transport, err := newTransport()
+	if err != nil {
+		log.Fatalf("TLS 설정 오류: %v", err)
+	}
+
 	httpClient := &http.Client{
-		Timeout: 10 * time.Second,
+		Timeout:   10 * time.Second,
+		Transport: transport,
 	}
 
 	// 테스트 실행
 	tester := NewLoadTester(httpClient)
 	tester.RunTest()
+
+	// 중복 발급, maxCoupon 초과 등이 있으면 exit code 1
+	if !tester.verify() {
+		shutdownTracing(context.Background())
+		os.Exit(1)
+	}
 }
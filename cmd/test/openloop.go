@@ -0,0 +1,212 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// stage : duration 동안 초당 요청 수를 from 에서 to 까지 일정하게 바꿈
+type stage struct {
+	name     string
+	duration time.Duration
+	from, to float64
+
+	// 결과
+	scheduled int64 // 보내야 했던 요청 수
+	sent      int64 // 대기열에 넣은 요청 수
+	missed    int64 // 대기열이 가득 차서 보내지 못한 요청 수
+	completed int64 // 응답을 받은 요청 수
+}
+
+// arrivals : 구간 동안 보내야 하는 요청 수 (소수)
+func (s *stage) arrivals() float64 {
+	return (s.from + s.to) / 2 * s.duration.Seconds()
+}
+
+// at : 구간 시작부터 n 번째 요청을 보낼 시점
+// 보낸 요청 수 N(t) = from*t + (to-from)*t²/(2*duration) 을 t 에 대해 푼 것
+func (s *stage) at(n float64) time.Duration {
+	a := (s.to - s.from) / (2 * s.duration.Seconds())
+	b := s.from
+	return time.Duration(2 * n / (b + math.Sqrt(max(b*b+4*a*n, 0))) * float64(time.Second))
+}
+
+// rate : 구간 시작부터 elapsed 시점의 초당 요청 수
+func (s *stage) rate(elapsed time.Duration) float64 {
+	return s.from + (s.to-s.from)*elapsed.Seconds()/s.duration.Seconds()
+}
+
+// arrival : 예정된 요청 하나
+type arrival struct {
+	seq      int64
+	intended time.Time
+	stage    *stage
+}
+
+// openLoopStats : 예정 시각 대비 실제로 보낸 시각까지의 대기 시간
+type openLoopStats struct {
+	queueDelay         histogram // 예정 시각 → 실제 요청 시작
+	responseTime       histogram // 예정 시각 → 응답 (coordinated omission 보정)
+	intervalQueueDelay histogram
+	mutex              sync.Mutex
+}
+
+func (s *openLoopStats) record(queueDelay, responseTime time.Duration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.queueDelay.record(queueDelay.Microseconds())
+	s.intervalQueueDelay.record(queueDelay.Microseconds())
+	s.responseTime.record(responseTime.Microseconds())
+}
+
+// runOpenLoop : ramp-up, steady, ramp-down 구간의 초당 요청 수에 맞춰 요청을 보냄
+// 응답을 기다리지 않기 때문에 서버가 느려지면 workers 가 모두 사용중이 되고, 대기열이 차면 그 요청은 보내지 못함(missed)
+func (lt *LoadTester) runOpenLoop() {
+	stages := []*stage{
+		{name: "ramp-up", duration: *rampUp, from: 0, to: *targetRate},
+		{name: "steady", duration: *testTime, from: *targetRate, to: *targetRate},
+		{name: "ramp-down", duration: *rampDown, from: *targetRate, to: 0},
+	}
+	log.Printf("open loop 시작: 초당 %.0f 요청, ramp-up %v, steady %v, ramp-down %v, workers %d",
+		*targetRate, *rampUp, *testTime, *rampDown, *numWorkers)
+
+	stats := &openLoopStats{}
+	// 대기열 크기는 workers 와 같게, 모든 worker 가 사용중이어도 그만큼은 기다렸다가 보냄
+	queue := make(chan arrival, *numWorkers)
+	var workers sync.WaitGroup
+	for i := 0; i < *numWorkers; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for a := range queue {
+				lt.sendArrival(a, stats)
+			}
+		}()
+	}
+
+	startTime := time.Now()
+	done := make(chan struct{})
+	go lt.reportOpenLoop(startTime, stages, stats, done)
+
+	var seq int64
+	stageStart := startTime
+	for _, st := range stages {
+		if st.duration <= 0 {
+			continue
+		}
+
+		// 늦어진 경우 바로 보내서 따라잡고, 대기열까지 차면 누락으로 셈
+		total := st.arrivals()
+		for n := 1.0; n <= total; n++ {
+			intended := stageStart.Add(st.at(n))
+			if wait := time.Until(intended); wait > 0 {
+				time.Sleep(wait)
+			}
+
+			st.scheduled++
+			seq++
+			select {
+			case queue <- arrival{seq: seq, intended: intended, stage: st}:
+				atomic.AddInt64(&st.sent, 1)
+			default:
+				atomic.AddInt64(&st.missed, 1)
+			}
+		}
+		stageStart = stageStart.Add(st.duration)
+	}
+
+	close(queue)
+	log.Println("요청 예정 시각 종료, 남은 요청 대기 중...")
+	workers.Wait()
+	close(done)
+
+	lt.printResults(time.Since(startTime).Seconds())
+	printOpenLoopResults(stages, stats)
+}
+
+// sendArrival : issue-ratio 에 맞춰 IssueCoupon 또는 GetCampaign 요청
+func (lt *LoadTester) sendArrival(a arrival, stats *openLoopStats) {
+	campaignId := lt.campaigns[int(a.seq)%len(lt.campaigns)]
+	userID := fmt.Sprintf("user-%d", a.seq%int64(*numUsers))
+	queueDelay := time.Since(a.intended)
+
+	// seq 마다 누적 비율이 넘어갈 때 발급 요청 (0.5 면 번갈아서)
+	ratio := *issueRatio
+	if math.Floor(float64(a.seq)*ratio) > math.Floor(float64(a.seq-1)*ratio) {
+		lt.countIssue(campaignId, lt.issueCoupon(campaignId, userID))
+	} else {
+		lt.countQuery(lt.getCampaign(campaignId))
+	}
+
+	stats.record(queueDelay, time.Since(a.intended))
+	atomic.AddInt64(&a.stage.completed, 1)
+}
+
+// reportOpenLoop : 5초마다 목표 / 실제 초당 요청 수, 누락, 대기 시간 출력
+func (lt *LoadTester) reportOpenLoop(startTime time.Time, stages []*stage, stats *openLoopStats, done <-chan struct{}) {
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	var lastSent, lastMissed int64
+	lastTime := startTime
+	for {
+		select {
+		case <-done:
+			return
+		case now := <-ticker.C:
+			// 지금 구간과 목표 초당 요청 수
+			current, target, offset := "done", 0.0, time.Duration(0)
+			for _, st := range stages {
+				if elapsed := now.Sub(startTime) - offset; elapsed < st.duration {
+					current, target = st.name, st.rate(elapsed)
+					break
+				}
+				offset += st.duration
+			}
+
+			var sent, missed int64
+			for _, st := range stages {
+				sent += atomic.LoadInt64(&st.sent)
+				missed += atomic.LoadInt64(&st.missed)
+			}
+			interval := now.Sub(lastTime).Seconds()
+
+			stats.mutex.Lock()
+			queueDelay := stats.intervalQueueDelay.summary()
+			stats.intervalQueueDelay = histogram{}
+			stats.mutex.Unlock()
+
+			log.Printf("[%s] 목표 RPS: %.1f | 보낸 RPS: %.1f | 누락: %d (누적 %d) | 요청: %d | 실패: %d",
+				current, target, float64(sent-lastSent)/interval, missed-lastMissed, missed,
+				atomic.LoadInt64(&lt.metrics.totalRequests), atomic.LoadInt64(&lt.metrics.failedRequests))
+			log.Printf("  대기 시간 %s", queueDelay)
+			for _, line := range lt.metrics.latency.intervalReport() {
+				log.Print(line)
+			}
+			lastSent, lastMissed, lastTime = sent, missed, now
+		}
+	}
+}
+
+func printOpenLoopResults(stages []*stage, stats *openLoopStats) {
+	fmt.Println("\n--- open loop 구간별 결과 ---")
+	fmt.Printf("%-10s %10s %10s %10s %10s %10s %10s\n", "구간", "시간", "목표 RPS", "예정", "보냄", "누락", "처리 RPS")
+	for _, st := range stages {
+		if st.duration <= 0 {
+			continue
+		}
+		fmt.Printf("%-10s %10v %10.1f %10d %10d %10d %10.1f\n",
+			st.name, st.duration, st.arrivals()/st.duration.Seconds(), st.scheduled, st.sent, st.missed,
+			float64(st.completed)/st.duration.Seconds())
+	}
+
+	stats.mutex.Lock()
+	defer stats.mutex.Unlock()
+	fmt.Printf("\n대기 시간 (예정 시각 → 요청 시작): %s\n", stats.queueDelay.summary())
+	fmt.Printf("응답 시간 (예정 시각 → 응답): %s\n", stats.responseTime.summary())
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestStageSchedule(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to float64
+		arrivals float64
+	}{
+		{name: "ramp-up", from: 0, to: 100, arrivals: 500},
+		{name: "steady", from: 100, to: 100, arrivals: 1000},
+		{name: "ramp-down", from: 100, to: 0, arrivals: 500},
+		{name: "partial ramp", from: 20, to: 60, arrivals: 400},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := &stage{name: tt.name, duration: 10 * time.Second, from: tt.from, to: tt.to}
+			if got := st.arrivals(); math.Abs(got-tt.arrivals) > 1e-9 {
+				t.Fatalf("arrivals = %v, want %v", got, tt.arrivals)
+			}
+
+			// 마지막 요청은 구간이 끝날 때, 요청 시점은 점점 늦어짐
+			if got := st.at(tt.arrivals); (got - st.duration).Abs() > time.Millisecond {
+				t.Errorf("at(%v) = %v, want %v", tt.arrivals, got, st.duration)
+			}
+			prev := time.Duration(0)
+			for n := 1.0; n <= tt.arrivals; n++ {
+				at := st.at(n)
+				if at < prev || at > st.duration+time.Millisecond {
+					t.Fatalf("at(%v) = %v after %v", n, at, prev)
+				}
+				prev = at
+			}
+
+			// at(n) 까지 보낸 요청 수는 n : from*t + (to-from)*t²/(2*duration)
+			for _, n := range []float64{1, tt.arrivals / 4, tt.arrivals / 2} {
+				elapsed := st.at(n).Seconds()
+				sent := tt.from*elapsed + (tt.to-tt.from)*elapsed*elapsed/(2*st.duration.Seconds())
+				if math.Abs(sent-n) > 0.01 {
+					t.Errorf("N(at(%v)) = %v", n, sent)
+				}
+			}
+
+			if got := st.rate(st.duration / 2); math.Abs(got-(tt.from+tt.to)/2) > 1e-9 {
+				t.Errorf("rate at half = %v, want %v", got, (tt.from+tt.to)/2)
+			}
+		})
+	}
+}
@@ -0,0 +1,290 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1"
+)
+
+// issuedCoupon : client 가 받은 발급 성공 응답
+type issuedCoupon struct {
+	code   string
+	userId string
+	start  time.Time // 요청을 보낸 시각
+}
+
+// campaignLedger : 캠페인 하나에 대해 client 가 받은 발급 결과
+type campaignLedger struct {
+	maxCoupon   int64
+	issued      []issuedCoupon
+	exhaustedAt time.Time // 처음으로 쿠폰 소진 응답을 받은 시각
+	unknown     int64     // timeout 등으로 서버에서 발급됐는지 알 수 없는 요청 수
+}
+
+// couponLedger : 발급된 쿠폰 코드를 모아서 테스트가 끝난 뒤 검증함
+type couponLedger struct {
+	campaigns map[string]*campaignLedger
+	mutex     sync.Mutex
+}
+
+func newCouponLedger() *couponLedger {
+	return &couponLedger{campaigns: make(map[string]*campaignLedger)}
+}
+
+func (l *couponLedger) addCampaign(campaignId string, maxCoupon int64) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.campaigns[campaignId] = &campaignLedger{maxCoupon: maxCoupon}
+}
+
+// record : 발급 요청 결과, 응답을 받은 시각 순서가 아니라 요청 시각으로 비교하기 때문에 끝난 뒤에 검증함
+func (l *couponLedger) record(campaignId, userId, code string, start time.Time, err error) {
+	end := time.Now()
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	ledger, ok := l.campaigns[campaignId]
+	if !ok {
+		return
+	}
+
+	var ae *apiError
+	switch {
+	case err == nil:
+		ledger.issued = append(ledger.issued, issuedCoupon{code: code, userId: userId, start: start})
+	case !errors.As(err, &ae):
+		ledger.unknown++
+	case soldOut(err):
+		if ledger.exhaustedAt.IsZero() || end.Before(ledger.exhaustedAt) {
+			ledger.exhaustedAt = end
+		}
+	}
+}
+
+// soldOutCode : 서버가 쿠폰 소진 응답의 Result.ErrorCode 로 보내는 값 (cache.ErrorReason)
+const soldOutCode = "no_more_coupon"
+
+// soldOut : 쿠폰이 소진되어 실패한 응답인지, 메시지가 아니라 ErrorCode 로 확인함
+func soldOut(err error) bool {
+	var ae *apiError
+	return errors.As(err, &ae) && ae.code == soldOutCode
+}
+
+// checkStatus : 확인하지 못한 항목(skip)은 위반(fail)과 따로 보여주고 exit code 에 반영하지 않음
+type checkStatus string
+
+const (
+	checkPass checkStatus = "PASS"
+	checkFail checkStatus = "FAIL"
+	checkSkip checkStatus = "SKIP"
+)
+
+// checkResult : 검증 항목 하나의 결과
+type checkResult struct {
+	name   string
+	status checkStatus
+	detail string
+}
+
+// passIf : 조건으로 PASS / FAIL 결정
+func passIf(ok bool) checkStatus {
+	if ok {
+		return checkPass
+	}
+	return checkFail
+}
+
+// verify : 캠페인별 검증 결과를 출력하고, 모두 통과하면 true
+func (lt *LoadTester) verify() bool {
+	lt.ledger.mutex.Lock()
+	defer lt.ledger.mutex.Unlock()
+
+	if len(lt.ledger.campaigns) == 0 {
+		return true
+	}
+
+	failed, skipped := 0, 0
+	fmt.Println("\n========== 발급 결과 검증 ==========")
+	for _, campaignId := range lt.campaigns {
+		ledger := lt.ledger.campaigns[campaignId]
+		fmt.Printf("%s (maxCoupon %d)\n", campaignId, ledger.maxCoupon)
+
+		for _, check := range lt.checkCampaign(campaignId, ledger) {
+			switch check.status {
+			case checkFail:
+				failed++
+			case checkSkip:
+				skipped++
+			}
+			fmt.Printf("  [%s] %s : %s\n", check.status, check.name, check.detail)
+		}
+	}
+
+	switch {
+	case failed > 0:
+		fmt.Printf("결과: FAIL (위반 %d개, 확인하지 못한 항목 %d개)\n", failed, skipped)
+	case skipped > 0:
+		fmt.Printf("결과: PASS (확인하지 못한 항목 %d개)\n", skipped)
+	default:
+		fmt.Println("결과: PASS")
+	}
+	fmt.Println("====================================")
+	return failed == 0
+}
+
+func (lt *LoadTester) checkCampaign(campaignId string, ledger *campaignLedger) []checkResult {
+	checks, owners := ledger.check()
+
+	// 테스트가 끝난 뒤 서버 상태와 client 가 받은 결과가 맞는지
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	codes, codesCheck := lt.checkCampaignCodes(ctx, campaignId, owners)
+	return append(checks, codesCheck, lt.checkServerCounts(ctx, campaignId, ledger, codes))
+}
+
+// check : client 가 받은 결과만으로 확인할 수 있는 항목, 받은 코드별 사용자 목록을 같이 반환함
+func (c *campaignLedger) check() ([]checkResult, map[string][]string) {
+	successes := int64(len(c.issued))
+	var checks []checkResult
+
+	// 같은 코드가 두번 이상 발급되지 않았는지
+	owners := make(map[string][]string, len(c.issued))
+	for _, issued := range c.issued {
+		owners[issued.code] = append(owners[issued.code], issued.userId)
+	}
+	var duplicates []string
+	for code, users := range owners {
+		if len(users) > 1 {
+			duplicates = append(duplicates, fmt.Sprintf("%s → %s", code, strings.Join(users, ", ")))
+		}
+	}
+	checks = append(checks, checkResult{
+		name:   "중복 발급 없음",
+		status: passIf(len(duplicates) == 0),
+		detail: fmt.Sprintf("코드 %d개, 중복 %d개%s", len(owners), len(duplicates), examples(duplicates)),
+	})
+
+	// 발급 성공 수가 maxCoupon 을 넘지 않는지
+	checks = append(checks, checkResult{
+		name:   "발급 수 <= maxCoupon",
+		status: passIf(successes <= c.maxCoupon),
+		detail: fmt.Sprintf("%d / %d", successes, c.maxCoupon),
+	})
+
+	// 소진 응답을 받은 뒤에 보낸 요청이 발급되지 않았는지
+	if c.exhaustedAt.IsZero() {
+		checks = append(checks, checkResult{name: "소진 이후 발급 없음", status: checkPass, detail: "소진되지 않음"})
+	} else {
+		var late []string
+		for _, issued := range c.issued {
+			if issued.start.After(c.exhaustedAt) {
+				late = append(late, fmt.Sprintf("%s (%s 요청)", issued.code, issued.start.Format("15:04:05.000")))
+			}
+		}
+		checks = append(checks, checkResult{
+			name:   "소진 이후 발급 없음",
+			status: passIf(len(late) == 0),
+			detail: fmt.Sprintf("소진 확인 %s, 이후에 보낸 요청의 발급 %d개%s", c.exhaustedAt.Format("15:04:05.000"), len(late), examples(late)),
+		})
+	}
+
+	return checks, owners
+}
+
+// skipped : 조회에 실패해서 확인하지 못한 항목, 권한이 없으면 그렇다고 알려줌
+func skipped(name, rpc string, err error) checkResult {
+	switch connect.CodeOf(err) {
+	case connect.CodePermissionDenied, connect.CodeUnauthenticated:
+		return checkResult{name: name, status: checkSkip, detail: fmt.Sprintf("%s 권한이 없어 확인할 수 없음 (admin api-key 필요)", rpc)}
+	}
+	return checkResult{name: name, status: checkSkip, detail: fmt.Sprintf("%s 실패로 확인할 수 없음: %v", rpc, err)}
+}
+
+// checkCampaignCodes : 받은 코드가 모두 캠페인 쿠폰인지, 캠페인 코드 수를 같이 반환함
+// GetCampaign 은 client 도 호출할 수 있지만 코드 목록은 admin 에게만 내려옴
+func (lt *LoadTester) checkCampaignCodes(ctx context.Context, campaignId string, owners map[string][]string) (int64, checkResult) {
+	const name = "받은 코드가 캠페인 쿠폰"
+
+	campaign, err := lt.campaignClient.GetCampaign(ctx, connect.NewRequest(&v1.GetCampaignReq{CampaignId: campaignId}))
+	if err == nil && !campaign.Msg.Result.Success {
+		err = &apiError{message: campaign.Msg.Result.Message, code: campaign.Msg.Result.ErrorCode}
+	}
+	if err != nil {
+		return -1, skipped(name, "GetCampaign", err)
+	}
+
+	if len(campaign.Msg.Info.AllCouponIds) == 0 && campaign.Msg.Info.CouponCount > 0 {
+		return campaign.Msg.Info.CouponCount, checkResult{name: name, status: checkSkip, detail: "GetCampaign 코드 목록 권한이 없어 확인할 수 없음 (admin api-key 필요)"}
+	}
+
+	known := make(map[string]bool, len(campaign.Msg.Info.AllCouponIds))
+	for _, code := range campaign.Msg.Info.AllCouponIds {
+		known[code] = true
+	}
+	var foreign []string
+	for code := range owners {
+		if !known[code] {
+			foreign = append(foreign, code)
+		}
+	}
+
+	return int64(len(known)), checkResult{
+		name:   name,
+		status: passIf(len(foreign) == 0),
+		detail: fmt.Sprintf("캠페인 코드 %d개, 받은 코드 %d개 중 캠페인에 없는 코드 %d개%s", len(known), len(owners), len(foreign), examples(foreign)),
+	}
+}
+
+// checkServerCounts : 서버의 발급 수가 client 성공 수와 맞는지 (GetCampaignStats 는 admin 전용)
+// 서버 발급 수(issued)는 발급 대기 목록에서 나간 쿠폰 수라서 예약(held), 사용(redeemed)된 쿠폰도 포함함
+// timeout 등으로 결과를 모르는 요청은 서버에서 발급됐을 수 있어서 그만큼은 허용함
+func (lt *LoadTester) checkServerCounts(ctx context.Context, campaignId string, ledger *campaignLedger, campaignCodes int64) checkResult {
+	const name = "서버 발급 수 일치"
+
+	stats, err := lt.campaignClient.GetCampaignStats(ctx, connect.NewRequest(&v1.GetCampaignStatsReq{CampaignId: campaignId}))
+	if err == nil && !stats.Msg.Result.Success {
+		err = &apiError{message: stats.Msg.Result.Message, code: stats.Msg.Result.ErrorCode}
+	}
+	if err != nil {
+		return skipped(name, "GetCampaignStats", err)
+	}
+	if len(stats.Msg.Campaigns) != 1 {
+		return checkResult{name: name, status: checkSkip, detail: "GetCampaignStats 응답에 캠페인이 없음"}
+	}
+	server := stats.Msg.Campaigns[0]
+
+	successes := int64(len(ledger.issued))
+	ok := successes <= server.Issued && server.Issued <= successes+ledger.unknown &&
+		server.Remaining == server.MaxCoupon-server.Issued
+	// GetCampaign 을 확인하지 못했으면 캠페인 코드 수는 비교하지 않음
+	if campaignCodes >= 0 {
+		ok = ok && campaignCodes == server.MaxCoupon
+	}
+
+	return checkResult{
+		name:   name,
+		status: passIf(ok),
+		detail: fmt.Sprintf("서버 발급 %d (예약 %d, 사용 %d 포함), 남은 쿠폰 %d / client 성공 %d, 결과를 모르는 요청 %d",
+			server.Issued, server.Held, server.Redeemed, server.Remaining, successes, ledger.unknown),
+	}
+}
+
+// examples : 실패한 경우 앞의 몇 개만 보여줌
+func examples(items []string) string {
+	if len(items) == 0 {
+		return ""
+	}
+	if len(items) > 3 {
+		return fmt.Sprintf(" (%s 외 %d개)", strings.Join(items[:3], "; "), len(items)-3)
+	}
+	return " (" + strings.Join(items, "; ") + ")"
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+func TestLedgerRecord(t *testing.T) {
+	start := time.Now()
+
+	tests := []struct {
+		name      string
+		err       error
+		issued    int
+		unknown   int64
+		exhausted bool
+	}{
+		{name: "issued", issued: 1},
+		{name: "sold out by error code", err: &apiError{message: "no more available coupon", code: soldOutCode}, exhausted: true},
+		{name: "message alone is not sold out", err: &apiError{message: "no more available coupon"}},
+		{name: "other failure", err: &apiError{message: "campaign issuance is paused", code: "campaign_paused"}},
+		{name: "no response", err: connect.NewError(connect.CodeDeadlineExceeded, errors.New("timeout")), unknown: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newCouponLedger()
+			l.addCampaign("c1", 10)
+			l.record("c1", "u1", "CODE-1", start, tt.err)
+
+			ledger := l.campaigns["c1"]
+			if len(ledger.issued) != tt.issued || ledger.unknown != tt.unknown || ledger.exhaustedAt.IsZero() == tt.exhausted {
+				t.Errorf("issued %d, unknown %d, exhausted %v, want %d, %d, %v",
+					len(ledger.issued), ledger.unknown, !ledger.exhaustedAt.IsZero(), tt.issued, tt.unknown, tt.exhausted)
+			}
+		})
+	}
+}
+
+func TestCampaignLedgerCheck(t *testing.T) {
+	at := time.Date(2025, 5, 12, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		ledger campaignLedger
+		want   []checkStatus // 중복, maxCoupon, 소진 이후 발급 순서
+	}{
+		{
+			name:   "ok",
+			ledger: campaignLedger{maxCoupon: 2, issued: []issuedCoupon{{"A", "u1", at}, {"B", "u2", at}}, exhaustedAt: at.Add(time.Second)},
+			want:   []checkStatus{checkPass, checkPass, checkPass},
+		},
+		{
+			name:   "duplicate code",
+			ledger: campaignLedger{maxCoupon: 2, issued: []issuedCoupon{{"A", "u1", at}, {"A", "u2", at}}},
+			want:   []checkStatus{checkFail, checkPass, checkPass},
+		},
+		{
+			name:   "over max coupon",
+			ledger: campaignLedger{maxCoupon: 1, issued: []issuedCoupon{{"A", "u1", at}, {"B", "u2", at}}},
+			want:   []checkStatus{checkPass, checkFail, checkPass},
+		},
+		{
+			name:   "issued after sold out",
+			ledger: campaignLedger{maxCoupon: 2, issued: []issuedCoupon{{"A", "u1", at}, {"B", "u2", at.Add(2 * time.Second)}}, exhaustedAt: at.Add(time.Second)},
+			want:   []checkStatus{checkPass, checkPass, checkFail},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checks, _ := tt.ledger.check()
+			if len(checks) != len(tt.want) {
+				t.Fatalf("%d checks, want %d", len(checks), len(tt.want))
+			}
+			for i, check := range checks {
+				if check.status != tt.want[i] {
+					t.Errorf("%s = %s (%s), want %s", check.name, check.status, check.detail, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSkippedCheck(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{name: "permission denied", err: connect.NewError(connect.CodePermissionDenied, errors.New("admin only"))},
+		{name: "unauthenticated", err: connect.NewError(connect.CodeUnauthenticated, errors.New("no key"))},
+		{name: "unavailable", err: connect.NewError(connect.CodeUnavailable, errors.New("down"))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 확인하지 못한 항목은 위반으로 세지 않음
+			if check := skipped("서버 발급 수 일치", "GetCampaignStats", tt.err); check.status != checkSkip {
+				t.Errorf("status = %s, want %s", check.status, checkSkip)
+			}
+		})
+	}
+}
@@ -0,0 +1,314 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/auth"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/cache"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/logging"
+)
+
+const (
+	ActorAnonymous = "anonymous" // 인증이 꺼져있을 때
+	ActorSystem    = "system"    // janitor 등 RPC 밖에서 일어난 변경
+)
+
+// Entry : JSON Lines 파일 한줄
+type Entry struct {
+	Seq        int64          `json:"seq"`
+	Time       time.Time      `json:"time"`
+	Actor      string         `json:"actor"`
+	ActorRole  string         `json:"actorRole,omitempty"`
+	TenantId   string         `json:"tenantId"`
+	RPC        string         `json:"rpc,omitempty"`
+	RequestId  string         `json:"requestId,omitempty"`
+	Action     string         `json:"action"`
+	CampaignId string         `json:"campaignId"`
+	CouponCode string         `json:"couponCode,omitempty"`
+	Before     map[string]any `json:"before,omitempty"`
+	After      map[string]any `json:"after,omitempty"`
+}
+
+// Filter : 비어있는 조건은 적용하지 않음
+type Filter struct {
+	TenantId   string
+	CampaignId string
+	CouponCode string
+	Actor      string
+	Since      time.Time // 포함
+	Until      time.Time // 미포함
+	AfterSeq   int64
+}
+
+func (f *Filter) Match(e *Entry) bool {
+	switch {
+	case e.Seq <= f.AfterSeq:
+	case f.TenantId != "" && e.TenantId != f.TenantId:
+	case f.CampaignId != "" && e.CampaignId != f.CampaignId:
+	case f.CouponCode != "" && e.CouponCode != f.CouponCode:
+	case f.Actor != "" && e.Actor != f.Actor:
+	case !f.Since.IsZero() && e.Time.Before(f.Since):
+	case !f.Until.IsZero() && !e.Time.Before(f.Until):
+	default:
+		return true
+	}
+	return false
+}
+
+// Log : 추가만 가능한 감사 이력
+// path 가 있으면 JSON Lines 파일에 한줄씩 덧붙이고 조회할때 파일을 읽음, 없으면 메모리에 최근 maxEntries 건만 보관
+// Record 는 캠페인 lock 을 잡은 상태에서 호출되기 때문에 seq 만 붙여서 queue 에 넣고, 파일 쓰기는 writer goroutine 이 함
+type Log struct {
+	path       string
+	file       *os.File
+	entries    []Entry
+	maxEntries int
+	seq        int64
+	pending    []Entry // 아직 파일에 쓰지 않은 이력
+	closed     bool
+	mutex      sync.Mutex
+
+	writeMutex sync.Mutex // flush 끼리 순서를 지킴 (pending 을 꺼낸 순서대로 씀)
+	wake       chan struct{}
+	stop       chan struct{}
+	done       chan struct{}
+}
+
+var ErrClosed = errors.New("audit log is closed")
+
+func Open(path string, maxEntries int) (*Log, error) {
+	l := &Log{path: path, maxEntries: maxEntries}
+	if path == "" {
+		return l, nil
+	}
+
+	// 기존 파일의 마지막 seq 이어서 사용
+	err := l.scan(func(e *Entry) error {
+		l.seq = e.Seq
+		return nil
+	})
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	l.file, err = os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	l.wake = make(chan struct{}, 1)
+	l.stop = make(chan struct{})
+	l.done = make(chan struct{})
+	go l.writer()
+	return l, nil
+}
+
+// Record : cache.CampaignManager.SetAuditor 에 넘김
+func (l *Log) Record(ctx context.Context, event cache.AuditEvent) {
+	entry := Entry{
+		Time:       time.Now(),
+		Actor:      ActorSystem,
+		TenantId:   event.TenantId,
+		RPC:        logging.Field(ctx, "rpc"),
+		RequestId:  logging.Field(ctx, "requestId"),
+		Action:     event.Action,
+		CampaignId: event.CampaignId,
+		CouponCode: event.CouponCode,
+		Before:     event.Before,
+		After:      event.After,
+	}
+
+	if p := auth.FromContext(ctx); p != nil {
+		entry.Actor = p.Subject
+		entry.ActorRole = string(p.Role)
+	} else if entry.RPC != "" {
+		entry.Actor = ActorAnonymous
+	}
+
+	if err := l.Append(entry); err != nil {
+		slog.ErrorContext(ctx, "failed to write audit log", "action", event.Action, "error", err)
+	}
+}
+
+// Append : seq 를 붙여서 저장, 파일에 쓰는 경우 queue 에만 넣고 바로 반환함 (Each, Close 전에 flush)
+func (l *Log) Append(entry Entry) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.closed {
+		return ErrClosed
+	}
+
+	l.seq++
+	entry.Seq = l.seq
+
+	if l.path == "" {
+		l.entries = append(l.entries, entry)
+		if l.maxEntries > 0 && len(l.entries) > l.maxEntries {
+			l.entries = l.entries[len(l.entries)-l.maxEntries:]
+		}
+		return nil
+	}
+
+	l.pending = append(l.pending, entry)
+	select {
+	case l.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// writer : queue 에 이력이 들어오면 파일에 씀
+func (l *Log) writer() {
+	defer close(l.done)
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-l.wake:
+			if err := l.flush(); err != nil {
+				slog.Error("failed to write audit log", "error", err)
+			}
+		}
+	}
+}
+
+// flush : queue 에 있는 이력을 한번에 씀
+func (l *Log) flush() error {
+	l.writeMutex.Lock()
+	defer l.writeMutex.Unlock()
+
+	l.mutex.Lock()
+	batch := l.pending
+	l.pending = nil
+	l.mutex.Unlock()
+
+	if len(batch) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	for i := range batch {
+		line, err := json.Marshal(&batch[i])
+		if err != nil {
+			return fmt.Errorf("seq %d: %w", batch[i].Seq, err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	if _, err := l.file.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("%d entries (seq %d ~ %d): %w", len(batch), batch[0].Seq, batch[len(batch)-1].Seq, err)
+	}
+	return nil
+}
+
+// Each : 조건에 맞는 이력을 seq 순서로 넘김, fn 이 에러를 반환하면 중단
+func (l *Log) Each(filter Filter, fn func(*Entry) error) error {
+	if l.path != "" {
+		// queue 에 남은 이력까지 조회되도록 먼저 씀
+		if err := l.flush(); err != nil {
+			return err
+		}
+		return l.scan(func(e *Entry) error {
+			if !filter.Match(e) {
+				return nil
+			}
+			return fn(e)
+		})
+	}
+
+	l.mutex.Lock()
+	entries := append([]Entry(nil), l.entries...)
+	l.mutex.Unlock()
+
+	for i := range entries {
+		if !filter.Match(&entries[i]) {
+			continue
+		}
+		if err := fn(&entries[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// errStop : Query 에서 limit 만큼 읽으면 scan 을 끝내기 위한 값
+var errStop = errors.New("stop")
+
+// Query : 최대 limit 건, 더 있으면 hasMore 가 true
+func (l *Log) Query(filter Filter, limit int) (entries []Entry, hasMore bool, err error) {
+	err = l.Each(filter, func(e *Entry) error {
+		if len(entries) == limit {
+			hasMore = true
+			return errStop
+		}
+		entries = append(entries, *e)
+		return nil
+	})
+	if errors.Is(err, errStop) {
+		err = nil
+	}
+	return entries, hasMore, err
+}
+
+// scan : 파일을 처음부터 읽음, 쓰는 중이던 마지막 줄이 잘려있으면 무시함
+func (l *Log) scan(fn func(*Entry) error) error {
+	f, err := os.Open(l.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	reader := bufio.NewReader(f)
+	for lineNo := 1; ; lineNo++ {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var e Entry
+		if err := json.Unmarshal(line, &e); err != nil {
+			return fmt.Errorf("%s:%d: %w", l.path, lineNo, err)
+		}
+		if err := fn(&e); err != nil {
+			return err
+		}
+	}
+}
+
+// Close : queue 에 남은 이력을 모두 쓰고 파일을 닫음
+func (l *Log) Close() error {
+	l.mutex.Lock()
+	if l.closed {
+		l.mutex.Unlock()
+		return nil
+	}
+	l.closed = true
+	l.mutex.Unlock()
+
+	if l.file == nil {
+		return nil
+	}
+
+	close(l.stop)
+	<-l.done
+
+	err := l.flush()
+	if closeErr := l.file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
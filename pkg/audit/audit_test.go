@@ -0,0 +1,162 @@
+package audit
+
+import (
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFilterMatch(t *testing.T) {
+	at := time.Date(2025, 5, 12, 10, 0, 0, 0, time.UTC)
+	entry := &Entry{Seq: 5, Time: at, Actor: "ops", TenantId: "brand", CampaignId: "spring", CouponCode: "GIFT-0001"}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		match  bool
+	}{
+		{name: "empty", filter: Filter{}, match: true},
+		{name: "all fields", filter: Filter{TenantId: "brand", CampaignId: "spring", CouponCode: "GIFT-0001", Actor: "ops"}, match: true},
+		{name: "other tenant", filter: Filter{TenantId: "other"}},
+		{name: "other campaign", filter: Filter{CampaignId: "summer"}},
+		{name: "other coupon", filter: Filter{CouponCode: "GIFT-0002"}},
+		{name: "other actor", filter: Filter{Actor: "system"}},
+		{name: "since is inclusive", filter: Filter{Since: at}, match: true},
+		{name: "until is exclusive", filter: Filter{Until: at}},
+		{name: "before since", filter: Filter{Since: at.Add(time.Second)}},
+		{name: "after seq", filter: Filter{AfterSeq: 4}, match: true},
+		{name: "seq already seen", filter: Filter{AfterSeq: 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Match(entry); got != tt.match {
+				t.Errorf("Match = %v, want %v", got, tt.match)
+			}
+		})
+	}
+}
+
+func TestLog(t *testing.T) {
+	tests := []struct {
+		name       string
+		file       bool
+		maxEntries int
+		appends    int
+		want       []int64 // 조회되는 seq
+	}{
+		{name: "memory", appends: 3, want: []int64{1, 2, 3}},
+		{name: "memory keeps latest", maxEntries: 2, appends: 3, want: []int64{2, 3}},
+		{name: "file", file: true, appends: 3, want: []int64{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := ""
+			if tt.file {
+				path = filepath.Join(t.TempDir(), "audit.jsonl")
+			}
+			l, err := Open(path, tt.maxEntries)
+			if err != nil {
+				t.Fatalf("Open: %v", err)
+			}
+			defer l.Close()
+
+			for i := 0; i < tt.appends; i++ {
+				if err := l.Append(Entry{TenantId: "brand", Action: "coupon.issued"}); err != nil {
+					t.Fatalf("Append: %v", err)
+				}
+			}
+
+			// 파일은 queue 에 남아있는 이력까지 바로 조회되어야 함
+			entries, hasMore, err := l.Query(Filter{TenantId: "brand"}, len(tt.want))
+			if err != nil {
+				t.Fatalf("Query: %v", err)
+			}
+			if hasMore || len(entries) != len(tt.want) {
+				t.Fatalf("Query returned %d entries (hasMore %v), want %d", len(entries), hasMore, len(tt.want))
+			}
+			for i, e := range entries {
+				if e.Seq != tt.want[i] {
+					t.Errorf("entries[%d].Seq = %d, want %d", i, e.Seq, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestLogReopenContinuesSeq(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	l, err := Open(path, 0)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		l.Append(Entry{Action: "coupon.issued"})
+	}
+	// Close 전에 queue 에 남은 이력도 파일에 써야 함
+	if err := l.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if err := l.Append(Entry{Action: "coupon.issued"}); !errors.Is(err, ErrClosed) {
+		t.Errorf("Append after Close: err = %v, want %v", err, ErrClosed)
+	}
+
+	l, err = Open(path, 0)
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	defer l.Close()
+	l.Append(Entry{Action: "coupon.redeemed"})
+
+	entries, _, err := l.Query(Filter{}, 10)
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if len(entries) != 4 || entries[3].Seq != 4 || entries[3].Action != "coupon.redeemed" {
+		t.Errorf("entries after reopen = %+v, want 4 entries ending with seq 4", entries)
+	}
+}
+
+// TestLogConcurrentAppend : 여러 goroutine 이 동시에 남겨도 seq 가 빠짐없이 순서대로 파일에 남아야 함
+func TestLogConcurrentAppend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	l, err := Open(path, 0)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+
+	const writers, each = 8, 100
+	var wg sync.WaitGroup
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < each; j++ {
+				l.Append(Entry{Action: "coupon.issued"})
+			}
+		}()
+	}
+	wg.Wait()
+	if err := l.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	var seq int64
+	err = l.scan(func(e *Entry) error {
+		seq++
+		if e.Seq != seq {
+			t.Fatalf("line %d has seq %d", seq, e.Seq)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("scan: %v", err)
+	}
+	if seq != writers*each {
+		t.Errorf("file has %d entries, want %d", seq, writers*each)
+	}
+}
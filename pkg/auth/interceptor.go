@@ -0,0 +1,136 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1/v1connect"
+)
+
+const APIKeyHeader = "X-Api-Key"
+
+// Policy : procedure 별로 필요한 최소 role
+// 여기 없는 procedure 는 admin 전용으로 취급해서, 새 RPC 를 추가하고 깜빡해도 열려있지 않게 함
+var Policy = map[string]Role{
+	v1connect.CampaignServiceCreateCampaignProcedure:    RoleAdmin,
+	v1connect.CampaignServiceGetCampaignProcedure:       RoleClient,
+	v1connect.CampaignServiceListCampaignsProcedure:     RoleAdmin,
+	v1connect.CampaignServiceUpdateCampaignProcedure:    RoleAdmin,
+	v1connect.CampaignServiceDeleteCampaignProcedure:    RoleAdmin,
+	v1connect.CampaignServicePauseCampaignProcedure:     RoleAdmin,
+	v1connect.CampaignServiceResumeCampaignProcedure:    RoleAdmin,
+	v1connect.CampaignServiceGetCampaignStatsProcedure:  RoleAdmin,
+	v1connect.CampaignServiceImportCouponsProcedure:     RoleAdmin,
+	v1connect.CampaignServiceExportCampaignProcedure:    RoleAdmin,
+	v1connect.CouponServiceIssueCouponProcedure:         RoleClient,
+	v1connect.CouponServiceEvaluateEligibilityProcedure: RoleClient,
+	v1connect.CouponServiceGetWaitlistPositionProcedure: RoleClient,
+	v1connect.CouponServiceLeaveWaitlistProcedure:       RoleClient,
+	v1connect.CouponServiceRedeemCouponProcedure:        RoleClient,
+	v1connect.CouponServiceQuoteDiscountProcedure:       RoleClient,
+	v1connect.CouponServiceRevokeCouponProcedure:        RoleAdmin,
+	v1connect.CouponServiceUnredeemCouponProcedure:      RoleAdmin,
+	v1connect.CouponServiceReserveCouponProcedure:       RoleClient,
+	v1connect.CouponServiceConfirmRedemptionProcedure:   RoleClient,
+	v1connect.CouponServiceReleaseReservationProcedure:  RoleClient,
+	v1connect.RaffleServiceEnterDrawProcedure:           RoleClient,
+	v1connect.RaffleServiceGetDrawResultProcedure:       RoleClient,
+	v1connect.RaffleServiceExportDrawProcedure:          RoleClient, // client 는 추첨이 끝난 뒤에만 (service 에서 확인)
+	v1connect.AuditServiceQueryAuditLogProcedure:        RoleAdmin,
+	v1connect.AuditServiceExportAuditLogProcedure:       RoleAdmin,
+	v1connect.WebhookServiceRegisterWebhookProcedure:    RoleAdmin,
+	v1connect.WebhookServiceListWebhooksProcedure:       RoleAdmin,
+	v1connect.WebhookServiceDeleteWebhookProcedure:      RoleAdmin,
+	v1connect.WebhookServiceListDeadLettersProcedure:    RoleAdmin,
+	v1connect.WebhookServiceReplayDeadLettersProcedure:  RoleAdmin,
+}
+
+// UserScoped : 요청 body 의 userId 대신 호출자의 userId 로 처리하는 procedure (ResolveUserId 사용)
+// client 는 sub 가 userId 인 JWT 로만 호출할 수 있고, API key 이름을 userId 로 쓰지 않게 client API key 는 거부함
+var UserScoped = map[string]bool{
+	v1connect.CouponServiceIssueCouponProcedure:         true,
+	v1connect.CouponServiceEvaluateEligibilityProcedure: true,
+	v1connect.CouponServiceGetWaitlistPositionProcedure: true,
+	v1connect.CouponServiceLeaveWaitlistProcedure:       true,
+	v1connect.CouponServiceRedeemCouponProcedure:        true,
+	v1connect.CouponServiceQuoteDiscountProcedure:       true,
+	v1connect.CouponServiceReserveCouponProcedure:       true,
+	v1connect.RaffleServiceEnterDrawProcedure:           true,
+	v1connect.RaffleServiceGetDrawResultProcedure:       true,
+}
+
+// RequiredRole : Policy 에 없는 procedure 는 admin
+func RequiredRole(procedure string) Role {
+	if required, ok := Policy[procedure]; ok {
+		return required
+	}
+	return RoleAdmin
+}
+
+type Interceptor struct {
+	authenticator *Authenticator
+}
+
+// NewInterceptor : connect handler 옵션으로 등록하는 인증 interceptor
+func NewInterceptor(authenticator *Authenticator) *Interceptor {
+	return &Interceptor{authenticator: authenticator}
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+
+		ctx, err := i.authorize(ctx, req.Spec().Procedure, req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := i.authorize(ctx, conn.Spec().Procedure, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+func (i *Interceptor) authorize(ctx context.Context, procedure string, header http.Header) (context.Context, error) {
+	principal, err := i.authenticate(header)
+	if err != nil {
+		return ctx, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	if RequiredRole(procedure) == RoleAdmin && !principal.IsAdmin() {
+		return ctx, connect.NewError(connect.CodePermissionDenied, ErrPermissionDenied)
+	}
+	if UserScoped[procedure] && !principal.IsAdmin() && principal.Method != "jwt" {
+		return ctx, connect.NewError(connect.CodePermissionDenied, ErrUserTokenRequired)
+	}
+
+	return WithPrincipal(ctx, principal), nil
+}
+
+func (i *Interceptor) authenticate(header http.Header) (*Principal, error) {
+	if key := header.Get(APIKeyHeader); key != "" {
+		return i.authenticator.AuthenticateAPIKey(key)
+	}
+
+	authorization := header.Get("Authorization")
+	if token, ok := strings.CutPrefix(authorization, "Bearer "); ok && token != "" {
+		return i.authenticator.AuthenticateToken(token)
+	}
+
+	return nil, ErrNoCredentials
+}
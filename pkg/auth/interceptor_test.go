@@ -0,0 +1,184 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1/v1connect"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var testSecret = []byte("test-secret")
+
+func testToken(t *testing.T, claims Claims) string {
+	t.Helper()
+	if claims.ExpiresAt == nil {
+		claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(time.Hour))
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(testSecret)
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return token
+}
+
+func TestRequiredRole(t *testing.T) {
+	tests := []struct {
+		procedure string
+		role      Role
+	}{
+		{procedure: v1connect.CouponServiceIssueCouponProcedure, role: RoleClient},
+		{procedure: v1connect.RaffleServiceExportDrawProcedure, role: RoleClient},
+		{procedure: v1connect.CampaignServiceCreateCampaignProcedure, role: RoleAdmin},
+		{procedure: v1connect.CouponServiceRevokeCouponProcedure, role: RoleAdmin},
+		{procedure: "/v1.CouponService/Unknown", role: RoleAdmin}, // Policy 에 없으면 admin
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.procedure, func(t *testing.T) {
+			if got := RequiredRole(tt.procedure); got != tt.role {
+				t.Errorf("RequiredRole = %s, want %s", got, tt.role)
+			}
+		})
+	}
+}
+
+// TestUserScopedAreClientRPCs : 사용자 단위 RPC 는 client 가 호출할 수 있는 RPC 여야 함
+func TestUserScopedAreClientRPCs(t *testing.T) {
+	for procedure := range UserScoped {
+		if RequiredRole(procedure) != RoleClient {
+			t.Errorf("%s is user scoped but requires %s", procedure, RequiredRole(procedure))
+		}
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	authenticator, err := NewAuthenticator(Config{
+		APIKeys: []APIKey{
+			{Name: "ops", Role: RoleAdmin, Key: "admin-key"},
+			{Name: "shop", Role: RoleClient, Key: "client-key"},
+		},
+		HS256Secret: testSecret,
+	})
+	if err != nil {
+		t.Fatalf("NewAuthenticator: %v", err)
+	}
+	i := NewInterceptor(authenticator)
+
+	tests := []struct {
+		name      string
+		procedure string
+		apiKey    string
+		token     string
+		code      connect.Code // 0 이면 허용
+		subject   string
+	}{
+		{name: "no credentials", procedure: v1connect.CouponServiceIssueCouponProcedure, code: connect.CodeUnauthenticated},
+		{name: "invalid api key", procedure: v1connect.CouponServiceIssueCouponProcedure, apiKey: "nope", code: connect.CodeUnauthenticated},
+		{name: "invalid token", procedure: v1connect.CouponServiceIssueCouponProcedure, token: "nope", code: connect.CodeUnauthenticated},
+		{name: "admin key issues", procedure: v1connect.CouponServiceIssueCouponProcedure, apiKey: "admin-key", subject: "ops"},
+		{name: "client key cannot issue", procedure: v1connect.CouponServiceIssueCouponProcedure, apiKey: "client-key", code: connect.CodePermissionDenied},
+		{name: "client key cannot enter draw", procedure: v1connect.RaffleServiceEnterDrawProcedure, apiKey: "client-key", code: connect.CodePermissionDenied},
+		{name: "client key reads campaign", procedure: v1connect.CampaignServiceGetCampaignProcedure, apiKey: "client-key", subject: "shop"},
+		{name: "client key cannot create campaign", procedure: v1connect.CampaignServiceCreateCampaignProcedure, apiKey: "client-key", code: connect.CodePermissionDenied},
+		{name: "client token issues", procedure: v1connect.CouponServiceIssueCouponProcedure, token: "u1", subject: "u1"},
+		{name: "client token cannot create campaign", procedure: v1connect.CampaignServiceCreateCampaignProcedure, token: "u1", code: connect.CodePermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			if tt.apiKey != "" {
+				header.Set(APIKeyHeader, tt.apiKey)
+			}
+			if tt.token == "nope" {
+				header.Set("Authorization", "Bearer nope")
+			} else if tt.token != "" {
+				header.Set("Authorization", "Bearer "+testToken(t, Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: tt.token}}))
+			}
+
+			ctx, err := i.authorize(context.Background(), tt.procedure, header)
+			if tt.code != 0 {
+				if connect.CodeOf(err) != tt.code {
+					t.Fatalf("err = %v, want %s", err, tt.code)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("authorize: %v", err)
+			}
+			if p := FromContext(ctx); p == nil || p.Subject != tt.subject {
+				t.Errorf("principal = %+v, want subject %s", p, tt.subject)
+			}
+		})
+	}
+}
+
+func TestAuthenticateToken(t *testing.T) {
+	authenticator, err := NewAuthenticator(Config{HS256Secret: testSecret})
+	if err != nil {
+		t.Fatalf("NewAuthenticator: %v", err)
+	}
+	expired := jwt.NewNumericDate(time.Now().Add(-time.Minute))
+
+	tests := []struct {
+		name   string
+		claims Claims
+		role   Role
+		err    error
+	}{
+		{name: "role defaults to client", claims: Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: "u1"}}, role: RoleClient},
+		{name: "admin", claims: Claims{Role: RoleAdmin, RegisteredClaims: jwt.RegisteredClaims{Subject: "ops"}}, role: RoleAdmin},
+		{name: "missing sub", claims: Claims{}, err: ErrInvalidToken},
+		{name: "unknown role", claims: Claims{Role: "owner", RegisteredClaims: jwt.RegisteredClaims{Subject: "u1"}}, err: ErrInvalidToken},
+		{name: "expired", claims: Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: "u1", ExpiresAt: expired}}, err: ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := authenticator.AuthenticateToken(testToken(t, tt.claims))
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("err = %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AuthenticateToken: %v", err)
+			}
+			if p.Role != tt.role || p.Method != "jwt" {
+				t.Errorf("role %s, method %s, want %s, jwt", p.Role, p.Method, tt.role)
+			}
+		})
+	}
+}
+
+func TestParseAPIKey(t *testing.T) {
+	tests := []struct {
+		value string
+		want  APIKey
+		ok    bool
+	}{
+		{value: "admin:ops:secret", want: APIKey{Role: RoleAdmin, Name: "ops", Key: "secret"}, ok: true},
+		{value: "client:shop@brandA:a:b", want: APIKey{Role: RoleClient, Name: "shop", Tenant: "brandA", Key: "a:b"}, ok: true},
+		{value: "admin:ops"},
+		{value: "admin::secret"},
+		{value: "admin:ops:"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseAPIKey(tt.value)
+			if (err == nil) != tt.ok {
+				t.Fatalf("err = %v, want ok %v", err, tt.ok)
+			}
+			if tt.ok && got != tt.want {
+				t.Errorf("ParseAPIKey = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"context"
+)
+
+type Role string
+
+const (
+	RoleAdmin  Role = "admin"  // 캠페인 생성/수정/삭제 등 운영자
+	RoleClient Role = "client" // 쿠폰 발급/사용 등 최종 사용자
+)
+
+// Principal : 인증된 호출자 정보
+type Principal struct {
+	Subject string // API key 이름 또는 JWT sub (client 의 경우 userId)
+	Role    Role
+	Tenant  string   // 비어있으면 X-Tenant-Id 헤더로 tenant 를 고를 수 있음 (플랫폼 운영자)
+	Method  string   // "apikey", "jwt"
+	Tiers   []string // JWT tiers claim : 캠페인 tier 멤버십
+}
+
+func (p *Principal) IsAdmin() bool {
+	return p != nil && p.Role == RoleAdmin
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext : 인증이 꺼져있으면 nil
+func FromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
+
+// ResolveTiers : client 토큰의 tiers claim, admin 이나 인증이 꺼진 경우에는 tier 의 userIds 로만 멤버를 확인함
+func ResolveTiers(ctx context.Context) []string {
+	p := FromContext(ctx)
+	if p == nil || p.IsAdmin() {
+		return nil
+	}
+	return p.Tiers
+}
+
+// ResolveUserId : client 는 요청 body 의 userId 를 믿지 않고 토큰의 subject 를 사용함
+// admin 이나 인증이 꺼진 경우에는 body 의 userId 를 그대로 사용
+// client API key 는 interceptor 에서 UserScoped procedure 를 거부하므로 subject 는 항상 JWT sub
+func ResolveUserId(ctx context.Context, bodyUserId string) string {
+	p := FromContext(ctx)
+	if p == nil || p.IsAdmin() {
+		return bodyUserId
+	}
+	return p.Subject
+}
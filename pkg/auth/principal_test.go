@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"context"
+	"slices"
+	"testing"
+)
+
+func TestResolveUserId(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *Principal
+		userId    string
+		tiers     []string
+	}{
+		{name: "auth disabled", userId: "body"},
+		{name: "admin uses body", principal: &Principal{Subject: "ops", Role: RoleAdmin, Method: "apikey", Tiers: []string{"vip"}}, userId: "body"},
+		{name: "client uses token sub", principal: &Principal{Subject: "u1", Role: RoleClient, Method: "jwt", Tiers: []string{"vip"}}, userId: "u1", tiers: []string{"vip"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = WithPrincipal(ctx, tt.principal)
+			}
+			if got := ResolveUserId(ctx, "body"); got != tt.userId {
+				t.Errorf("ResolveUserId = %s, want %s", got, tt.userId)
+			}
+			if got := ResolveTiers(ctx); !slices.Equal(got, tt.tiers) {
+				t.Errorf("ResolveTiers = %v, want %v", got, tt.tiers)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package cache
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// recentEventsSize : 캠페인별로 보관하는 최근 이벤트 수 (대시보드 drill-down 용)
+const recentEventsSize = 50
+
+// 실패한 요청의 최근 이벤트 종류, 쿠폰 상태 변경은 감사 이력 action 을 그대로 사용함
+const (
+	ActivityIssueFailed  = "issue.failed"
+	ActivityRedeemFailed = "redeem.failed"
+)
+
+// ActivityEvent : 대시보드용 최근 이벤트, 메모리에만 있고 재시작하면 사라짐
+type ActivityEvent struct {
+	Time       time.Time
+	Type       string
+	CouponCode string
+	UserId     string
+	Reason     string // 실패한 경우 ErrorReason
+}
+
+// activity : 실패한 요청 수와 최근 이벤트
+// 실패는 캠페인 lock 을 잡기 전에도 일어나서 캠페인 lock 과 따로 둠
+type activity struct {
+	failures int64
+	events   [recentEventsSize]ActivityEvent
+	next     int // 다음에 쓸 위치
+	count    int
+	mutex    sync.Mutex
+}
+
+func (a *activity) record(event ActivityEvent) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.events[a.next] = event
+	a.next = (a.next + 1) % recentEventsSize
+	a.count = min(a.count+1, recentEventsSize)
+}
+
+// fail : 캠페인이 있는 경우의 발급/사용 실패, 대시보드 에러율에 들어감
+func (a *activity) fail(eventType, couponCode, userId string, err error) {
+	event := ActivityEvent{Time: time.Now(), Type: eventType, CouponCode: couponCode, UserId: userId, Reason: ErrorReason(err)}
+
+	// 대기자 등록은 실패로 세지만 사유는 따로 보여줌
+	var waitlisted *WaitlistError
+	if errors.As(err, &waitlisted) {
+		event.Reason = "waitlisted"
+	}
+
+	a.mutex.Lock()
+	a.failures++
+	a.mutex.Unlock()
+	a.record(event)
+}
+
+// recent : 최근 이벤트부터
+func (a *activity) recent() []ActivityEvent {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	ret := make([]ActivityEvent, 0, a.count)
+	for i := 1; i <= a.count; i++ {
+		ret = append(ret, a.events[(a.next-i+recentEventsSize)%recentEventsSize])
+	}
+	return ret
+}
+
+func (a *activity) failureCount() int64 {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	return a.failures
+}
+
+// RecentActivity : 캠페인의 최근 발급/사용/실패 이벤트, 최근 것부터
+func (v *CampaignManager) RecentActivity(tenantId, campaignId string) ([]ActivityEvent, error) {
+	_, campaign, exists := v.getCampaign(tenantId, campaignId)
+	if !exists {
+		return nil, ErrCampaignNotExists
+	}
+	return campaign.activity.recent(), nil
+}
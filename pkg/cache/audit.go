@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// 감사 이력 action
+const (
+	AuditCampaignCreated  = "campaign.created"
+	AuditCampaignRemoved  = "campaign.removed"
+	AuditCampaignDeleted  = "campaign.deleted"
+	AuditCampaignDrawn    = "campaign.drawn"
+	AuditCampaignUpdated  = "campaign.updated"
+	AuditCampaignPaused   = "campaign.paused"
+	AuditCampaignResumed  = "campaign.resumed"
+	AuditTierReleased     = "campaign.tier_released"
+	AuditCouponsImported  = "campaign.coupons_imported"
+	AuditCouponIssued     = "coupon.issued"
+	AuditCouponRedeemed   = "coupon.redeemed"
+	AuditCouponRevoked    = "coupon.revoked"
+	AuditCouponUnredeemed = "coupon.unredeemed"
+	AuditCouponExpired    = "coupon.expired"
+	AuditCouponReserved   = "coupon.reserved"
+	AuditCouponReleased   = "coupon.released"
+)
+
+// AuditEvent : CampaignManager 의 상태 변경 한건, 누가 어떤 RPC 로 바꿨는지는 기록하는 쪽에서 ctx 로 채움
+type AuditEvent struct {
+	Action     string
+	TenantId   string
+	CampaignId string
+	CouponCode string
+	Before     map[string]any // 새로 만든 경우 nil
+	After      map[string]any // 삭제된 경우 nil
+}
+
+// SetAuditor : 상태가 바뀔때마다 호출됨, 서버 가동 전에 한번만 설정
+// 변경 순서대로 기록되도록 캠페인 lock 을 잡은 상태에서 호출하므로 오래 걸리면 안됨
+func (v *CampaignManager) SetAuditor(record func(ctx context.Context, event AuditEvent)) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	v.auditor = record
+}
+
+func (v *CampaignManager) audit(ctx context.Context, event AuditEvent) {
+	if v.auditor != nil {
+		v.auditor(ctx, event)
+	}
+}
+
+func campaignAuditValues(c *Campaign) map[string]any {
+	values := map[string]any{
+		"startDate":   c.StartDate.Format(time.RFC3339),
+		"expiredDate": c.ExpiredDate.Format(time.RFC3339),
+		"maxCoupons":  c.MaxCoupons,
+	}
+	if c.Benefit != nil {
+		values["benefit"] = c.Benefit
+	}
+	if len(c.Rules) > 0 {
+		values["rules"] = c.Rules
+	}
+	if c.Schedule != nil {
+		values["schedule"] = c.Schedule
+	}
+	if len(c.Tiers) > 0 {
+		values["tiers"] = c.Tiers
+	}
+	return values
+}
@@ -0,0 +1,226 @@
+package cache
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// 혜택 종류
+const (
+	BenefitPercent      = "percent"
+	BenefitFixed        = "fixed"
+	BenefitFreeShipping = "free_shipping"
+	BenefitBuyXGetY     = "bxgy"
+)
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// 장바구니 제한 : QuoteDiscount, RedeemCoupon 은 client 가 보낸 값을 그대로 계산하기 때문에 크기와 금액을 제한함
+const (
+	MaxCartItems    = 100
+	MaxItemQuantity = 10_000
+	MaxCartAmount   = int64(1_000_000_000_000_000) // 통화의 최소 단위, 주문 금액 + 배송비도 int64 를 넘지 않는 값
+)
+
+// Benefit : 캠페인 쿠폰 한장의 혜택, 금액은 모두 통화의 최소 단위
+type Benefit struct {
+	Type              string `json:"type"`
+	PercentOff        int    `json:"percentOff,omitempty"`
+	AmountOff         int64  `json:"amountOff,omitempty"`
+	Currency          string `json:"currency,omitempty"`
+	BuyQuantity       int    `json:"buyQuantity,omitempty"`
+	GetQuantity       int    `json:"getQuantity,omitempty"`
+	MinOrderAmount    int64  `json:"minOrderAmount,omitempty"`
+	MaxDiscountAmount int64  `json:"maxDiscountAmount,omitempty"`
+}
+
+// Validate : 캠페인 생성 시점에 확인
+func (b *Benefit) Validate() error {
+	invalid := func(format string, args ...any) error {
+		return fmt.Errorf("%w: %s", ErrInvalidBenefit, fmt.Sprintf(format, args...))
+	}
+
+	switch b.Type {
+	case BenefitPercent:
+		if b.PercentOff < 1 || b.PercentOff > 100 {
+			return invalid("percentOff must be between 1 and 100")
+		}
+	case BenefitFixed:
+		if b.AmountOff <= 0 {
+			return invalid("amountOff must be positive")
+		}
+		if b.Currency == "" {
+			return invalid("currency is required for fixed benefit")
+		}
+	case BenefitFreeShipping:
+	case BenefitBuyXGetY:
+		if b.BuyQuantity <= 0 || b.GetQuantity <= 0 {
+			return invalid("buyQuantity and getQuantity must be positive")
+		}
+	default:
+		return invalid("unknown type %q (percent, fixed, free_shipping, bxgy)", b.Type)
+	}
+
+	if b.MinOrderAmount < 0 || b.MaxDiscountAmount < 0 {
+		return invalid("minOrderAmount and maxDiscountAmount must not be negative")
+	}
+	if (b.MinOrderAmount > 0 || b.MaxDiscountAmount > 0) && b.Currency == "" {
+		return invalid("currency is required with minOrderAmount or maxDiscountAmount")
+	}
+	if b.Currency != "" && !currencyPattern.MatchString(b.Currency) {
+		return invalid("currency must be an ISO 4217 code like KRW")
+	}
+
+	return nil
+}
+
+// Cart : 할인 계산용 장바구니
+type Cart struct {
+	OrderAmount    int64 // 0 이면 Items 합계
+	ShippingAmount int64
+	Currency       string
+	Items          []CartItem
+}
+
+type CartItem struct {
+	Sku       string
+	UnitPrice int64
+	Quantity  int
+}
+
+type Discount struct {
+	Amount       int64
+	Currency     string
+	FreeShipping bool
+	FinalAmount  int64
+}
+
+func (c *Cart) orderAmount() int64 {
+	if c.OrderAmount > 0 {
+		return c.OrderAmount
+	}
+
+	total := int64(0)
+	for _, item := range c.Items {
+		total += item.UnitPrice * int64(item.Quantity)
+	}
+	return total
+}
+
+// validate : orderAmount 를 계산하기 전에 호출함, 상품별 금액과 합계가 MaxCartAmount 를 넘지 않으면 overflow 가 없음
+func (c *Cart) validate() error {
+	if c.OrderAmount < 0 || c.ShippingAmount < 0 {
+		return fmt.Errorf("%w: amounts must not be negative", ErrInvalidCart)
+	}
+	if c.OrderAmount > MaxCartAmount || c.ShippingAmount > MaxCartAmount {
+		return fmt.Errorf("%w: amounts must not exceed %d", ErrInvalidCart, MaxCartAmount)
+	}
+	if len(c.Items) > MaxCartItems {
+		return fmt.Errorf("%w: at most %d items", ErrInvalidCart, MaxCartItems)
+	}
+
+	total := int64(0)
+	for _, item := range c.Items {
+		if item.UnitPrice < 0 || item.Quantity <= 0 {
+			return fmt.Errorf("%w: item %q must have non-negative unitPrice and positive quantity", ErrInvalidCart, item.Sku)
+		}
+		if item.Quantity > MaxItemQuantity {
+			return fmt.Errorf("%w: item %q quantity must not exceed %d", ErrInvalidCart, item.Sku, MaxItemQuantity)
+		}
+		if item.UnitPrice > MaxCartAmount/int64(item.Quantity) {
+			return fmt.Errorf("%w: item %q amount must not exceed %d", ErrInvalidCart, item.Sku, MaxCartAmount)
+		}
+		total += item.UnitPrice * int64(item.Quantity)
+	}
+	if total > MaxCartAmount {
+		return fmt.Errorf("%w: order amount must not exceed %d", ErrInvalidCart, MaxCartAmount)
+	}
+	return nil
+}
+
+// Apply : 쿠폰을 cart 에 적용했을때의 할인 금액, benefit 이 없는 캠페인이면 할인 없음
+func (b *Benefit) Apply(cart *Cart) (*Discount, error) {
+	if err := cart.validate(); err != nil {
+		return nil, err
+	}
+
+	order := cart.orderAmount()
+	discount := &Discount{Currency: cart.Currency}
+	if b == nil {
+		discount.FinalAmount = order + cart.ShippingAmount
+		return discount, nil
+	}
+
+	if b.Currency != "" {
+		if cart.Currency != "" && cart.Currency != b.Currency {
+			return nil, fmt.Errorf("%w: coupon is %s, cart is %s", ErrCurrencyMismatch, b.Currency, cart.Currency)
+		}
+		discount.Currency = b.Currency
+	}
+
+	if order < b.MinOrderAmount {
+		return nil, fmt.Errorf("%w: %d %s", ErrOrderBelowMinimum, b.MinOrderAmount, b.Currency)
+	}
+
+	var amount, limit int64
+	switch b.Type {
+	case BenefitPercent:
+		amount, limit = percentOf(order, int64(b.PercentOff)), order
+	case BenefitFixed:
+		amount, limit = b.AmountOff, order
+	case BenefitFreeShipping:
+		amount, limit = cart.ShippingAmount, cart.ShippingAmount
+		discount.FreeShipping = true
+	case BenefitBuyXGetY:
+		if len(cart.Items) == 0 {
+			return nil, fmt.Errorf("%w: items are required for bxgy coupon", ErrInvalidCart)
+		}
+		amount, limit = b.freeItemsAmount(cart.Items), order
+	}
+
+	if b.MaxDiscountAmount > 0 {
+		amount = min(amount, b.MaxDiscountAmount)
+	}
+	discount.Amount = min(amount, limit)
+	discount.FinalAmount = order + cart.ShippingAmount - discount.Amount
+
+	return discount, nil
+}
+
+// percentOf : amount*percent/100 을 곱하기 전에 나눠서 계산함, 금액 제한을 올려도 int64 를 넘지 않음
+func percentOf(amount, percent int64) int64 {
+	return amount/100*percent + amount%100*percent/100
+}
+
+// freeItemsAmount : 비싼 상품부터 buy+get 개씩 묶어서, 묶음마다 가장 싼 get 개를 무료로 함
+// 수량만큼 가격을 펼치지 않고, 가격이 같은 상품 묶음마다 무료가 되는 자리 수를 계산함
+func (b *Benefit) freeItemsAmount(items []CartItem) int64 {
+	sorted := make([]CartItem, len(items))
+	copy(sorted, items)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].UnitPrice > sorted[j].UnitPrice
+	})
+
+	group, buy, get := int64(b.BuyQuantity+b.GetQuantity), int64(b.BuyQuantity), int64(b.GetQuantity)
+	units := int64(0)
+	for _, item := range sorted {
+		units += int64(item.Quantity)
+	}
+	// 다 채워진 묶음까지만 무료 대상
+	limit := units / group * group
+
+	// freeBefore : 비싼 순서로 줄 세웠을때 앞에서 n 개 안에 있는 무료 자리 수
+	freeBefore := func(n int64) int64 {
+		n = min(n, limit)
+		return n/group*get + max(0, n%group-buy)
+	}
+
+	free, position := int64(0), int64(0)
+	for _, item := range sorted {
+		next := position + int64(item.Quantity)
+		free += item.UnitPrice * (freeBefore(next) - freeBefore(position))
+		position = next
+	}
+	return free
+}
@@ -0,0 +1,213 @@
+package cache
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestBenefitApply(t *testing.T) {
+	tests := []struct {
+		name    string
+		benefit *Benefit
+		cart    Cart
+		amount  int64
+		final   int64
+		err     error
+	}{
+		{
+			name:   "no benefit",
+			cart:   Cart{OrderAmount: 10000, ShippingAmount: 3000},
+			amount: 0,
+			final:  13000,
+		},
+		{
+			name:    "percent rounds down",
+			benefit: &Benefit{Type: BenefitPercent, PercentOff: 15},
+			cart:    Cart{OrderAmount: 9999},
+			amount:  1499,
+			final:   8500,
+		},
+		{
+			name:    "percent capped by maxDiscountAmount",
+			benefit: &Benefit{Type: BenefitPercent, PercentOff: 50, MaxDiscountAmount: 3000, Currency: "KRW"},
+			cart:    Cart{OrderAmount: 10000, Currency: "KRW"},
+			amount:  3000,
+			final:   7000,
+		},
+		{
+			name:    "fixed does not exceed order",
+			benefit: &Benefit{Type: BenefitFixed, AmountOff: 5000, Currency: "KRW"},
+			cart:    Cart{OrderAmount: 3000, ShippingAmount: 2500, Currency: "KRW"},
+			amount:  3000,
+			final:   2500,
+		},
+		{
+			name:    "free shipping",
+			benefit: &Benefit{Type: BenefitFreeShipping},
+			cart:    Cart{OrderAmount: 10000, ShippingAmount: 3000},
+			amount:  3000,
+			final:   10000,
+		},
+		{
+			name:    "order amount from items",
+			benefit: &Benefit{Type: BenefitPercent, PercentOff: 10},
+			cart:    Cart{Items: []CartItem{{Sku: "a", UnitPrice: 1000, Quantity: 3}, {Sku: "b", UnitPrice: 500, Quantity: 2}}},
+			amount:  400,
+			final:   3600,
+		},
+		{
+			name:    "bxgy buy 2 get 1",
+			benefit: &Benefit{Type: BenefitBuyXGetY, BuyQuantity: 2, GetQuantity: 1},
+			// 5000 4000 3000 | 2000 1000 : 두번째 묶음은 다 채워지지 않음
+			cart:   Cart{Items: []CartItem{{Sku: "a", UnitPrice: 1000, Quantity: 1}, {Sku: "b", UnitPrice: 5000, Quantity: 1}, {Sku: "c", UnitPrice: 3000, Quantity: 1}, {Sku: "d", UnitPrice: 4000, Quantity: 1}, {Sku: "e", UnitPrice: 2000, Quantity: 1}}},
+			amount: 3000,
+			final:  12000,
+		},
+		{
+			name:    "bxgy same price bucket spans groups",
+			benefit: &Benefit{Type: BenefitBuyXGetY, BuyQuantity: 1, GetQuantity: 1},
+			cart:    Cart{Items: []CartItem{{Sku: "a", UnitPrice: 700, Quantity: 7}}},
+			amount:  2100,
+			final:   2800,
+		},
+		{
+			name:    "bxgy without items",
+			benefit: &Benefit{Type: BenefitBuyXGetY, BuyQuantity: 1, GetQuantity: 1},
+			cart:    Cart{OrderAmount: 1000},
+			err:     ErrInvalidCart,
+		},
+		{
+			name:    "percent at cart limit",
+			benefit: &Benefit{Type: BenefitPercent, PercentOff: 99},
+			cart:    Cart{OrderAmount: MaxCartAmount, ShippingAmount: MaxCartAmount},
+			amount:  MaxCartAmount / 100 * 99,
+			final:   MaxCartAmount*2 - MaxCartAmount/100*99,
+		},
+		{
+			name:    "bxgy at cart limit",
+			benefit: &Benefit{Type: BenefitBuyXGetY, BuyQuantity: 1, GetQuantity: 1},
+			cart:    Cart{Items: []CartItem{{Sku: "a", UnitPrice: MaxCartAmount / MaxItemQuantity, Quantity: MaxItemQuantity}}, ShippingAmount: MaxCartAmount},
+			amount:  MaxCartAmount / 2,
+			final:   MaxCartAmount + MaxCartAmount/2,
+		},
+		{
+			name:    "currency mismatch",
+			benefit: &Benefit{Type: BenefitFixed, AmountOff: 5, Currency: "USD"},
+			cart:    Cart{OrderAmount: 1000, Currency: "KRW"},
+			err:     ErrCurrencyMismatch,
+		},
+		{
+			name:    "below minimum order",
+			benefit: &Benefit{Type: BenefitPercent, PercentOff: 10, MinOrderAmount: 20000, Currency: "KRW"},
+			cart:    Cart{OrderAmount: 19999},
+			err:     ErrOrderBelowMinimum,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			discount, err := tt.benefit.Apply(&tt.cart)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("err = %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Apply: %v", err)
+			}
+			if discount.Amount != tt.amount || discount.FinalAmount != tt.final {
+				t.Errorf("Amount = %d, FinalAmount = %d, want %d, %d", discount.Amount, discount.FinalAmount, tt.amount, tt.final)
+			}
+		})
+	}
+}
+
+func TestCartValidateLimits(t *testing.T) {
+	tooMany := make([]CartItem, MaxCartItems+1)
+	for i := range tooMany {
+		tooMany[i] = CartItem{Sku: "a", UnitPrice: 1, Quantity: 1}
+	}
+
+	tests := []struct {
+		name string
+		cart Cart
+		ok   bool
+	}{
+		{name: "empty", cart: Cart{}, ok: true},
+		{name: "at limits", cart: Cart{Items: []CartItem{{UnitPrice: MaxCartAmount / MaxItemQuantity, Quantity: MaxItemQuantity}}}, ok: true},
+		{name: "negative order amount", cart: Cart{OrderAmount: -1}},
+		{name: "order amount over limit", cart: Cart{OrderAmount: MaxCartAmount + 1}},
+		{name: "shipping over limit", cart: Cart{ShippingAmount: MaxCartAmount + 1}},
+		{name: "too many items", cart: Cart{Items: tooMany}},
+		{name: "zero quantity", cart: Cart{Items: []CartItem{{UnitPrice: 1, Quantity: 0}}}},
+		{name: "quantity over limit", cart: Cart{Items: []CartItem{{UnitPrice: 1, Quantity: MaxItemQuantity + 1}}}},
+		{name: "huge quantity", cart: Cart{Items: []CartItem{{UnitPrice: 1, Quantity: 1 << 40}}}},
+		{name: "item amount overflows int64", cart: Cart{Items: []CartItem{{UnitPrice: 1 << 62, Quantity: 4}}}},
+		{name: "item amount over limit", cart: Cart{Items: []CartItem{{UnitPrice: MaxCartAmount/2 + 1, Quantity: 2}}}},
+		{name: "total over limit", cart: Cart{Items: []CartItem{{UnitPrice: MaxCartAmount, Quantity: 1}, {UnitPrice: 1, Quantity: 1}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cart.validate()
+			if tt.ok && err != nil {
+				t.Fatalf("validate: %v", err)
+			}
+			if !tt.ok && !errors.Is(err, ErrInvalidCart) {
+				t.Fatalf("validate: err = %v, want %v", err, ErrInvalidCart)
+			}
+		})
+	}
+}
+
+// TestPercentOf : 곱한 뒤 나누는 값과 같고, int64 끝 값에서도 넘치지 않아야 함
+func TestPercentOf(t *testing.T) {
+	for _, amount := range []int64{0, 1, 99, 100, 9999, 123456789, MaxCartAmount, math.MaxInt64} {
+		for _, percent := range []int64{1, 15, 50, 99, 100} {
+			want := new(big.Int).Mul(big.NewInt(amount), big.NewInt(percent))
+			want.Quo(want, big.NewInt(100))
+			if got := percentOf(amount, percent); got != want.Int64() {
+				t.Errorf("percentOf(%d, %d) = %d, want %s", amount, percent, got, want)
+			}
+		}
+	}
+}
+
+// TestFreeItemsAmountMatchesUnitExpansion : 수량만큼 가격을 펼쳐서 계산한 값과 같아야 함
+func TestFreeItemsAmountMatchesUnitExpansion(t *testing.T) {
+	expand := func(b *Benefit, items []CartItem) int64 {
+		var prices []int64
+		for _, item := range items {
+			for i := 0; i < item.Quantity; i++ {
+				prices = append(prices, item.UnitPrice)
+			}
+		}
+		sort.Slice(prices, func(i, j int) bool { return prices[i] > prices[j] })
+
+		group, free := b.BuyQuantity+b.GetQuantity, int64(0)
+		for start := 0; start+group <= len(prices); start += group {
+			for _, price := range prices[start+b.BuyQuantity : start+group] {
+				free += price
+			}
+		}
+		return free
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		b := &Benefit{Type: BenefitBuyXGetY, BuyQuantity: 1 + rng.Intn(4), GetQuantity: 1 + rng.Intn(3)}
+		items := make([]CartItem, 1+rng.Intn(6))
+		for j := range items {
+			items[j] = CartItem{UnitPrice: int64(rng.Intn(5)) * 1000, Quantity: 1 + rng.Intn(9)}
+		}
+
+		if got, want := b.freeItemsAmount(items), expand(b, items); got != want {
+			t.Fatalf("buy %d get %d, items %+v: freeItemsAmount = %d, want %d", b.BuyQuantity, b.GetQuantity, items, got, want)
+		}
+	}
+}
@@ -1,13 +1,18 @@
 package cache
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/dev-jiemu/coupon-issuance-poc/pkg/models"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/tracing"
 	"github.com/dev-jiemu/coupon-issuance-poc/pkg/utils"
-	"log"
+	"log/slog"
+	"sort"
 	"sync"
 	"time"
+
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type Campaign struct {
@@ -15,8 +20,23 @@ type Campaign struct {
 	StartDate            time.Time
 	ExpiredDate          time.Time
 	MaxCoupons           int64
-	UnPublishedCouponIds []string // 발행 안된 coupon id 관리용
+	Benefit              *Benefit  // 없으면 할인 없음
+	Rules                []Rule    // 발급 조건, 생성 후에는 바뀌지 않아서 캠페인 lock 없이 읽음
+	Schedule             *Schedule // 없으면 기간 안에서 제한 없이 발급
+	Raffle               *Raffle   // 있으면 추첨으로만 발급
+	Tiers                []Tier    // 우선 발급 그룹, 생성 후에는 바뀌지 않음
+	UnPublishedCouponIds []string  // 발행 안된 coupon id 관리용 : available 상태의 쿠폰만 들어있음
 	Coupons              map[string]*models.Coupon
+	redeemed             int64       // 사용된 쿠폰 수 : metric 수집할때 Coupons 를 매번 순회하지 않으려고 따로 셈
+	couponsExpired       bool        // 종료 후 남은 쿠폰을 expired 로 바꿨는지 (janitor 가 매번 순회하지 않게)
+	holds                int         // held 상태인 쿠폰 수 : 예약 만료 확인할때 예약이 없는 캠페인은 건너뜀
+	issueTimes           []time.Time // rate 제한용 최근 발급 시각 (최대 RateLimit 개)
+	draw                 *drawState  // 추첨 캠페인만 있음
+	waitlist             []WaitlistEntry
+	tierIssued           map[string]int64 // tier 예약 수량에서 발급된 쿠폰 수 : 발급 대기 목록으로 돌아오면 줄어듦
+	releasedTiers        []string         // 예약 수량을 일반 발급으로 돌린 tier (감사 이력은 한번만 남김)
+	paused               bool             // 운영자가 발급을 멈춘 상태 : 사용은 그대로 가능
+	activity             activity         // 대시보드용 실패 수, 최근 이벤트
 	mutex                sync.RWMutex
 }
 
@@ -25,28 +45,115 @@ type CampaignInfo struct {
 	StartDate    string
 	ExpiredDate  string
 	AllCouponIds []string
+	Benefit      *Benefit
+	Rules        []Rule
+	Schedule     *Schedule
+	Raffle       *Raffle
+	Tiers        []Tier
+	TierReserved map[string]int64 // tier 별 남은 예약 수량
+	NextRelease  time.Time        // 다음 발급 시각, 없으면 zero
+	Paused       bool
+}
+
+// CampaignOptions : 캠페인 생성시 선택 항목
+type CampaignOptions struct {
+	Benefit  *Benefit  // 없으면 할인 없음
+	Rules    []Rule    // 없으면 누구나 발급 가능
+	Schedule *Schedule // 없으면 기간 안에서 제한 없이 발급
+	Raffle   *Raffle   // 있으면 선착순 대신 추첨으로 발급, Schedule 과 같이 쓸 수 없음
+	Tiers    []Tier    // 우선 발급 그룹, Schedule / Raffle 과 같이 쓸 수 없음
+}
+
+// IssueOptions : 발급 요청 선택 항목
+type IssueOptions struct {
+	Attributes   map[string]string // 캠페인 발급 조건을 평가할 사용자 속성
+	Tiers        []string          // 인증 토큰의 tiers claim, tier 의 UserIds 에 없어도 멤버로 취급함
+	JoinWaitlist bool              // 쿠폰이 소진된 경우 대기자로 등록함
 }
 
 type CampaignManager struct {
-	campaigns map[string]*Campaign
-	mutex     sync.RWMutex
+	tenants      map[string]*Tenant // tenant id -> 캠페인 목록 : 브랜드끼리 캠페인 ID 가 겹쳐도 됨
+	quotas       map[string]TenantQuota
+	defaultQuota TenantQuota
+	lockWait     func(operation string, wait time.Duration)
+	auditor      func(ctx context.Context, event AuditEvent)
+	outbox       *Outbox
+
+	reservationTTL    time.Duration
+	maxReservationTTL time.Duration
+	mutex             sync.RWMutex
+
+	// commitMutex : 발급/사용은 RLock, Snapshot 은 Lock
+	// 상태 변경과 outbox 이벤트가 항상 같은 snapshot 에 같이 들어가도록 함
+	commitMutex sync.RWMutex
 }
 
 var Manager *CampaignManager
 
 func NewCampaignManager() *CampaignManager {
-	fmt.Printf("Create Campaign Manager ** \n")
 	return &CampaignManager{
-		campaigns: make(map[string]*Campaign),
+		tenants: make(map[string]*Tenant),
+		quotas:  make(map[string]TenantQuota),
+		outbox:  newOutbox(),
+
+		reservationTTL:    5 * time.Minute,
+		maxReservationTTL: 30 * time.Minute,
 	}
 }
 
-func (v *CampaignManager) CreateCampaign(id string, start, end time.Time, maxCoupon int64) error {
+func (v *CampaignManager) CreateCampaign(ctx context.Context, tenantId, id string, start, end time.Time, maxCoupon int64, opts CampaignOptions) (err error) {
+	ctx, span := tracing.Start(ctx, "CampaignManager.CreateCampaign")
+	span.SetAttributes(attribute.String("tenant.id", tenantId), attribute.String("campaign.id", id), attribute.Int64("campaign.max_coupons", maxCoupon))
+	defer func() { tracing.End(span, err) }()
+
 	v.mutex.Lock()
 	defer v.mutex.Unlock()
 
-	if _, exists := v.campaigns[id]; exists {
-		return errors.New("campaign already exists")
+	tenant := v.tenant(tenantId, true)
+	if _, exists := tenant.campaigns[id]; exists {
+		return ErrCampaignExists
+	}
+
+	if err := tenant.checkQuota(maxCoupon, time.Now()); err != nil {
+		return err
+	}
+
+	if opts.Benefit != nil {
+		if err := opts.Benefit.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := ValidateRules(opts.Rules); err != nil {
+		return err
+	}
+
+	if opts.Schedule != nil {
+		if err := opts.Schedule.Validate(start, end, maxCoupon); err != nil {
+			return err
+		}
+	}
+
+	if len(opts.Tiers) > 0 {
+		if opts.Schedule != nil || opts.Raffle != nil {
+			return fmt.Errorf("%w: tiers cannot be used with schedule or raffle", ErrInvalidTier)
+		}
+		if err := ValidateTiers(opts.Tiers, end, maxCoupon); err != nil {
+			return err
+		}
+	}
+
+	var draw *drawState
+	if opts.Raffle != nil {
+		if opts.Schedule != nil {
+			return fmt.Errorf("%w: schedule cannot be used with raffle", ErrInvalidRaffle)
+		}
+		if err := opts.Raffle.Validate(start, end); err != nil {
+			return err
+		}
+		if draw, err = newDrawState(); err != nil {
+			return err
+		}
 	}
 
 	campaign := &Campaign{
@@ -54,77 +161,234 @@ func (v *CampaignManager) CreateCampaign(id string, start, end time.Time, maxCou
 		StartDate:            start,
 		ExpiredDate:          end,
 		MaxCoupons:           maxCoupon,
+		Benefit:              opts.Benefit,
+		Rules:                opts.Rules,
+		Schedule:             opts.Schedule,
+		Raffle:               opts.Raffle,
+		Tiers:                opts.Tiers,
+		draw:                 draw,
+		tierIssued:           make(map[string]int64, len(opts.Tiers)),
 		UnPublishedCouponIds: make([]string, 0, maxCoupon),
 		Coupons:              make(map[string]*models.Coupon, maxCoupon),
 	}
 
 	// 미리 쿠폰 ID는 생성해둠 : 나중에 발급요청 할때 발급유무 변경
-	// 이렇게 하면 campaign_id 내에서는 중복을 체크하지만 campaign_id 끼리의 쿠폰 ID 는 겹칠 수도 있다는 단점이 있음...
-	existingCouponIDs := make(map[string]bool)
-	generatedCount := int64(0)
+	if err := generateCoupons(ctx, tenant, campaign, maxCoupon); err != nil {
+		return err
+	}
+
+	tenant.campaigns[id] = campaign
+	v.audit(ctx, AuditEvent{
+		Action:     AuditCampaignCreated,
+		TenantId:   tenantId,
+		CampaignId: id,
+		After:      campaignAuditValues(campaign),
+	})
+
+	return nil
+}
+
+// DeleteCampaign : 캠페인과 쿠폰을 지움, 종료 전 캠페인에 사용자가 가진 쿠폰(issued, held)이 있으면 force 일때만 지움
+func (v *CampaignManager) DeleteCampaign(ctx context.Context, tenantId, campaignId string, force bool) (err error) {
+	ctx, span := tracing.Start(ctx, "CampaignManager.DeleteCampaign")
+	span.SetAttributes(attribute.String("tenant.id", tenantId), attribute.String("campaign.id", campaignId), attribute.Bool("force", force))
+	defer func() { tracing.End(span, err) }()
+
+	v.commitMutex.RLock()
+	defer v.commitMutex.RUnlock()
+
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	tenant := v.tenant(tenantId, false)
+	if tenant == nil {
+		return ErrCampaignNotExists
+	}
+	campaign, exists := tenant.campaigns[campaignId]
+	if !exists {
+		return ErrCampaignNotExists
+	}
+
+	// 진행중인 발급/사용 요청이 끝난 뒤에 지움
+	v.lockCampaign(ctx, campaign, "delete")
+	defer campaign.mutex.Unlock()
+
+	if !force && !time.Now().After(campaign.ExpiredDate) {
+		for _, coupon := range campaign.Coupons {
+			if coupon.State == models.CouponIssued || coupon.State == models.CouponHeld {
+				return ErrCampaignInUse
+			}
+		}
+	}
+
+	v.removeCampaign(ctx, tenant, campaign, AuditCampaignDeleted)
+	return nil
+}
+
+// SetPaused : 캠페인 발급을 멈추거나 다시 시작함, 멈춘 동안에는 대기자 발급, 추첨도 미뤄짐
+// 이미 같은 상태면 아무것도 하지 않음
+func (v *CampaignManager) SetPaused(ctx context.Context, tenantId, campaignId string, paused bool, reason string) (err error) {
+	ctx, span := tracing.Start(ctx, "CampaignManager.SetPaused")
+	span.SetAttributes(attribute.String("tenant.id", tenantId), attribute.String("campaign.id", campaignId), attribute.Bool("paused", paused))
+	defer func() { tracing.End(span, err) }()
+
+	v.commitMutex.RLock()
+	defer v.commitMutex.RUnlock()
+
+	_, campaign, exists := v.getCampaign(tenantId, campaignId)
+	if !exists {
+		return ErrCampaignNotExists
+	}
+
+	v.lockCampaign(ctx, campaign, "pause")
+	defer campaign.mutex.Unlock()
+
+	if campaign.paused == paused {
+		return nil
+	}
+	campaign.paused = paused
+
+	event := AuditEvent{Action: AuditCampaignResumed, TenantId: tenantId, CampaignId: campaignId}
+	if paused {
+		event.Action = AuditCampaignPaused
+		event.After = map[string]any{"reason": reason}
+	}
+	v.audit(ctx, event)
+
+	if !paused {
+		if issued := v.backfill(ctx, tenantId, campaign, time.Now()); issued > 0 {
+			slog.InfoContext(ctx, "issued coupons to waitlist after resume", "count", issued)
+		}
+	}
+	return nil
+}
+
+// generateCoupons : count 개의 쿠폰을 만들어서 발급 대기 목록에 넣음, v.mutex 를 잡은 상태에서 호출
+// tenant 단위로 쿠폰 코드를 모아두고 있어서 같은 tenant 의 다른 캠페인과도 겹치지 않음
+func generateCoupons(ctx context.Context, tenant *Tenant, campaign *Campaign, count int64) (err error) {
+	_, genSpan := tracing.Start(ctx, "generate coupon codes")
+	defer func() { tracing.End(genSpan, err) }()
+
+	generatedCount, collisions := int64(0), 0
 
 	// 500 ~ 1000건 정도 라고 했으니까 이정돈 for문 써도 상관은 없는데... 더 많은 양의 생성이 필요하다면 고루틴 써야할듯함
-	for generatedCount < maxCoupon {
+	for generatedCount < count {
 		couponId, err := utils.GenerateCouponCode(10)
 		if err != nil {
 			return fmt.Errorf("failed to generate coupon ID: %w", err)
 		}
 
-		fmt.Printf("generated coupon ID: %s \n", couponId)
-
-		if existingCouponIDs[couponId] { // 중복이면 다시 만들기
+		if _, exists := tenant.couponCodes[couponId]; exists { // 중복이면 다시 만들기
+			collisions++
 			continue
 		}
 
-		existingCouponIDs[couponId] = true
-
-		coupon := &models.Coupon{
-			CouponId:    couponId,
-			StartDate:   start,
-			ExpiredDate: end,
-			PublishYn:   false,
-			UseYn:       false,
-		}
-
-		campaign.Coupons[couponId] = coupon
-		campaign.UnPublishedCouponIds = append(campaign.UnPublishedCouponIds, couponId)
+		addCoupon(tenant, campaign, couponId)
 		generatedCount++
 	}
 
-	v.campaigns[id] = campaign
+	// 쿠폰 코드는 하나씩 로그로 남기지 않음 (유출 위험, 대량 생성시 로그 폭주)
+	slog.DebugContext(ctx, "generated coupon codes", "count", generatedCount, "collisions", collisions)
+	genSpan.SetAttributes(attribute.Int64("coupon.generated", generatedCount), attribute.Int("coupon.collisions", collisions))
 
 	return nil
 }
 
-func (v *CampaignManager) PublishCoupon(campaignId string) (*models.Coupon, error) {
+// addCoupon : available 쿠폰을 만들어서 발급 대기 목록에 넣음, 코드 중복은 호출하는 쪽에서 확인
+func addCoupon(tenant *Tenant, campaign *Campaign, couponId string) {
+	tenant.couponCodes[couponId] = campaign.CampaignId
+	campaign.Coupons[couponId] = &models.Coupon{
+		CouponId:    couponId,
+		StartDate:   campaign.StartDate,
+		ExpiredDate: campaign.ExpiredDate,
+		State:       models.CouponAvailable,
+	}
+	campaign.UnPublishedCouponIds = append(campaign.UnPublishedCouponIds, couponId)
+}
+
+// PublishCoupon : 발급 조건을 만족하지 못하면 *EligibilityError
+// JoinWaitlist 면 쿠폰이 소진된 경우 대기자로 등록하고 *WaitlistError 를 반환함
+// tier 멤버는 tier 예약 수량에서 먼저 발급하고, 발급된 쿠폰의 Tier 에 tier 이름이 남음
+func (v *CampaignManager) PublishCoupon(ctx context.Context, tenantId, campaignId, userId string, opts IssueOptions) (_ *models.Coupon, err error) {
+	ctx, span := tracing.Start(ctx, "CampaignManager.PublishCoupon")
+	span.SetAttributes(attribute.String("tenant.id", tenantId), attribute.String("campaign.id", campaignId))
+	defer func() { tracing.End(span, err) }()
+
+	v.commitMutex.RLock()
+	defer v.commitMutex.RUnlock()
+
 	v.mutex.RLock()
-	campaign, exists := v.campaigns[campaignId]
+	tenant := v.tenant(tenantId, false)
+	var campaign *Campaign
+	exists, allowed := false, false
+	if tenant != nil {
+		campaign, exists = tenant.campaigns[campaignId]
+		allowed = exists && tenant.allowIssue()
+	}
 	v.mutex.RUnlock()
 
 	if !exists {
-		return nil, errors.New("campaign is not exists")
+		return nil, ErrCampaignNotExists
 	}
 
-	campaign.mutex.Lock()
-	defer campaign.mutex.Unlock()
+	defer func() {
+		if err != nil {
+			campaign.activity.fail(ActivityIssueFailed, "", userId, err)
+		}
+	}()
 
-	// 요청 시점 확인
-	now := time.Now()
+	if !allowed {
+		return nil, ErrTenantRateLimited
+	}
 
-	startDateKST := campaign.StartDate.In(time.Local)
-	expiredDateKST := campaign.ExpiredDate.In(time.Local)
+	if campaign.Raffle != nil {
+		return nil, ErrRaffleCampaign
+	}
 
-	log.Printf("현재 시간: %v (UTC: %v)", now, now.UTC())
-	log.Printf("캠페인 시작 시간(KST): %v, 종료 시간(KST): %v", startDateKST, expiredDateKST)
-	log.Printf("시작 시간 이전 여부: %v, 종료 시간 이후 여부: %v", now.Before(startDateKST), now.After(expiredDateKST))
+	// 발급 조건은 캠페인 lock 을 잡기 전에 평가해서 조건에 맞지 않는 요청이 발급을 기다리지 않게 함
+	if len(campaign.Rules) > 0 {
+		if err := checkEligibility(campaign.Rules, withUserId(opts.Attributes, userId), time.Now()); err != nil {
+			return nil, err
+		}
+	}
 
-	// KST로 변환된 시간으로 비교
-	if now.Before(startDateKST) || now.After(expiredDateKST) {
-		return nil, errors.New("campaign not valid at this time")
+	v.lockCampaign(ctx, campaign, "publish")
+	defer campaign.mutex.Unlock()
+
+	if campaign.paused {
+		return nil, ErrCampaignPaused
 	}
 
-	if len(campaign.UnPublishedCouponIds) == 0 {
-		return nil, errors.New("no more available coupon")
+	// 요청 시점 확인 : 기간, 남은 수량, 발급 일정 (wave, 시간대, rate)
+	now := time.Now()
+
+	slog.DebugContext(ctx, "campaign period check", "now", now, "start", campaign.StartDate, "expired", campaign.ExpiredDate,
+		"beforeStart", now.Before(campaign.StartDate), "afterExpired", now.After(campaign.ExpiredDate))
+
+	tier, tierStartsAt := campaign.memberTier(userId, opts.Tiers, now)
+	if tier != nil {
+		// 예약 수량은 일반 발급 시작 전이어도 tier 시작 시각부터 발급함
+		if now.After(campaign.ExpiredDate) {
+			return nil, ErrCampaignNotValidTime
+		}
+		if len(campaign.UnPublishedCouponIds) == 0 {
+			return nil, ErrNoMoreCoupon
+		}
+	} else if err := campaign.checkSchedule(now); err != nil {
+		// 아직 시작하지 않은 tier 의 멤버는 tier 시작 시각이 더 빠르면 그 시각을 알려줌
+		if !tierStartsAt.IsZero() {
+			var scheduled *ScheduleError
+			if !errors.As(err, &scheduled) {
+				return nil, &ScheduleError{Cause: err, NextRelease: tierStartsAt}
+			}
+			if tierStartsAt.Before(scheduled.NextRelease) {
+				return nil, &ScheduleError{Cause: scheduled.Cause, NextRelease: tierStartsAt}
+			}
+		}
+		if opts.JoinWaitlist && userId != "" && errors.Is(err, ErrNoMoreCoupon) && campaign.exhausted(now) && !now.After(campaign.ExpiredDate) {
+			return nil, &WaitlistError{Position: campaign.joinWaitlist(userId, now)}
+		}
+		return nil, err
 	}
 
 	// 발행처리
@@ -132,77 +396,251 @@ func (v *CampaignManager) PublishCoupon(campaignId string) (*models.Coupon, erro
 	couponId := campaign.UnPublishedCouponIds[lastIdx]
 	campaign.UnPublishedCouponIds = campaign.UnPublishedCouponIds[:lastIdx]
 
+	change := couponChange{action: AuditCouponIssued, tenantId: tenantId, userId: userId}
+	if tier != nil {
+		change.tier = tier.Name
+		change.extra = map[string]any{"tier": tier.Name}
+	}
+
 	coupon := campaign.Coupons[couponId]
-	coupon.PublishYn = true
+	err = v.transition(ctx, campaign, coupon, models.CouponIssued, change)
+	if err != nil {
+		return nil, err
+	}
+
+	campaign.recordIssue(now)
+
+	v.outbox.enqueue(Event{Type: EventCouponIssued, Time: now, TenantId: tenantId, CampaignId: campaignId, CouponCode: couponId, UserId: userId})
+	if len(campaign.UnPublishedCouponIds) == 0 {
+		v.outbox.enqueue(Event{Type: EventCampaignExhausted, Time: now, TenantId: tenantId, CampaignId: campaignId})
+	}
 
 	return coupon, nil
 }
 
-func (v *CampaignManager) UseCoupon(campaignId, couponId string) error {
-	v.mutex.RLock()
-	campaign, exists := v.campaigns[campaignId]
-	v.mutex.RUnlock()
+// UseCoupon : userId 가 비어있지 않으면 발급받은 사용자 본인인지 확인함
+// 예약(held)된 쿠폰은 같은 reservationId 로 요청한 경우에만 사용할 수 있음
+// cart 가 있으면 최소 주문 금액 등 혜택 조건을 확인하고 적용된 할인 금액을 반환함
+func (v *CampaignManager) UseCoupon(ctx context.Context, tenantId, campaignId, couponId, userId, reservationId string, cart *Cart) (_ *Discount, err error) {
+	ctx, span := tracing.Start(ctx, "CampaignManager.UseCoupon")
+	span.SetAttributes(attribute.String("tenant.id", tenantId), attribute.String("campaign.id", campaignId))
+	defer func() { tracing.End(span, err) }()
+
+	v.commitMutex.RLock()
+	defer v.commitMutex.RUnlock()
+
+	_, campaign, exists := v.getCampaign(tenantId, campaignId)
 
 	if !exists {
-		return errors.New("campaign is not exists")
+		return nil, ErrCampaignNotExists
 	}
 
-	campaign.mutex.Lock()
+	defer func() {
+		if err != nil {
+			campaign.activity.fail(ActivityRedeemFailed, couponId, userId, err)
+		}
+	}()
+
+	v.lockCampaign(ctx, campaign, "use")
 	defer campaign.mutex.Unlock()
 
 	coupon, exists := campaign.Coupons[couponId]
 	if !exists {
-		return errors.New("coupon is not exists")
+		return nil, ErrCouponNotExists
+	}
+
+	now := time.Now()
+	v.releaseExpiredHold(ctx, tenantId, campaign, coupon, now)
+
+	return v.redeem(ctx, tenantId, campaign, coupon, userId, reservationId, cart, now)
+}
+
+// redeem : 캠페인 lock 을 잡은 상태에서 호출, cart 가 없으면 할인 금액은 nil
+func (v *CampaignManager) redeem(ctx context.Context, tenantId string, campaign *Campaign, coupon *models.Coupon, userId, reservationId string, cart *Cart, now time.Time) (*Discount, error) {
+	if err := checkUsable(ctx, coupon, userId, reservationId, now); err != nil {
+		return nil, err
+	}
+
+	change := couponChange{action: AuditCouponRedeemed, tenantId: tenantId, extra: map[string]any{}}
+	if coupon.State == models.CouponHeld {
+		change.extra["reservationId"] = reservationId
+	}
+
+	var discount *Discount
+	if cart != nil {
+		var err error
+		if discount, err = campaign.Benefit.Apply(cart); err != nil {
+			return nil, err
+		}
+		change.extra["discountAmount"] = discount.Amount
+	}
+
+	if err := v.transition(ctx, campaign, coupon, models.CouponRedeemed, change); err != nil {
+		return nil, err
+	}
+
+	v.outbox.enqueue(Event{Type: EventCouponRedeemed, Time: now, TenantId: tenantId, CampaignId: campaign.CampaignId, CouponCode: coupon.CouponId, UserId: coupon.UserId})
+
+	return discount, nil
+}
+
+// QuoteDiscount : RedeemCoupon 에 같은 cart 를 보냈을때 적용될 할인 금액, 쿠폰 상태는 바꾸지 않음
+func (v *CampaignManager) QuoteDiscount(ctx context.Context, tenantId, campaignId, couponId, userId, reservationId string, cart *Cart) (*Discount, error) {
+	_, campaign, exists := v.getCampaign(tenantId, campaignId)
+	if !exists {
+		return nil, ErrCampaignNotExists
+	}
+
+	campaign.mutex.RLock()
+	defer campaign.mutex.RUnlock()
+
+	coupon, exists := campaign.Coupons[couponId]
+	if !exists {
+		return nil, ErrCouponNotExists
+	}
+
+	// 예약 시간이 지난 예약은 사용할때 풀리므로 여기서도 없는 예약으로 봄
+	now := time.Now()
+	checked := *coupon
+	if checked.State == models.CouponHeld && !checked.HeldUntil.After(now) {
+		checked.State, checked.ReservationId = models.CouponIssued, ""
+	}
+	if err := checkUsable(ctx, &checked, userId, reservationId, now); err != nil {
+		return nil, err
+	}
+
+	return campaign.Benefit.Apply(cart)
+}
+
+// checkUsable : 사용(또는 사용을 위한 예약)이 가능한 쿠폰인지 확인
+func checkUsable(ctx context.Context, coupon *models.Coupon, userId, reservationId string, now time.Time) error {
+	// 발행 안된 쿠폰, 회수된 쿠폰 사용금지
+	switch coupon.State {
+	case models.CouponAvailable:
+		return ErrCouponNotPublished
+	case models.CouponRevoked:
+		return ErrCouponRevoked
 	}
 
-	// 발행 안된 쿠폰 사용금지
-	if !coupon.PublishYn {
-		return errors.New("coupon is not published")
+	// 다른 사용자에게 발급된 쿠폰 사용금지
+	if userId != "" && coupon.UserId != userId {
+		return ErrCouponNotIssuedToUser
 	}
 
 	// 이미 사용된 쿠폰이면 에러처리
-	if coupon.UseYn {
-		return errors.New("coupon is already used")
+	if coupon.State == models.CouponRedeemed {
+		return ErrCouponAlreadyUsed
+	}
+
+	// 결제 진행중인 다른 예약이 잡고 있는 쿠폰 사용금지
+	if coupon.State == models.CouponHeld && coupon.ReservationId != reservationId {
+		return ErrCouponHeld
 	}
 
 	// startDate 보다 이전이거나 expiredDate 이후면 에러처리
-	now := time.Now()
 	startDateKST := coupon.StartDate.In(time.Local)
 	expiredDateKST := coupon.ExpiredDate.In(time.Local)
 
-	log.Printf("캠페인 시작 시간(KST): %v, 종료 시간(KST): %v", startDateKST, expiredDateKST)
-	log.Printf("시작 시간 이전 여부: %v, 종료 시간 이후 여부: %v", now.Before(startDateKST), now.After(expiredDateKST))
+	slog.DebugContext(ctx, "coupon period check", "now", now, "start", startDateKST, "expired", expiredDateKST,
+		"beforeStart", now.Before(startDateKST), "afterExpired", now.After(expiredDateKST))
 
 	// KST로 변환된 시간으로 비교
-	if now.Before(startDateKST) || now.After(expiredDateKST) {
-		return errors.New("coupon not valid at this time")
+	if coupon.State == models.CouponExpired || now.Before(startDateKST) || now.After(expiredDateKST) {
+		return ErrCouponNotValidTime
 	}
 
-	coupon.UseYn = true
-
 	return nil
 }
 
-// GetCampaignInfo : 캠페인 등록 시점에 쿠폰을 만드는게 아니라, 캠페인 시작 시점에 쿠폰이 실시간으로 바뀐다면 mutax 필요할듯
-// 지금으로썬 그저 조회만 하는 역할에 가까워서 mutax 뺌
-func (v *CampaignManager) GetCampaignInfo(campaignId string) (*CampaignInfo, error) {
-	campaign, exists := v.campaigns[campaignId]
+// SetLockWaitObserver : 캠페인 lock 을 잡기까지 기다린 시간을 받음 (metric 용), 서버 가동 전에 한번만 설정
+func (v *CampaignManager) SetLockWaitObserver(observe func(operation string, wait time.Duration)) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	v.lockWait = observe
+}
+
+// lockCampaign : 같은 캠페인에 발급/사용 요청이 몰리면 여기서 대기함
+func (v *CampaignManager) lockCampaign(ctx context.Context, campaign *Campaign, operation string) {
+	_, span := tracing.Start(ctx, "campaign lock")
+	defer span.End()
+
+	if v.lockWait == nil {
+		campaign.mutex.Lock()
+		return
+	}
+
+	start := time.Now()
+	campaign.mutex.Lock()
+	v.lockWait(operation, time.Since(start))
+}
+
+// GetCampaignInfo : 증액(RaiseMaxCoupons), 가져오기(ImportCoupons) 가 쿠폰 맵에 쓰기 때문에 캠페인 read lock 안에서 복사함
+func (v *CampaignManager) GetCampaignInfo(tenantId, campaignId string) (*CampaignInfo, error) {
+	_, campaign, exists := v.getCampaign(tenantId, campaignId)
 	if !exists {
-		return nil, errors.New("campaign is not exists")
+		return nil, ErrCampaignNotExists
 	}
 
 	ret := &CampaignInfo{}
 	ret.CampaignId = campaign.CampaignId
 	ret.StartDate = campaign.StartDate.Format("2006-01-02 15:04:05")
 	ret.ExpiredDate = campaign.ExpiredDate.Format("2006-01-02 15:04:05")
+	ret.Benefit = campaign.Benefit
+	ret.Rules = campaign.Rules
+	ret.Schedule = campaign.Schedule
+	ret.Raffle = campaign.Raffle
+	ret.Tiers = campaign.Tiers
 
-	coupons := make([]string, 0, campaign.MaxCoupons)
+	campaign.mutex.RLock()
+	defer campaign.mutex.RUnlock()
 
-	for couponId, _ := range campaign.Coupons {
-		coupons = append(coupons, couponId)
+	now := time.Now()
+	ret.Paused = campaign.paused
+	ret.NextRelease = campaign.nextRelease(now)
+	if len(campaign.Tiers) > 0 {
+		ret.TierReserved = make(map[string]int64, len(campaign.Tiers))
+		for i := range campaign.Tiers {
+			ret.TierReserved[campaign.Tiers[i].Name] = campaign.reservedRemaining(&campaign.Tiers[i], now)
+		}
 	}
 
+	coupons := make([]string, 0, len(campaign.Coupons))
+	for couponId := range campaign.Coupons {
+		coupons = append(coupons, couponId)
+	}
 	ret.AllCouponIds = coupons
 
 	return ret, nil
 }
+
+// ListCampaigns : tenant 에 속한 캠페인 목록 (쿠폰 코드는 제외)
+func (v *CampaignManager) ListCampaigns(tenantId string) []*CampaignInfo {
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	tenant := v.tenant(tenantId, false)
+	if tenant == nil {
+		return []*CampaignInfo{}
+	}
+
+	ret := make([]*CampaignInfo, 0, len(tenant.campaigns))
+	for _, campaign := range tenant.campaigns {
+		ret = append(ret, &CampaignInfo{
+			CampaignId:  campaign.CampaignId,
+			StartDate:   campaign.StartDate.Format("2006-01-02 15:04:05"),
+			ExpiredDate: campaign.ExpiredDate.Format("2006-01-02 15:04:05"),
+			Benefit:     campaign.Benefit,
+			Rules:       campaign.Rules,
+			Schedule:    campaign.Schedule,
+			Raffle:      campaign.Raffle,
+			Tiers:       campaign.Tiers,
+		})
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].CampaignId < ret[j].CampaignId
+	})
+
+	return ret
+}
@@ -0,0 +1,257 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/models"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/tracing"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// couponChange : 상태 변경 한건의 감사 이력에 남길 내용
+type couponChange struct {
+	action   string
+	tenantId string
+	userId   string         // 변경 후 사용자, 비어있으면 그대로
+	tier     string         // tier 예약 수량에서 발급하는 경우 tier 이름
+	extra    map[string]any // reason 등 After 에 같이 남길 값
+}
+
+// transition : 캠페인 lock 을 잡은 상태에서 호출, 허용되지 않는 변경이면 아무것도 바꾸지 않음
+func (v *CampaignManager) transition(ctx context.Context, campaign *Campaign, coupon *models.Coupon, next models.CouponState, change couponChange) error {
+	prev := coupon.State
+	if !prev.CanTransitionTo(next) {
+		return fmt.Errorf("%w: %s -> %s", ErrCouponInvalidState, prev, next)
+	}
+
+	before := map[string]any{"state": prev, "userId": coupon.UserId}
+	if prev == models.CouponHeld {
+		before["reservationId"] = coupon.ReservationId
+		coupon.ReservationId, coupon.HeldUntil = "", time.Time{}
+		campaign.holds--
+	}
+
+	coupon.State = next
+	switch {
+	case next == models.CouponAvailable:
+		coupon.UserId = ""
+	case change.userId != "":
+		coupon.UserId = change.userId
+	}
+
+	// 발급/사용 시각 : 예약(held) 을 거쳐도 처음 발급된 시각은 그대로 둠
+	switch {
+	case next == models.CouponAvailable:
+		coupon.IssuedAt, coupon.RedeemedAt = time.Time{}, time.Time{}
+	case next == models.CouponIssued && prev == models.CouponAvailable:
+		coupon.IssuedAt = time.Now()
+	case next == models.CouponRedeemed:
+		coupon.RedeemedAt = time.Now()
+	case prev == models.CouponRedeemed:
+		coupon.RedeemedAt = time.Time{}
+	}
+
+	// 발급 대기 목록으로 돌아온 쿠폰은 다시 tier 예약 수량이 됨
+	if next == models.CouponAvailable && coupon.Tier != "" {
+		campaign.tierIssued[coupon.Tier]--
+		coupon.Tier = ""
+	}
+	if change.tier != "" {
+		coupon.Tier = change.tier
+		campaign.tierIssued[change.tier]++
+	}
+
+	if prev == models.CouponRedeemed {
+		campaign.redeemed--
+	}
+	if next == models.CouponRedeemed {
+		campaign.redeemed++
+	}
+	if next == models.CouponHeld {
+		campaign.holds++
+	}
+	// 종료 후에 발급/사용 취소된 쿠폰도 다음 janitor 에서 만료되도록 다시 확인하게 함
+	if next == models.CouponIssued || next == models.CouponAvailable {
+		campaign.couponsExpired = false
+	}
+
+	after := map[string]any{"state": next, "userId": coupon.UserId}
+	for k, val := range change.extra {
+		after[k] = val
+	}
+
+	v.audit(ctx, AuditEvent{
+		Action:     change.action,
+		TenantId:   change.tenantId,
+		CampaignId: campaign.CampaignId,
+		CouponCode: coupon.CouponId,
+		Before:     before,
+		After:      after,
+	})
+
+	// 종료 후 한번에 만료되는 쿠폰은 최근 이벤트를 덮어쓰지 않게 뺌
+	if change.action != AuditCouponExpired {
+		campaign.activity.record(ActivityEvent{Time: time.Now(), Type: change.action, CouponCode: coupon.CouponId, UserId: coupon.UserId})
+	}
+
+	return nil
+}
+
+// RevokeCoupon : 잘못 발급된 쿠폰 회수
+// returnToPool 이면 발급된 쿠폰을 발급 전 상태로 돌려서 다른 사용자에게 다시 발급될 수 있게 함
+// 사용된 쿠폰은 UnredeemCoupon 으로 먼저 사용을 취소해야 회수할 수 있음
+func (v *CampaignManager) RevokeCoupon(ctx context.Context, tenantId, campaignId, couponId string, returnToPool bool, reason string) (_ *models.Coupon, err error) {
+	ctx, span := tracing.Start(ctx, "CampaignManager.RevokeCoupon")
+	span.SetAttributes(attribute.String("tenant.id", tenantId), attribute.String("campaign.id", campaignId), attribute.Bool("coupon.return_to_pool", returnToPool))
+	defer func() { tracing.End(span, err) }()
+
+	v.commitMutex.RLock()
+	defer v.commitMutex.RUnlock()
+
+	_, campaign, exists := v.getCampaign(tenantId, campaignId)
+	if !exists {
+		return nil, ErrCampaignNotExists
+	}
+
+	v.lockCampaign(ctx, campaign, "revoke")
+	defer campaign.mutex.Unlock()
+
+	coupon, exists := campaign.Coupons[couponId]
+	if !exists {
+		return nil, ErrCouponNotExists
+	}
+
+	prev, userId := coupon.State, coupon.UserId
+	next := models.CouponRevoked
+	if returnToPool {
+		next = models.CouponAvailable
+	}
+
+	err = v.transition(ctx, campaign, coupon, next, couponChange{
+		action:   AuditCouponRevoked,
+		tenantId: tenantId,
+		extra:    map[string]any{"reason": reason, "returnToPool": returnToPool},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 발급 대기 목록은 항상 available 상태의 쿠폰만 들어있게 유지함
+	switch {
+	case returnToPool:
+		campaign.UnPublishedCouponIds = append(campaign.UnPublishedCouponIds, couponId)
+	case prev == models.CouponAvailable:
+		campaign.UnPublishedCouponIds = slices.DeleteFunc(campaign.UnPublishedCouponIds, func(id string) bool {
+			return id == couponId
+		})
+	}
+
+	now := time.Now()
+	v.outbox.enqueue(Event{Type: EventCouponRevoked, Time: now, TenantId: tenantId, CampaignId: campaignId, CouponCode: couponId, UserId: userId})
+
+	// 돌려놓은 쿠폰은 바로 다음 대기자에게 발급함 (응답은 회수 직후 상태)
+	copied := *coupon
+	campaign.draw.markReclaimed(couponId, userId)
+	if returnToPool {
+		v.backfill(ctx, tenantId, campaign, now)
+	}
+
+	return &copied, nil
+}
+
+// UnredeemCoupon : 주문 취소 등으로 사용을 되돌림, 쿠폰은 같은 사용자에게 발급된 상태로 돌아감
+func (v *CampaignManager) UnredeemCoupon(ctx context.Context, tenantId, campaignId, couponId, reason string) (err error) {
+	ctx, span := tracing.Start(ctx, "CampaignManager.UnredeemCoupon")
+	span.SetAttributes(attribute.String("tenant.id", tenantId), attribute.String("campaign.id", campaignId))
+	defer func() { tracing.End(span, err) }()
+
+	v.commitMutex.RLock()
+	defer v.commitMutex.RUnlock()
+
+	_, campaign, exists := v.getCampaign(tenantId, campaignId)
+	if !exists {
+		return ErrCampaignNotExists
+	}
+
+	v.lockCampaign(ctx, campaign, "unredeem")
+	defer campaign.mutex.Unlock()
+
+	coupon, exists := campaign.Coupons[couponId]
+	if !exists {
+		return ErrCouponNotExists
+	}
+
+	err = v.transition(ctx, campaign, coupon, models.CouponIssued, couponChange{
+		action:   AuditCouponUnredeemed,
+		tenantId: tenantId,
+		extra:    map[string]any{"reason": reason},
+	})
+	if err != nil {
+		return err
+	}
+
+	v.outbox.enqueue(Event{Type: EventCouponUnredeemed, Time: time.Now(), TenantId: tenantId, CampaignId: campaignId, CouponCode: couponId, UserId: coupon.UserId})
+
+	return nil
+}
+
+// ExpireCoupons : 종료된 캠페인에서 사용되지 않은 쿠폰을 expired 로 바꿈, janitor 가 주기적으로 호출
+// 발급된 쿠폰은 한건씩, 발급되지 않은 쿠폰은 캠페인당 한건으로 묶어서 감사 이력을 남김
+func (v *CampaignManager) ExpireCoupons(now time.Time) int {
+	v.commitMutex.RLock()
+	defer v.commitMutex.RUnlock()
+
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	ctx := context.Background()
+	expired := 0
+
+	for tenantId, tenant := range v.tenants {
+		for _, campaign := range tenant.campaigns {
+			if !campaign.ExpiredDate.Before(now) {
+				continue
+			}
+
+			campaign.mutex.Lock()
+			if campaign.couponsExpired {
+				campaign.mutex.Unlock()
+				continue
+			}
+
+			unissued := 0
+			for _, coupon := range campaign.Coupons {
+				switch coupon.State {
+				case models.CouponIssued:
+					v.transition(ctx, campaign, coupon, models.CouponExpired, couponChange{action: AuditCouponExpired, tenantId: tenantId})
+				case models.CouponAvailable:
+					coupon.State = models.CouponExpired
+					unissued++
+				default:
+					continue
+				}
+				expired++
+			}
+
+			if unissued > 0 {
+				v.audit(ctx, AuditEvent{
+					Action:     AuditCouponExpired,
+					TenantId:   tenantId,
+					CampaignId: campaign.CampaignId,
+					Before:     map[string]any{"state": models.CouponAvailable, "count": unissued},
+					After:      map[string]any{"state": models.CouponExpired, "count": unissued},
+				})
+			}
+
+			campaign.UnPublishedCouponIds = campaign.UnPublishedCouponIds[:0]
+			campaign.couponsExpired = true
+			campaign.mutex.Unlock()
+		}
+	}
+
+	return expired
+}
@@ -0,0 +1,119 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/models"
+)
+
+func TestCouponLifecycle(t *testing.T) {
+	ctx := context.Background()
+	m := NewCampaignManager()
+	campaign := newTestCampaign(t, m, "brand", "spring", 2, CampaignOptions{})
+
+	coupon, err := m.PublishCoupon(ctx, "brand", "spring", "u1", IssueOptions{})
+	if err != nil {
+		t.Fatalf("PublishCoupon: %v", err)
+	}
+	code := coupon.CouponId
+	if coupon.State != models.CouponIssued || coupon.IssuedAt.IsZero() {
+		t.Fatalf("issued coupon = %+v", coupon)
+	}
+	issuedAt := coupon.IssuedAt
+
+	steps := []struct {
+		name    string
+		do      func() error
+		err     error
+		state   models.CouponState
+		userId  string
+		pool    int // 발급 대기 목록 길이
+		revoked bool
+	}{
+		{name: "other user cannot redeem", do: func() error { _, err := m.UseCoupon(ctx, "brand", "spring", code, "u2", "", nil); return err }, err: ErrCouponNotIssuedToUser, state: models.CouponIssued, userId: "u1", pool: 1},
+		{name: "redeem", do: func() error { _, err := m.UseCoupon(ctx, "brand", "spring", code, "u1", "", nil); return err }, state: models.CouponRedeemed, userId: "u1", pool: 1},
+		{name: "redeem twice", do: func() error { _, err := m.UseCoupon(ctx, "brand", "spring", code, "u1", "", nil); return err }, err: ErrCouponAlreadyUsed, state: models.CouponRedeemed, userId: "u1", pool: 1},
+		{name: "revoke redeemed", do: func() error { _, err := m.RevokeCoupon(ctx, "brand", "spring", code, false, "fraud"); return err }, err: ErrCouponInvalidState, state: models.CouponRedeemed, userId: "u1", pool: 1},
+		{name: "unredeem", do: func() error { return m.UnredeemCoupon(ctx, "brand", "spring", code, "order canceled") }, state: models.CouponIssued, userId: "u1", pool: 1},
+		{name: "unredeem issued", do: func() error { return m.UnredeemCoupon(ctx, "brand", "spring", code, "again") }, err: ErrCouponInvalidState, state: models.CouponIssued, userId: "u1", pool: 1},
+		{name: "return to pool", do: func() error { _, err := m.RevokeCoupon(ctx, "brand", "spring", code, true, "wrong user"); return err }, state: models.CouponAvailable, pool: 2},
+		{name: "cannot redeem available", do: func() error { _, err := m.UseCoupon(ctx, "brand", "spring", code, "", "", nil); return err }, err: ErrCouponNotPublished, state: models.CouponAvailable, pool: 2},
+		{name: "revoke available", do: func() error { _, err := m.RevokeCoupon(ctx, "brand", "spring", code, false, "leaked"); return err }, state: models.CouponRevoked, pool: 1, revoked: true},
+		{name: "cannot redeem revoked", do: func() error { _, err := m.UseCoupon(ctx, "brand", "spring", code, "", "", nil); return err }, err: ErrCouponRevoked, state: models.CouponRevoked, pool: 1, revoked: true},
+		{name: "revoked is final", do: func() error { _, err := m.RevokeCoupon(ctx, "brand", "spring", code, true, "undo"); return err }, err: ErrCouponInvalidState, state: models.CouponRevoked, pool: 1, revoked: true},
+	}
+
+	for _, step := range steps {
+		if err := step.do(); !errors.Is(err, step.err) {
+			t.Fatalf("%s: err = %v, want %v", step.name, err, step.err)
+		}
+
+		got := campaign.Coupons[code]
+		inPool := slices.Contains(campaign.UnPublishedCouponIds, code)
+		if got.State != step.state || got.UserId != step.userId || len(campaign.UnPublishedCouponIds) != step.pool || inPool != (step.state == models.CouponAvailable) {
+			t.Fatalf("%s: state %s, user %q, pool %d (contains %v), want %s, %q, %d",
+				step.name, got.State, got.UserId, len(campaign.UnPublishedCouponIds), inPool, step.state, step.userId, step.pool)
+		}
+
+		wantRedeemed := int64(0)
+		if step.state == models.CouponRedeemed {
+			wantRedeemed = 1
+		}
+		if campaign.redeemed != wantRedeemed {
+			t.Fatalf("%s: redeemed count %d, want %d", step.name, campaign.redeemed, wantRedeemed)
+		}
+
+		// 사용을 되돌려도 처음 발급된 시각은 유지하고, 발급 대기 목록으로 돌아가면 지움
+		if step.state == models.CouponIssued && !got.IssuedAt.Equal(issuedAt) {
+			t.Fatalf("%s: IssuedAt changed", step.name)
+		}
+		if step.state == models.CouponAvailable && (!got.IssuedAt.IsZero() || !got.RedeemedAt.IsZero()) {
+			t.Fatalf("%s: timestamps not cleared: %+v", step.name, got)
+		}
+	}
+}
+
+func TestExpireCoupons(t *testing.T) {
+	ctx := context.Background()
+	m := NewCampaignManager()
+	campaign := newTestCampaign(t, m, "brand", "spring", 3, CampaignOptions{})
+
+	issued, _ := m.PublishCoupon(ctx, "brand", "spring", "u1", IssueOptions{})
+	redeemed, _ := m.PublishCoupon(ctx, "brand", "spring", "u2", IssueOptions{})
+	if _, err := m.UseCoupon(ctx, "brand", "spring", redeemed.CouponId, "u2", "", nil); err != nil {
+		t.Fatalf("UseCoupon: %v", err)
+	}
+
+	if n := m.ExpireCoupons(time.Now()); n != 0 {
+		t.Fatalf("ExpireCoupons before end = %d, want 0", n)
+	}
+
+	after := campaign.ExpiredDate.Add(time.Minute)
+	// 발급된 쿠폰 1개, 발급되지 않은 쿠폰 1개, 사용된 쿠폰은 그대로
+	if n := m.ExpireCoupons(after); n != 2 {
+		t.Fatalf("ExpireCoupons = %d, want 2", n)
+	}
+	if n := m.ExpireCoupons(after); n != 0 {
+		t.Errorf("ExpireCoupons again = %d, want 0", n)
+	}
+
+	for code, coupon := range campaign.Coupons {
+		want := models.CouponExpired
+		if code == redeemed.CouponId {
+			want = models.CouponRedeemed
+		}
+		if coupon.State != want {
+			t.Errorf("coupon %s state %s, want %s", code, coupon.State, want)
+		}
+	}
+	if len(campaign.UnPublishedCouponIds) != 0 {
+		t.Errorf("%d coupons left to issue after expiry", len(campaign.UnPublishedCouponIds))
+	}
+	if _, err := m.UseCoupon(ctx, "brand", "spring", issued.CouponId, "u1", "", nil); !errors.Is(err, ErrCouponNotValidTime) {
+		t.Errorf("UseCoupon expired: err = %v, want %v", err, ErrCouponNotValidTime)
+	}
+}
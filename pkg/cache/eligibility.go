@@ -0,0 +1,224 @@
+package cache
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// 발급 조건 연산자
+const (
+	RuleEq         = "eq"          // 값이 values[0] 와 같음
+	RuleNeq        = "neq"         // 값이 없거나 values[0] 와 다름
+	RuleIn         = "in"          // 값이 values 중 하나
+	RuleNotIn      = "not_in"      // 값이 없거나 values 에 없음
+	RuleExists     = "exists"      // 값이 있음
+	RuleNotExists  = "not_exists"  // 값이 없음
+	RuleGt         = "gt"          // 숫자 비교
+	RuleGte        = "gte"         // 숫자 비교
+	RuleLt         = "lt"          // 숫자 비교
+	RuleLte        = "lte"         // 숫자 비교
+	RuleWithinDays = "within_days" // 날짜 값이 지금부터 values[0] 일 이내 (가입일 등)
+)
+
+// AttributeUserId : 발급 요청의 userId 는 항상 이 이름의 attribute 로 같이 평가됨
+const AttributeUserId = "userId"
+
+// Rule : 발급 조건 한개, 캠페인의 조건은 모두 만족해야 발급됨
+// attribute 는 IssueCouponReq.attributes 의 key
+type Rule struct {
+	Name      string   `json:"name"`
+	Attribute string   `json:"attribute"`
+	Op        string   `json:"op"`
+	Values    []string `json:"values,omitempty"`
+}
+
+// RuleResult : 조건 한개의 평가 결과
+type RuleResult struct {
+	Rule   Rule
+	Passed bool
+	Reason string // 실패한 경우 이유
+}
+
+// EligibilityError : 발급 조건을 만족하지 못한 경우, errors.Is(err, ErrNotEligible) 로 확인
+type EligibilityError struct {
+	Rule   Rule
+	Reason string
+}
+
+func (e *EligibilityError) Error() string {
+	return fmt.Sprintf("%s: rule %q failed (%s)", ErrNotEligible, e.Rule.Name, e.Reason)
+}
+
+func (e *EligibilityError) Unwrap() error {
+	return ErrNotEligible
+}
+
+// ValidateRules : 캠페인 생성 시점에 확인, 이름이 없는 조건은 attribute_op 로 채움
+func ValidateRules(rules []Rule) error {
+	names := make(map[string]struct{}, len(rules))
+
+	for i := range rules {
+		rule := &rules[i]
+		invalid := func(format string, args ...any) error {
+			return fmt.Errorf("%w: rules[%d]: %s", ErrInvalidRule, i, fmt.Sprintf(format, args...))
+		}
+
+		if rule.Attribute == "" {
+			return invalid("attribute is required")
+		}
+		if rule.Name == "" {
+			rule.Name = rule.Attribute + "_" + rule.Op
+		}
+		if _, exists := names[rule.Name]; exists {
+			return invalid("duplicate rule name %q", rule.Name)
+		}
+		names[rule.Name] = struct{}{}
+
+		switch rule.Op {
+		case RuleExists, RuleNotExists:
+			if len(rule.Values) != 0 {
+				return invalid("%s takes no values", rule.Op)
+			}
+		case RuleEq, RuleNeq:
+			if len(rule.Values) != 1 {
+				return invalid("%s takes exactly one value", rule.Op)
+			}
+		case RuleIn, RuleNotIn:
+			if len(rule.Values) == 0 {
+				return invalid("%s needs at least one value", rule.Op)
+			}
+		case RuleGt, RuleGte, RuleLt, RuleLte:
+			if len(rule.Values) != 1 {
+				return invalid("%s takes exactly one value", rule.Op)
+			}
+			if _, err := strconv.ParseFloat(rule.Values[0], 64); err != nil {
+				return invalid("%s value must be a number", rule.Op)
+			}
+		case RuleWithinDays:
+			if len(rule.Values) != 1 {
+				return invalid("%s takes exactly one value", rule.Op)
+			}
+			if days, err := strconv.Atoi(rule.Values[0]); err != nil || days <= 0 {
+				return invalid("%s value must be a positive number of days", rule.Op)
+			}
+		default:
+			return invalid("unknown op %q", rule.Op)
+		}
+	}
+
+	return nil
+}
+
+// evaluate : 통과하면 빈 문자열, 실패하면 이유
+func (r Rule) evaluate(attributes map[string]string, now time.Time) string {
+	value, exists := attributes[r.Attribute]
+
+	switch r.Op {
+	case RuleExists:
+		if !exists {
+			return "attribute is missing"
+		}
+		return ""
+	case RuleNotExists:
+		if exists {
+			return "attribute is present"
+		}
+		return ""
+	case RuleNeq:
+		if exists && value == r.Values[0] {
+			return fmt.Sprintf("%s is %q", r.Attribute, value)
+		}
+		return ""
+	case RuleNotIn:
+		if exists && slices.Contains(r.Values, value) {
+			return fmt.Sprintf("%s is %q", r.Attribute, value)
+		}
+		return ""
+	}
+
+	// 나머지는 값이 있어야 비교할 수 있음
+	if !exists {
+		return "attribute is missing"
+	}
+
+	switch r.Op {
+	case RuleEq:
+		if value != r.Values[0] {
+			return fmt.Sprintf("%s is %q, want %q", r.Attribute, value, r.Values[0])
+		}
+	case RuleIn:
+		if !slices.Contains(r.Values, value) {
+			return fmt.Sprintf("%s is %q, want one of %v", r.Attribute, value, r.Values)
+		}
+	case RuleGt, RuleGte, RuleLt, RuleLte:
+		got, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Sprintf("%s is not a number", r.Attribute)
+		}
+		want, _ := strconv.ParseFloat(r.Values[0], 64)
+		passed := map[string]bool{RuleGt: got > want, RuleGte: got >= want, RuleLt: got < want, RuleLte: got <= want}[r.Op]
+		if !passed {
+			return fmt.Sprintf("%s is %s, want %s %s", r.Attribute, value, r.Op, r.Values[0])
+		}
+	case RuleWithinDays:
+		at, err := parseAttributeTime(value)
+		if err != nil {
+			return fmt.Sprintf("%s is not a date (yyyy-mm-dd or RFC3339)", r.Attribute)
+		}
+		days, _ := strconv.Atoi(r.Values[0])
+		if at.Before(now.AddDate(0, 0, -days)) || at.After(now) {
+			return fmt.Sprintf("%s is %s, want within %d days", r.Attribute, value, days)
+		}
+	}
+
+	return ""
+}
+
+func parseAttributeTime(value string) (time.Time, error) {
+	if at, err := time.Parse(time.RFC3339, value); err == nil {
+		return at, nil
+	}
+	return time.ParseInLocation("2006-01-02", value, time.Local)
+}
+
+// checkEligibility : 처음 실패한 조건을 EligibilityError 로 반환
+func checkEligibility(rules []Rule, attributes map[string]string, now time.Time) error {
+	for _, rule := range rules {
+		if reason := rule.evaluate(attributes, now); reason != "" {
+			return &EligibilityError{Rule: rule, Reason: reason}
+		}
+	}
+	return nil
+}
+
+// EvaluateEligibility : 발급하지 않고 조건만 평가 (dry-run), 실패한 조건이 있어도 모든 조건의 결과를 반환함
+func (v *CampaignManager) EvaluateEligibility(tenantId, campaignId, userId string, attributes map[string]string) ([]RuleResult, error) {
+	_, campaign, exists := v.getCampaign(tenantId, campaignId)
+	if !exists {
+		return nil, ErrCampaignNotExists
+	}
+
+	attributes = withUserId(attributes, userId)
+	now := time.Now()
+
+	results := make([]RuleResult, 0, len(campaign.Rules))
+	for _, rule := range campaign.Rules {
+		reason := rule.evaluate(attributes, now)
+		results = append(results, RuleResult{Rule: rule, Passed: reason == "", Reason: reason})
+	}
+	return results, nil
+}
+
+// withUserId : 요청 attribute 를 바꾸지 않도록 복사해서 userId 를 채움
+func withUserId(attributes map[string]string, userId string) map[string]string {
+	merged := make(map[string]string, len(attributes)+1)
+	for k, val := range attributes {
+		merged[k] = val
+	}
+	if userId != "" {
+		merged[AttributeUserId] = userId
+	}
+	return merged
+}
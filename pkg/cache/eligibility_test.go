@@ -0,0 +1,148 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestValidateRules(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules []Rule
+		ok    bool
+	}{
+		{name: "no rules", ok: true},
+		{name: "all ops", ok: true, rules: []Rule{
+			{Attribute: "country", Op: RuleEq, Values: []string{"KR"}},
+			{Attribute: "grade", Op: RuleIn, Values: []string{"gold", "vip"}},
+			{Attribute: "age", Op: RuleGte, Values: []string{"19"}},
+			{Attribute: "joinedAt", Op: RuleWithinDays, Values: []string{"30"}},
+			{Attribute: "banned", Op: RuleNotExists},
+		}},
+		{name: "missing attribute", rules: []Rule{{Op: RuleExists}}},
+		{name: "unknown op", rules: []Rule{{Attribute: "age", Op: "between"}}},
+		{name: "exists with values", rules: []Rule{{Attribute: "age", Op: RuleExists, Values: []string{"1"}}}},
+		{name: "eq with two values", rules: []Rule{{Attribute: "country", Op: RuleEq, Values: []string{"KR", "JP"}}}},
+		{name: "in without values", rules: []Rule{{Attribute: "grade", Op: RuleIn}}},
+		{name: "gt not a number", rules: []Rule{{Attribute: "age", Op: RuleGt, Values: []string{"adult"}}}},
+		{name: "within zero days", rules: []Rule{{Attribute: "joinedAt", Op: RuleWithinDays, Values: []string{"0"}}}},
+		{name: "duplicate default name", rules: []Rule{{Attribute: "age", Op: RuleGt, Values: []string{"1"}}, {Attribute: "age", Op: RuleGt, Values: []string{"2"}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRules(tt.rules)
+			if tt.ok && err != nil {
+				t.Fatalf("ValidateRules: %v", err)
+			}
+			if !tt.ok && !errors.Is(err, ErrInvalidRule) {
+				t.Fatalf("err = %v, want %v", err, ErrInvalidRule)
+			}
+		})
+	}
+
+	rules := []Rule{{Attribute: "age", Op: RuleGt, Values: []string{"1"}}}
+	ValidateRules(rules)
+	if rules[0].Name != "age_gt" {
+		t.Errorf("default name = %q, want age_gt", rules[0].Name)
+	}
+}
+
+func TestRuleEvaluate(t *testing.T) {
+	now := time.Date(2025, 5, 12, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		rule  Rule
+		value string // "-" 면 attribute 없음
+		pass  bool
+	}{
+		{name: "eq", rule: Rule{Op: RuleEq, Values: []string{"KR"}}, value: "KR", pass: true},
+		{name: "eq other", rule: Rule{Op: RuleEq, Values: []string{"KR"}}, value: "JP"},
+		{name: "eq missing", rule: Rule{Op: RuleEq, Values: []string{"KR"}}, value: "-"},
+		{name: "neq missing", rule: Rule{Op: RuleNeq, Values: []string{"KR"}}, value: "-", pass: true},
+		{name: "neq same", rule: Rule{Op: RuleNeq, Values: []string{"KR"}}, value: "KR"},
+		{name: "in", rule: Rule{Op: RuleIn, Values: []string{"gold", "vip"}}, value: "vip", pass: true},
+		{name: "in other", rule: Rule{Op: RuleIn, Values: []string{"gold", "vip"}}, value: "silver"},
+		{name: "not in missing", rule: Rule{Op: RuleNotIn, Values: []string{"banned"}}, value: "-", pass: true},
+		{name: "not in listed", rule: Rule{Op: RuleNotIn, Values: []string{"banned"}}, value: "banned"},
+		{name: "exists", rule: Rule{Op: RuleExists}, value: "", pass: true},
+		{name: "exists missing", rule: Rule{Op: RuleExists}, value: "-"},
+		{name: "not exists", rule: Rule{Op: RuleNotExists}, value: "-", pass: true},
+		{name: "gt", rule: Rule{Op: RuleGt, Values: []string{"19"}}, value: "20", pass: true},
+		{name: "gt equal", rule: Rule{Op: RuleGt, Values: []string{"19"}}, value: "19"},
+		{name: "gte equal", rule: Rule{Op: RuleGte, Values: []string{"19"}}, value: "19", pass: true},
+		{name: "lt decimal", rule: Rule{Op: RuleLt, Values: []string{"1.5"}}, value: "1.25", pass: true},
+		{name: "lte not a number", rule: Rule{Op: RuleLte, Values: []string{"10"}}, value: "ten"},
+		{name: "within days date", rule: Rule{Op: RuleWithinDays, Values: []string{"30"}}, value: "2025-05-01", pass: true},
+		{name: "within days rfc3339", rule: Rule{Op: RuleWithinDays, Values: []string{"30"}}, value: "2025-04-12T10:00:00Z", pass: true},
+		{name: "within days too old", rule: Rule{Op: RuleWithinDays, Values: []string{"30"}}, value: "2025-04-12T09:59:59Z"},
+		{name: "within days future", rule: Rule{Op: RuleWithinDays, Values: []string{"30"}}, value: "2025-05-12T10:00:01Z"},
+		{name: "within days not a date", rule: Rule{Op: RuleWithinDays, Values: []string{"30"}}, value: "yesterday"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.rule.Attribute = "attr"
+			attributes := map[string]string{}
+			if tt.value != "-" {
+				attributes["attr"] = tt.value
+			}
+
+			reason := tt.rule.evaluate(attributes, now)
+			if (reason == "") != tt.pass {
+				t.Errorf("evaluate = %q, want pass %v", reason, tt.pass)
+			}
+		})
+	}
+}
+
+func TestEligibility(t *testing.T) {
+	ctx := context.Background()
+	m := NewCampaignManager()
+	rules := []Rule{
+		{Name: "korea", Attribute: "country", Op: RuleEq, Values: []string{"KR"}},
+		{Name: "adult", Attribute: "age", Op: RuleGte, Values: []string{"19"}},
+		{Name: "not blocked", Attribute: AttributeUserId, Op: RuleNotIn, Values: []string{"u9"}},
+	}
+	newTestCampaign(t, m, "brand", "spring", 10, CampaignOptions{Rules: rules})
+
+	tests := []struct {
+		name       string
+		userId     string
+		attributes map[string]string
+		failed     string // 처음 실패한 조건, 비어있으면 발급됨
+		results    []bool
+	}{
+		{name: "eligible", userId: "u1", attributes: map[string]string{"country": "KR", "age": "20"}, results: []bool{true, true, true}},
+		{name: "first failure", userId: "u1", attributes: map[string]string{"country": "JP"}, failed: "korea", results: []bool{false, false, true}},
+		{name: "userId attribute", userId: "u9", attributes: map[string]string{"country": "KR", "age": "20"}, failed: "not blocked", results: []bool{true, true, false}},
+		// body 의 userId attribute 로 요청한 userId 를 덮어쓸 수 없음
+		{name: "userId cannot be spoofed", userId: "u9", attributes: map[string]string{"country": "KR", "age": "20", AttributeUserId: "u1"}, failed: "not blocked", results: []bool{true, true, false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := m.EvaluateEligibility("brand", "spring", tt.userId, tt.attributes)
+			if err != nil {
+				t.Fatalf("EvaluateEligibility: %v", err)
+			}
+			for i, r := range results {
+				if r.Passed != tt.results[i] || r.Passed != (r.Reason == "") {
+					t.Errorf("rule %s passed %v (%q), want %v", r.Rule.Name, r.Passed, r.Reason, tt.results[i])
+				}
+			}
+
+			_, err = m.PublishCoupon(ctx, "brand", "spring", tt.userId, IssueOptions{Attributes: tt.attributes})
+			var eligibility *EligibilityError
+			switch {
+			case tt.failed == "" && err != nil:
+				t.Errorf("PublishCoupon: %v", err)
+			case tt.failed != "" && (!errors.As(err, &eligibility) || !errors.Is(err, ErrNotEligible) || eligibility.Rule.Name != tt.failed):
+				t.Errorf("PublishCoupon: err = %v, want rule %q", err, tt.failed)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package cache
+
+import (
+	"errors"
+)
+
+// 에러 메시지는 그대로 응답 Message 로 내려가고, load tester 등 client 가 문자열로 비교하기도 해서 바꾸지 않음
+var (
+	ErrCampaignExists        = errors.New("campaign already exists")
+	ErrCampaignNotExists     = errors.New("campaign is not exists")
+	ErrCampaignNotValidTime  = errors.New("campaign not valid at this time")
+	ErrCampaignInUse         = errors.New("campaign has coupons issued to users, use force to delete")
+	ErrCampaignPaused        = errors.New("campaign issuance is paused")
+	ErrNoMoreCoupon          = errors.New("no more available coupon")
+	ErrTenantRateLimited     = errors.New("tenant rate limit exceeded")
+	ErrTenantCampaignQuota   = errors.New("tenant active campaign quota exceeded")
+	ErrTenantCouponQuota     = errors.New("tenant total coupon quota exceeded")
+	ErrCouponNotExists       = errors.New("coupon is not exists")
+	ErrCouponNotPublished    = errors.New("coupon is not published")
+	ErrCouponNotIssuedToUser = errors.New("coupon is not issued to this user")
+	ErrCouponAlreadyUsed     = errors.New("coupon is already used")
+	ErrCouponNotValidTime    = errors.New("coupon not valid at this time")
+	ErrCouponRevoked         = errors.New("coupon is revoked")
+	ErrCouponInvalidState    = errors.New("coupon state cannot be changed")
+	ErrCouponHeld            = errors.New("coupon is held by another reservation")
+	ErrReservationNotExists  = errors.New("reservation is not exists")
+	ErrReservationExpired    = errors.New("reservation is expired")
+	ErrInvalidBenefit        = errors.New("invalid benefit")
+	ErrInvalidCart           = errors.New("invalid cart")
+	ErrCurrencyMismatch      = errors.New("cart currency does not match coupon currency")
+	ErrOrderBelowMinimum     = errors.New("order amount is below the coupon minimum")
+	ErrInvalidRule           = errors.New("invalid eligibility rule")
+	ErrNotEligible           = errors.New("user is not eligible for this campaign")
+	ErrInvalidSchedule       = errors.New("invalid issuance schedule")
+	ErrIssueRateLimited      = errors.New("campaign issuance rate limit exceeded")
+	ErrOutsideIssueWindow    = errors.New("campaign is outside issuance window")
+	ErrInvalidRaffle         = errors.New("invalid raffle")
+	ErrNotRaffle             = errors.New("campaign is not a raffle")
+	ErrRaffleCampaign        = errors.New("campaign issues coupons by draw, use EnterDraw")
+	ErrDrawNotOpen           = errors.New("draw registration is not open")
+	ErrDrawNotDrawn          = errors.New("draw has not been run yet")
+	ErrAlreadyEntered        = errors.New("user already entered the draw")
+	ErrNotWaitlisted         = errors.New("user is not on the waitlist")
+	ErrInvalidMaxCoupon      = errors.New("invalid maxCoupon")
+	ErrInvalidTier           = errors.New("invalid tier")
+	ErrInvalidExportFilter   = errors.New("invalid export filter")
+	ErrWebhookNotExists      = errors.New("webhook endpoint is not exists")
+	ErrInvalidWebhookURL     = errors.New("webhook url must be an absolute http or https url")
+	ErrUnknownEventType      = errors.New("unknown event type")
+	ErrDeliveryNotExists     = errors.New("delivery is not exists")
+)
+
+// ErrorReason : metric label 등에 쓰는 짧은 이름, 모르는 에러는 "internal"
+func ErrorReason(err error) string {
+	switch {
+	case err == nil:
+		return "ok"
+	case errors.Is(err, ErrCampaignExists):
+		return "campaign_exists"
+	case errors.Is(err, ErrCampaignNotExists):
+		return "campaign_not_exists"
+	case errors.Is(err, ErrCampaignNotValidTime):
+		return "campaign_not_valid_time"
+	case errors.Is(err, ErrCampaignInUse):
+		return "campaign_in_use"
+	case errors.Is(err, ErrCampaignPaused):
+		return "campaign_paused"
+	case errors.Is(err, ErrNoMoreCoupon):
+		return "no_more_coupon"
+	case errors.Is(err, ErrTenantRateLimited):
+		return "tenant_rate_limited"
+	case errors.Is(err, ErrTenantCampaignQuota):
+		return "tenant_campaign_quota"
+	case errors.Is(err, ErrTenantCouponQuota):
+		return "tenant_coupon_quota"
+	case errors.Is(err, ErrCouponNotExists):
+		return "coupon_not_exists"
+	case errors.Is(err, ErrCouponNotPublished):
+		return "coupon_not_published"
+	case errors.Is(err, ErrCouponNotIssuedToUser):
+		return "coupon_not_issued_to_user"
+	case errors.Is(err, ErrCouponAlreadyUsed):
+		return "coupon_already_used"
+	case errors.Is(err, ErrCouponNotValidTime):
+		return "coupon_not_valid_time"
+	case errors.Is(err, ErrCouponRevoked):
+		return "coupon_revoked"
+	case errors.Is(err, ErrCouponInvalidState):
+		return "coupon_invalid_state"
+	case errors.Is(err, ErrCouponHeld):
+		return "coupon_held"
+	case errors.Is(err, ErrReservationNotExists):
+		return "reservation_not_exists"
+	case errors.Is(err, ErrReservationExpired):
+		return "reservation_expired"
+	case errors.Is(err, ErrInvalidBenefit):
+		return "invalid_benefit"
+	case errors.Is(err, ErrInvalidCart):
+		return "invalid_cart"
+	case errors.Is(err, ErrCurrencyMismatch):
+		return "currency_mismatch"
+	case errors.Is(err, ErrOrderBelowMinimum):
+		return "order_below_minimum"
+	case errors.Is(err, ErrInvalidRule):
+		return "invalid_rule"
+	case errors.Is(err, ErrNotEligible):
+		return "not_eligible"
+	case errors.Is(err, ErrInvalidSchedule):
+		return "invalid_schedule"
+	case errors.Is(err, ErrIssueRateLimited):
+		return "issue_rate_limited"
+	case errors.Is(err, ErrOutsideIssueWindow):
+		return "outside_issue_window"
+	case errors.Is(err, ErrInvalidRaffle):
+		return "invalid_raffle"
+	case errors.Is(err, ErrNotRaffle):
+		return "not_raffle"
+	case errors.Is(err, ErrRaffleCampaign):
+		return "raffle_campaign"
+	case errors.Is(err, ErrDrawNotOpen):
+		return "draw_not_open"
+	case errors.Is(err, ErrDrawNotDrawn):
+		return "draw_not_drawn"
+	case errors.Is(err, ErrAlreadyEntered):
+		return "already_entered"
+	case errors.Is(err, ErrNotWaitlisted):
+		return "not_waitlisted"
+	case errors.Is(err, ErrInvalidMaxCoupon):
+		return "invalid_max_coupon"
+	case errors.Is(err, ErrInvalidTier):
+		return "invalid_tier"
+	case errors.Is(err, ErrInvalidExportFilter):
+		return "invalid_export_filter"
+	case errors.Is(err, ErrWebhookNotExists):
+		return "webhook_not_exists"
+	case errors.Is(err, ErrInvalidWebhookURL):
+		return "invalid_webhook_url"
+	case errors.Is(err, ErrUnknownEventType):
+		return "unknown_event_type"
+	case errors.Is(err, ErrDeliveryNotExists):
+		return "delivery_not_exists"
+	}
+	return "internal"
+}
@@ -0,0 +1,106 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/models"
+)
+
+// exportChunk : 캠페인 lock 을 한번 잡고 복사하는 쿠폰 수, 내보내는 동안 발급/사용이 오래 막히지 않게 나눠서 복사함
+const exportChunk = 1000
+
+// 시간 조건을 적용할 시각
+const (
+	ExportByIssued   = "issued"
+	ExportByRedeemed = "redeemed"
+)
+
+// ExportFilter : 비어있는 조건은 적용하지 않음
+type ExportFilter struct {
+	States    []models.CouponState
+	TimeField string    // ExportByIssued(기본값), ExportByRedeemed
+	From      time.Time // 포함
+	To        time.Time // 포함하지 않음
+}
+
+// Validate : 알 수 없는 상태나 시간 조건이면 ErrInvalidExportFilter
+func (f *ExportFilter) Validate() error {
+	for _, state := range f.States {
+		if !slices.Contains(models.CouponStates, state) {
+			return fmt.Errorf("%w: unknown state %q", ErrInvalidExportFilter, state)
+		}
+	}
+	switch f.TimeField {
+	case "":
+		f.TimeField = ExportByIssued
+	case ExportByIssued, ExportByRedeemed:
+	default:
+		return fmt.Errorf("%w: timeField must be %s or %s", ErrInvalidExportFilter, ExportByIssued, ExportByRedeemed)
+	}
+	if !f.From.IsZero() && !f.To.IsZero() && !f.From.Before(f.To) {
+		return fmt.Errorf("%w: from must be before to", ErrInvalidExportFilter)
+	}
+	return nil
+}
+
+func (f *ExportFilter) match(coupon *models.Coupon) bool {
+	if len(f.States) > 0 && !slices.Contains(f.States, coupon.State) {
+		return false
+	}
+	if f.From.IsZero() && f.To.IsZero() {
+		return true
+	}
+
+	at := coupon.IssuedAt
+	if f.TimeField == ExportByRedeemed {
+		at = coupon.RedeemedAt
+	}
+	if at.IsZero() {
+		return false
+	}
+	return (f.From.IsZero() || !at.Before(f.From)) && (f.To.IsZero() || at.Before(f.To))
+}
+
+// ExportCoupons : 캠페인의 쿠폰을 코드 순서로 fn 에 넘김, fn 이 에러를 반환하면 멈춤
+// 쿠폰 목록은 처음에 한번 정하고 exportChunk 개씩 lock 을 잡고 복사해서, 내보내는 동안 상태가 바뀐 쿠폰은 복사한 시점의 상태로 나감
+func (v *CampaignManager) ExportCoupons(ctx context.Context, tenantId, campaignId string, filter ExportFilter, fn func(models.Coupon) error) error {
+	_, campaign, exists := v.getCampaign(tenantId, campaignId)
+	if !exists {
+		return ErrCampaignNotExists
+	}
+
+	campaign.mutex.RLock()
+	couponIds := make([]string, 0, len(campaign.Coupons))
+	for couponId := range campaign.Coupons {
+		couponIds = append(couponIds, couponId)
+	}
+	campaign.mutex.RUnlock()
+	sort.Strings(couponIds)
+
+	chunk := make([]models.Coupon, 0, exportChunk)
+	for start := 0; start < len(couponIds); start += exportChunk {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		chunk = chunk[:0]
+		campaign.mutex.RLock()
+		for _, couponId := range couponIds[start:min(start+exportChunk, len(couponIds))] {
+			if coupon, exists := campaign.Coupons[couponId]; exists && filter.match(coupon) {
+				chunk = append(chunk, *coupon)
+			}
+		}
+		campaign.mutex.RUnlock()
+
+		for _, coupon := range chunk {
+			if err := fn(coupon); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/models"
+)
+
+func TestExportFilterValidate(t *testing.T) {
+	at := time.Date(2025, 5, 12, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		filter ExportFilter
+		ok     bool
+	}{
+		{name: "empty", ok: true},
+		{name: "states", filter: ExportFilter{States: []models.CouponState{models.CouponIssued, models.CouponRedeemed}}, ok: true},
+		{name: "unknown state", filter: ExportFilter{States: []models.CouponState{"used"}}},
+		{name: "redeemed range", filter: ExportFilter{TimeField: ExportByRedeemed, From: at, To: at.Add(time.Hour)}, ok: true},
+		{name: "unknown time field", filter: ExportFilter{TimeField: "created"}},
+		{name: "empty range", filter: ExportFilter{From: at, To: at}},
+		{name: "open range", filter: ExportFilter{From: at}, ok: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if tt.ok && err != nil {
+				t.Fatalf("Validate: %v", err)
+			}
+			if !tt.ok && !errors.Is(err, ErrInvalidExportFilter) {
+				t.Fatalf("err = %v, want %v", err, ErrInvalidExportFilter)
+			}
+			if tt.ok && tt.filter.TimeField == "" {
+				t.Errorf("TimeField was not defaulted")
+			}
+		})
+	}
+}
+
+func TestExportFilterMatch(t *testing.T) {
+	at := time.Date(2025, 5, 12, 10, 0, 0, 0, time.UTC)
+	issued := &models.Coupon{State: models.CouponIssued, IssuedAt: at}
+	redeemed := &models.Coupon{State: models.CouponRedeemed, IssuedAt: at, RedeemedAt: at.Add(time.Hour)}
+	available := &models.Coupon{State: models.CouponAvailable}
+
+	tests := []struct {
+		name   string
+		filter ExportFilter
+		coupon *models.Coupon
+		match  bool
+	}{
+		{name: "no filter", coupon: available, match: true},
+		{name: "state", filter: ExportFilter{States: []models.CouponState{models.CouponIssued}}, coupon: issued, match: true},
+		{name: "other state", filter: ExportFilter{States: []models.CouponState{models.CouponIssued}}, coupon: redeemed},
+		{name: "from is inclusive", filter: ExportFilter{TimeField: ExportByIssued, From: at}, coupon: issued, match: true},
+		{name: "to is exclusive", filter: ExportFilter{TimeField: ExportByIssued, To: at}, coupon: issued},
+		{name: "not issued has no time", filter: ExportFilter{TimeField: ExportByIssued, From: at.Add(-time.Hour)}, coupon: available},
+		{name: "by redeemed", filter: ExportFilter{TimeField: ExportByRedeemed, From: at.Add(30 * time.Minute)}, coupon: redeemed, match: true},
+		{name: "by redeemed not redeemed", filter: ExportFilter{TimeField: ExportByRedeemed, From: at.Add(-time.Hour)}, coupon: issued},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.match(tt.coupon); got != tt.match {
+				t.Errorf("match = %v, want %v", got, tt.match)
+			}
+		})
+	}
+}
+
+func TestExportCoupons(t *testing.T) {
+	ctx := context.Background()
+	m := NewCampaignManager()
+	// 여러 chunk 로 나눠서 복사되는 수량
+	const maxCoupon = exportChunk + 500
+	newTestCampaign(t, m, "brand", "spring", maxCoupon, CampaignOptions{})
+
+	for _, userId := range []string{"u1", "u2", "u3"} {
+		if _, err := m.PublishCoupon(ctx, "brand", "spring", userId, IssueOptions{}); err != nil {
+			t.Fatalf("PublishCoupon: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		filter ExportFilter
+		count  int
+	}{
+		{name: "all", count: maxCoupon},
+		{name: "issued", filter: ExportFilter{States: []models.CouponState{models.CouponIssued}}, count: 3},
+		{name: "issued since an hour ago", filter: ExportFilter{TimeField: ExportByIssued, From: time.Now().Add(-time.Hour)}, count: 3},
+		{name: "redeemed", filter: ExportFilter{States: []models.CouponState{models.CouponRedeemed}}, count: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var codes []string
+			err := m.ExportCoupons(ctx, "brand", "spring", tt.filter, func(c models.Coupon) error {
+				codes = append(codes, c.CouponId)
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("ExportCoupons: %v", err)
+			}
+			if len(codes) != tt.count || !sort.StringsAreSorted(codes) {
+				t.Errorf("exported %d coupons (sorted %v), want %d", len(codes), sort.StringsAreSorted(codes), tt.count)
+			}
+		})
+	}
+
+	stop := errors.New("stop")
+	calls := 0
+	err := m.ExportCoupons(ctx, "brand", "spring", ExportFilter{}, func(models.Coupon) error {
+		calls++
+		return stop
+	})
+	if !errors.Is(err, stop) || calls != 1 {
+		t.Errorf("ExportCoupons with failing fn: err = %v after %d calls", err, calls)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := m.ExportCoupons(canceled, "brand", "spring", ExportFilter{}, func(models.Coupon) error { return nil }); !errors.Is(err, context.Canceled) {
+		t.Errorf("ExportCoupons canceled: err = %v, want %v", err, context.Canceled)
+	}
+	if err := m.ExportCoupons(ctx, "other", "spring", ExportFilter{}, func(models.Coupon) error { return nil }); !errors.Is(err, ErrCampaignNotExists) {
+		t.Errorf("ExportCoupons other tenant: err = %v, want %v", err, ErrCampaignNotExists)
+	}
+}
@@ -0,0 +1,28 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// newTestCampaign : 지금부터 하루 동안 진행되는 캠페인을 만듦
+func newTestCampaign(t *testing.T, m *CampaignManager, tenantId, campaignId string, maxCoupon int64, opts CampaignOptions) *Campaign {
+	t.Helper()
+
+	now := time.Now()
+	if err := m.CreateCampaign(context.Background(), tenantId, campaignId, now.Add(-time.Minute), now.Add(24*time.Hour), maxCoupon, opts); err != nil {
+		t.Fatalf("CreateCampaign(%s/%s): %v", tenantId, campaignId, err)
+	}
+
+	_, campaign, _ := m.getCampaign(tenantId, campaignId)
+	return campaign
+}
+
+func importRows(codes ...string) []ImportRow {
+	rows := make([]ImportRow, len(codes))
+	for i, code := range codes {
+		rows[i] = ImportRow{Line: int64(i + 1), Code: code}
+	}
+	return rows
+}
@@ -0,0 +1,145 @@
+package cache
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/tracing"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/utils"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// 가져오지 않은 행의 사유
+const (
+	ImportInvalidFormat   = "invalid_format"    // 코드 형식이 맞지 않음
+	ImportDuplicateInFile = "duplicate_in_file" // 같은 요청 안에서 앞의 행과 중복
+	ImportAlreadyExists   = "already_exists"    // 같은 tenant 에 이미 있는 코드
+)
+
+// ImportRow : 외부에서 받은 쿠폰 코드 한줄, Line 은 거절 사유를 알려줄때 쓰는 원본 파일의 행 번호
+type ImportRow struct {
+	Line int64
+	Code string
+}
+
+// ImportRejection : 가져오지 않은 행
+type ImportRejection struct {
+	Line   int64
+	Code   string
+	Reason string
+	Detail string
+}
+
+// ImportResult : 가져온 쿠폰 수와 거절된 행
+type ImportResult struct {
+	Imported  int64
+	Rejected  []ImportRejection
+	MaxCoupon int64 // 가져온 뒤 캠페인 쿠폰 수
+}
+
+// ImportCoupons : 제휴사 상품권 코드 등 외부 코드로 쿠폰을 만들어서 발급 대기 목록에 넣음
+// 형식이 맞지 않거나 중복인 행만 거절하고 나머지는 가져옴, pattern 이 있으면 코드가 pattern 에 맞아야 함
+// 가져온 쿠폰은 캠페인 쿠폰 수(MaxCoupons) 에 더해지고, 일반 쿠폰과 같이 PublishCoupon 으로 발급됨 (대기자가 있으면 먼저 발급)
+// dryRun 이면 검증만 하고 Imported 에 가져올 수 있는 코드 수를 채움
+func (v *CampaignManager) ImportCoupons(ctx context.Context, tenantId, campaignId string, rows []ImportRow, pattern *regexp.Regexp, dryRun bool) (_ *ImportResult, err error) {
+	ctx, span := tracing.Start(ctx, "CampaignManager.ImportCoupons")
+	span.SetAttributes(attribute.String("tenant.id", tenantId), attribute.String("campaign.id", campaignId), attribute.Int("import.rows", len(rows)))
+	defer func() { tracing.End(span, err) }()
+
+	v.commitMutex.RLock()
+	defer v.commitMutex.RUnlock()
+
+	// 쿠폰 코드 중복 확인, tenant quota 계산 때문에 tenant 전체 lock 을 잡음
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	tenant := v.tenant(tenantId, false)
+	if tenant == nil {
+		return nil, ErrCampaignNotExists
+	}
+	campaign, exists := tenant.campaigns[campaignId]
+	if !exists {
+		return nil, ErrCampaignNotExists
+	}
+
+	v.lockCampaign(ctx, campaign, "import")
+	defer campaign.mutex.Unlock()
+
+	now := time.Now()
+	if now.After(campaign.ExpiredDate) {
+		return nil, ErrCampaignNotValidTime
+	}
+
+	result := &ImportResult{}
+	reject := func(row ImportRow, reason, detail string) {
+		result.Rejected = append(result.Rejected, ImportRejection{Line: row.Line, Code: row.Code, Reason: reason, Detail: detail})
+	}
+
+	accepted := make([]string, 0, len(rows))
+	seen := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		row.Code = strings.TrimSpace(row.Code)
+		if err := utils.ValidateCouponCode(row.Code); err != nil {
+			reject(row, ImportInvalidFormat, err.Error())
+			continue
+		}
+		if pattern != nil && !pattern.MatchString(row.Code) {
+			reject(row, ImportInvalidFormat, "code does not match pattern")
+			continue
+		}
+		if line, exists := seen[row.Code]; exists {
+			reject(row, ImportDuplicateInFile, "same code at line "+strconv.FormatInt(line, 10))
+			continue
+		}
+		seen[row.Code] = row.Line
+		if _, exists := tenant.couponCodes[row.Code]; exists {
+			reject(row, ImportAlreadyExists, "")
+			continue
+		}
+		accepted = append(accepted, row.Code)
+	}
+
+	added := int64(len(accepted))
+	result.MaxCoupon = campaign.MaxCoupons
+	if added == 0 {
+		return result, nil
+	}
+	if err := tenant.checkCouponQuota(added, now); err != nil {
+		return nil, err
+	}
+	if dryRun {
+		result.Imported = added
+		return result, nil
+	}
+
+	before := campaignAuditValues(campaign)
+	for _, code := range accepted {
+		addCoupon(tenant, campaign, code)
+	}
+	campaign.MaxCoupons += added
+	campaign.couponsExpired = false
+	result.Imported, result.MaxCoupon = added, campaign.MaxCoupons
+
+	after := campaignAuditValues(campaign)
+	after["imported"] = added
+	after["rejected"] = len(result.Rejected)
+	v.audit(ctx, AuditEvent{
+		Action:     AuditCouponsImported,
+		TenantId:   tenantId,
+		CampaignId: campaignId,
+		Before:     before,
+		After:      after,
+	})
+
+	// 쿠폰 코드는 하나씩 로그로 남기지 않음
+	slog.InfoContext(ctx, "imported coupon codes", "imported", added, "rejected", len(result.Rejected))
+	span.SetAttributes(attribute.Int64("import.imported", added), attribute.Int("import.rejected", len(result.Rejected)))
+
+	v.backfill(ctx, tenantId, campaign, now)
+	return result, nil
+}
@@ -0,0 +1,179 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"testing"
+)
+
+func TestImportCoupons(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing []string // 같은 tenant 의 다른 캠페인에 미리 가져온 코드
+		rows     []ImportRow
+		pattern  string
+		dryRun   bool
+		imported int64
+		rejected map[int64]string // line -> reason
+	}{
+		{
+			name:     "all valid",
+			rows:     importRows("GIFT-0001", "GIFT-0002"),
+			imported: 2,
+		},
+		{
+			name:     "duplicate in file keeps first",
+			rows:     importRows("GIFT-0001", "GIFT-0002", "GIFT-0001"),
+			imported: 2,
+			rejected: map[int64]string{3: ImportDuplicateInFile},
+		},
+		{
+			name:     "duplicate after trim",
+			rows:     importRows("GIFT-0001", " GIFT-0001 "),
+			imported: 1,
+			rejected: map[int64]string{2: ImportDuplicateInFile},
+		},
+		{
+			name:     "already exists in tenant",
+			existing: []string{"GIFT-0001"},
+			rows:     importRows("GIFT-0001", "GIFT-0002"),
+			imported: 1,
+			rejected: map[int64]string{1: ImportAlreadyExists},
+		},
+		{
+			name:     "invalid format",
+			rows:     importRows("abc", "GIFT 0002", "GIFT-0003"),
+			imported: 1,
+			rejected: map[int64]string{1: ImportInvalidFormat, 2: ImportInvalidFormat},
+		},
+		{
+			name:     "pattern mismatch",
+			rows:     importRows("GIFT-0001", "SHOP-0002"),
+			pattern:  `^GIFT-\d{4}$`,
+			imported: 1,
+			rejected: map[int64]string{2: ImportInvalidFormat},
+		},
+		{
+			name:     "dry run",
+			rows:     importRows("GIFT-0001", "GIFT-0001"),
+			dryRun:   true,
+			imported: 1,
+			rejected: map[int64]string{2: ImportDuplicateInFile},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			m := NewCampaignManager()
+			campaign := newTestCampaign(t, m, "brand", "giftcard", 0, CampaignOptions{})
+			if len(tt.existing) > 0 {
+				newTestCampaign(t, m, "brand", "other", 0, CampaignOptions{})
+				if _, err := m.ImportCoupons(ctx, "brand", "other", importRows(tt.existing...), nil, false); err != nil {
+					t.Fatalf("import existing: %v", err)
+				}
+			}
+
+			var pattern *regexp.Regexp
+			if tt.pattern != "" {
+				pattern = regexp.MustCompile(tt.pattern)
+			}
+
+			result, err := m.ImportCoupons(ctx, "brand", "giftcard", tt.rows, pattern, tt.dryRun)
+			if err != nil {
+				t.Fatalf("ImportCoupons: %v", err)
+			}
+			if result.Imported != tt.imported {
+				t.Errorf("Imported = %d, want %d", result.Imported, tt.imported)
+			}
+
+			rejected := make(map[int64]string, len(result.Rejected))
+			for _, r := range result.Rejected {
+				rejected[r.Line] = r.Reason
+			}
+			if fmt.Sprint(rejected) != fmt.Sprint(tt.rejected) {
+				t.Errorf("Rejected = %v, want %v", rejected, tt.rejected)
+			}
+
+			want := tt.imported
+			if tt.dryRun {
+				want = 0
+			}
+			if campaign.MaxCoupons != want || int64(len(campaign.Coupons)) != want {
+				t.Errorf("campaign has MaxCoupons %d, %d coupons, want %d", campaign.MaxCoupons, len(campaign.Coupons), want)
+			}
+		})
+	}
+}
+
+func TestImportCouponsTenantQuota(t *testing.T) {
+	m := NewCampaignManager()
+	m.SetTenantQuota("brand", TenantQuota{MaxTotalCoupons: 3})
+	newTestCampaign(t, m, "brand", "giftcard", 2, CampaignOptions{})
+
+	_, err := m.ImportCoupons(context.Background(), "brand", "giftcard", importRows("GIFT-0001", "GIFT-0002"), nil, false)
+	if !errors.Is(err, ErrTenantCouponQuota) {
+		t.Fatalf("ImportCoupons over quota: err = %v, want %v", err, ErrTenantCouponQuota)
+	}
+}
+
+// TestGetCampaignInfoConcurrentWrites : go test -race 로 실행해야 의미가 있음
+// 가져오기, 증액이 쿠폰 맵에 쓰는 동안 조회가 같은 맵을 순회함
+func TestGetCampaignInfoConcurrentWrites(t *testing.T) {
+	ctx := context.Background()
+	m := NewCampaignManager()
+	newTestCampaign(t, m, "brand", "giftcard", 10, CampaignOptions{})
+
+	const rounds = 50
+	var wg sync.WaitGroup
+	wg.Add(3)
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < rounds; i++ {
+			if _, err := m.ImportCoupons(ctx, "brand", "giftcard", importRows(fmt.Sprintf("GIFT-%04d", i)), nil, false); err != nil {
+				t.Errorf("ImportCoupons: %v", err)
+				return
+			}
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < rounds; i++ {
+			_, campaign, _ := m.getCampaign("brand", "giftcard")
+			campaign.mutex.RLock()
+			maxCoupon := campaign.MaxCoupons
+			campaign.mutex.RUnlock()
+			// 가져오기와 번갈아 실행되면 쿠폰 수가 이미 늘어나 있을 수 있음
+			if err := m.RaiseMaxCoupons(ctx, "brand", "giftcard", maxCoupon+1); err != nil && !errors.Is(err, ErrInvalidMaxCoupon) {
+				t.Errorf("RaiseMaxCoupons: %v", err)
+				return
+			}
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < rounds*2; i++ {
+			if _, err := m.GetCampaignInfo("brand", "giftcard"); err != nil {
+				t.Errorf("GetCampaignInfo: %v", err)
+				return
+			}
+		}
+	}()
+
+	wg.Wait()
+
+	info, err := m.GetCampaignInfo("brand", "giftcard")
+	if err != nil {
+		t.Fatalf("GetCampaignInfo: %v", err)
+	}
+	_, campaign, _ := m.getCampaign("brand", "giftcard")
+	if int64(len(info.AllCouponIds)) != campaign.MaxCoupons {
+		t.Errorf("AllCouponIds has %d codes, MaxCoupons = %d", len(info.AllCouponIds), campaign.MaxCoupons)
+	}
+}
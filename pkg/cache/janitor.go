@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// RemoveExpired : 종료된지 retention 이상 지난 캠페인을 메모리에서 제거함
+// lock 순서는 DeleteCampaign 과 같음 : commitMutex -> v.mutex -> 캠페인
+func (v *CampaignManager) RemoveExpired(now time.Time, retention time.Duration) int {
+	v.commitMutex.RLock()
+	defer v.commitMutex.RUnlock()
+
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	ctx := context.Background()
+	removed := 0
+	deadline := now.Add(-retention)
+
+	for _, tenant := range v.tenants {
+		for _, campaign := range tenant.campaigns {
+			// 진행중인 회수/사용 요청이 끝난 뒤에 확인하고 지움
+			v.lockCampaign(ctx, campaign, "remove")
+			if campaign.ExpiredDate.Before(deadline) {
+				v.removeCampaign(ctx, tenant, campaign, AuditCampaignRemoved)
+				removed++
+			}
+			campaign.mutex.Unlock()
+		}
+	}
+
+	return removed
+}
+
+// removeCampaign : v.mutex 와 캠페인 lock 을 잡은 상태에서 호출, 캠페인과 쿠폰 코드를 tenant 에서 지움
+func (v *CampaignManager) removeCampaign(ctx context.Context, tenant *Tenant, campaign *Campaign, action string) {
+	before := campaignAuditValues(campaign)
+	before["redeemed"] = campaign.redeemed
+	v.audit(ctx, AuditEvent{
+		Action:     action,
+		TenantId:   tenant.TenantId,
+		CampaignId: campaign.CampaignId,
+		Before:     before,
+	})
+
+	for couponId := range campaign.Coupons {
+		delete(tenant.couponCodes, couponId)
+	}
+	delete(tenant.campaigns, campaign.CampaignId)
+}
+
+// RunJanitor : ctx 가 끝날때까지 interval 마다 만료된 캠페인 정리
+func (v *CampaignManager) RunJanitor(ctx context.Context, interval, retention time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if expired := v.ExpireCoupons(now); expired > 0 {
+				slog.Info("janitor expired unused coupons", "count", expired)
+			}
+			if removed := v.RemoveExpired(now, retention); removed > 0 {
+				slog.Info("janitor removed expired campaigns", "count", removed)
+			}
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRemoveExpired(t *testing.T) {
+	m := NewCampaignManager()
+	campaign := newTestCampaign(t, m, "brand", "spring", 2, CampaignOptions{})
+	newTestCampaign(t, m, "other", "spring", 2, CampaignOptions{})
+
+	// retention 이 지나기 전에는 남겨둠
+	if removed := m.RemoveExpired(campaign.ExpiredDate.Add(time.Hour), 2*time.Hour); removed != 0 {
+		t.Fatalf("removed %d campaigns before retention", removed)
+	}
+	if removed := m.RemoveExpired(campaign.ExpiredDate.Add(3*time.Hour), 2*time.Hour); removed != 2 {
+		t.Fatalf("removed = %d, want 2", removed)
+	}
+	if _, err := m.GetCampaignInfo("brand", "spring"); err != ErrCampaignNotExists {
+		t.Fatalf("GetCampaignInfo after janitor = %v, want ErrCampaignNotExists", err)
+	}
+}
+
+// go test -race : janitor 가 지우는 동안 같은 캠페인에 사용/회수 요청이 들어와도 캠페인 내부를 같이 읽고 쓰지 않아야 함
+func TestRemoveExpiredConcurrentRevoke(t *testing.T) {
+	ctx := context.Background()
+
+	// 사용 -> 사용 취소 -> 회수 후 다시 발급을 캠페인이 지워질때까지 반복
+	cycle := func(m *CampaignManager, userId, code string, j int) (string, error) {
+		if _, err := m.UseCoupon(ctx, "brand", "spring", code, userId, "", nil); err != nil {
+			return code, err
+		}
+		if err := m.UnredeemCoupon(ctx, "brand", "spring", code, "test"); err != nil {
+			return code, err
+		}
+		if j%10 != 9 {
+			return code, nil
+		}
+		if _, err := m.RevokeCoupon(ctx, "brand", "spring", code, true, "test"); err != nil {
+			return code, err
+		}
+		coupon, err := m.PublishCoupon(ctx, "brand", "spring", userId, IssueOptions{})
+		if err != nil {
+			return code, err
+		}
+		return coupon.CouponId, nil
+	}
+
+	for round := 0; round < 10; round++ {
+		m := NewCampaignManager()
+		campaign := newTestCampaign(t, m, "brand", "spring", 8, CampaignOptions{})
+
+		var started, wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			userId := fmt.Sprintf("u%d", i)
+			coupon, err := m.PublishCoupon(ctx, "brand", "spring", userId, IssueOptions{})
+			if err != nil {
+				t.Fatalf("PublishCoupon: %v", err)
+			}
+
+			started.Add(1)
+			wg.Add(1)
+			go func(code string) {
+				defer wg.Done()
+				var once sync.Once
+				defer once.Do(started.Done)
+
+				for j := 0; ; j++ {
+					next, err := cycle(m, userId, code, j)
+					code = next
+					once.Do(started.Done)
+					if err == ErrCampaignNotExists {
+						return
+					}
+					if err != nil {
+						t.Errorf("%s: %v", userId, err)
+						return
+					}
+				}
+			}(coupon.CouponId)
+		}
+
+		started.Wait()
+		if removed := m.RemoveExpired(campaign.ExpiredDate.Add(time.Hour), 0); removed != 1 {
+			t.Fatalf("removed = %d, want 1", removed)
+		}
+		wg.Wait()
+	}
+}
@@ -0,0 +1,299 @@
+package cache
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+)
+
+// webhook 으로 내보내는 이벤트 종류
+const (
+	EventCouponIssued      = "coupon.issued"
+	EventCouponRedeemed    = "coupon.redeemed"
+	EventCouponRevoked     = "coupon.revoked"
+	EventCouponUnredeemed  = "coupon.unredeemed"
+	EventCampaignExhausted = "campaign.exhausted"
+	EventWaitlistFulfilled = "waitlist.fulfilled" // 대기자에게 쿠폰이 발급됨 (coupon.issued 와 같이 나감)
+)
+
+// EventTypes : webhook 등록시 구독할 수 있는 이벤트
+var EventTypes = []string{EventCouponIssued, EventCouponRedeemed, EventCouponRevoked, EventCouponUnredeemed, EventCampaignExhausted, EventWaitlistFulfilled}
+
+// Event : 상태 변경과 같은 lock 안에서 outbox 에 쌓이는 이벤트
+type Event struct {
+	Id         string    `json:"id"`
+	Type       string    `json:"type"`
+	Time       time.Time `json:"time"`
+	TenantId   string    `json:"tenantId"`
+	CampaignId string    `json:"campaignId"`
+	CouponCode string    `json:"couponCode,omitempty"`
+	UserId     string    `json:"userId,omitempty"`
+}
+
+// WebhookEndpoint : Events 가 비어있으면 모든 이벤트를 받음
+type WebhookEndpoint struct {
+	Id        string    `json:"id"`
+	TenantId  string    `json:"tenantId"`
+	URL       string    `json:"url"`
+	Secret    string    `json:"secret"` // HMAC 서명용
+	Events    []string  `json:"events"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (e *WebhookEndpoint) subscribes(eventType string) bool {
+	return len(e.Events) == 0 || slices.Contains(e.Events, eventType)
+}
+
+// Delivery : 이벤트 하나를 endpoint 하나로 보내는 작업
+type Delivery struct {
+	Id            int64      `json:"id"`
+	Event         Event      `json:"event"`
+	EndpointId    string     `json:"endpointId"`
+	Attempts      int        `json:"attempts"`
+	NextAttemptAt time.Time  `json:"nextAttemptAt"`
+	LastError     string     `json:"lastError,omitempty"`
+	DeadAt        *time.Time `json:"deadAt,omitempty"` // 재시도를 모두 실패해서 dead letter 로 옮긴 시각
+}
+
+// Outbox : 이벤트가 생길때 구독중인 endpoint 마다 delivery 를 만들어둠
+// 상태 변경과 delivery 가 같은 snapshot 에 같이 저장되어야 해서 CampaignManager 안에 둠
+type Outbox struct {
+	endpoints map[string]*WebhookEndpoint
+	pending   map[int64]*Delivery
+	dead      map[int64]*Delivery
+	inFlight  map[int64]bool
+	seq       int64
+	mutex     sync.Mutex
+}
+
+func newOutbox() *Outbox {
+	return &Outbox{
+		endpoints: make(map[string]*WebhookEndpoint),
+		pending:   make(map[int64]*Delivery),
+		dead:      make(map[int64]*Delivery),
+		inFlight:  make(map[int64]bool),
+	}
+}
+
+// enqueue : 상태를 바꾼 lock 안에서 호출함
+func (o *Outbox) enqueue(event Event) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	event.Id = newId("evt")
+	for _, endpoint := range o.endpoints {
+		if endpoint.TenantId != event.TenantId || !endpoint.subscribes(event.Type) {
+			continue
+		}
+		o.seq++
+		o.pending[o.seq] = &Delivery{
+			Id:            o.seq,
+			Event:         event,
+			EndpointId:    endpoint.Id,
+			NextAttemptAt: event.Time,
+		}
+	}
+}
+
+func newId(prefix string) string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return prefix + "_" + hex.EncodeToString(b)
+}
+
+// RegisterWebhook : secret 이 비어있으면 만들어서 반환함
+func (v *CampaignManager) RegisterWebhook(tenantId, rawURL, secret string, events []string) (*WebhookEndpoint, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, ErrInvalidWebhookURL
+	}
+	for _, e := range events {
+		if !slices.Contains(EventTypes, e) {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownEventType, e)
+		}
+	}
+	if secret == "" {
+		secret = newId("whsec")
+	}
+
+	endpoint := &WebhookEndpoint{
+		Id:        newId("wh"),
+		TenantId:  tenantId,
+		URL:       rawURL,
+		Secret:    secret,
+		Events:    events,
+		CreatedAt: time.Now(),
+	}
+
+	o := v.outbox
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	o.endpoints[endpoint.Id] = endpoint
+	copied := *endpoint
+	return &copied, nil
+}
+
+// DeleteWebhook : 아직 보내지 못한 delivery 도 같이 지움
+func (v *CampaignManager) DeleteWebhook(tenantId, endpointId string) error {
+	o := v.outbox
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	endpoint, exists := o.endpoints[endpointId]
+	if !exists || endpoint.TenantId != tenantId {
+		return ErrWebhookNotExists
+	}
+
+	delete(o.endpoints, endpointId)
+	for _, deliveries := range []map[int64]*Delivery{o.pending, o.dead} {
+		for id, d := range deliveries {
+			if d.EndpointId == endpointId {
+				delete(deliveries, id)
+			}
+		}
+	}
+	return nil
+}
+
+// ListWebhooks : secret 은 빼고 반환
+func (v *CampaignManager) ListWebhooks(tenantId string) []*WebhookEndpoint {
+	o := v.outbox
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	ret := make([]*WebhookEndpoint, 0)
+	for _, endpoint := range o.endpoints {
+		if endpoint.TenantId != tenantId {
+			continue
+		}
+		copied := *endpoint
+		copied.Secret = ""
+		ret = append(ret, &copied)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].CreatedAt.Before(ret[j].CreatedAt)
+	})
+	return ret
+}
+
+// DueDeliveries : 지금 보내야 하는 delivery 를 id 순서로 최대 limit 건 꺼냄
+// 꺼낸 delivery 는 CompleteDelivery / FailDelivery 를 호출할때까지 다시 꺼내지 않음
+func (v *CampaignManager) DueDeliveries(now time.Time, limit int) ([]Delivery, map[string]WebhookEndpoint) {
+	o := v.outbox
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	ids := make([]int64, 0)
+	for id, d := range o.pending {
+		if !o.inFlight[id] && !d.NextAttemptAt.After(now) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	deliveries := make([]Delivery, 0, len(ids))
+	endpoints := make(map[string]WebhookEndpoint)
+	for _, id := range ids {
+		d := o.pending[id]
+		o.inFlight[id] = true
+		deliveries = append(deliveries, *d)
+		endpoints[d.EndpointId] = *o.endpoints[d.EndpointId]
+	}
+	return deliveries, endpoints
+}
+
+// CompleteDelivery : 전송 성공, outbox 에서 제거
+func (v *CampaignManager) CompleteDelivery(id int64) {
+	o := v.outbox
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	delete(o.inFlight, id)
+	delete(o.pending, id)
+}
+
+// FailDelivery : nextAttemptAt 이 zero 면 더 재시도하지 않고 dead letter 로 옮김
+func (v *CampaignManager) FailDelivery(id int64, cause error, nextAttemptAt time.Time) {
+	o := v.outbox
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	delete(o.inFlight, id)
+	d, exists := o.pending[id]
+	if !exists { // 전송 중에 endpoint 가 삭제된 경우
+		return
+	}
+
+	d.Attempts++
+	d.LastError = cause.Error()
+	if !nextAttemptAt.IsZero() {
+		d.NextAttemptAt = nextAttemptAt
+		return
+	}
+
+	now := time.Now()
+	d.DeadAt = &now
+	delete(o.pending, id)
+	o.dead[id] = d
+}
+
+// DeadLetters : 재시도를 모두 실패한 delivery 목록 (id 순서)
+func (v *CampaignManager) DeadLetters(tenantId string) []Delivery {
+	o := v.outbox
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	ret := make([]Delivery, 0)
+	for _, d := range o.dead {
+		if d.Event.TenantId == tenantId {
+			ret = append(ret, *d)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Id < ret[j].Id
+	})
+	return ret
+}
+
+// ReplayDeadLetters : dead letter 를 시도 횟수를 초기화해서 다시 보냄, ids 가 비어있으면 tenant 의 전체
+func (v *CampaignManager) ReplayDeadLetters(tenantId string, ids []int64) (int, error) {
+	o := v.outbox
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	targets := ids
+	if len(targets) == 0 {
+		for id, d := range o.dead {
+			if d.Event.TenantId == tenantId {
+				targets = append(targets, id)
+			}
+		}
+	}
+
+	for _, id := range targets {
+		if d, exists := o.dead[id]; !exists || d.Event.TenantId != tenantId {
+			return 0, fmt.Errorf("%w: %d", ErrDeliveryNotExists, id)
+		}
+	}
+
+	now := time.Now()
+	for _, id := range targets {
+		d := o.dead[id]
+		delete(o.dead, id)
+		d.Attempts = 0
+		d.DeadAt = nil
+		d.NextAttemptAt = now
+		o.pending[id] = d
+	}
+	return len(targets), nil
+}
@@ -0,0 +1,151 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRegisterWebhook(t *testing.T) {
+	tests := []struct {
+		name   string
+		url    string
+		events []string
+		err    error
+	}{
+		{name: "all events", url: "https://example.com/hook"},
+		{name: "some events", url: "http://localhost:8080/hook", events: []string{EventCouponIssued, EventCampaignExhausted}},
+		{name: "no scheme", url: "example.com/hook", err: ErrInvalidWebhookURL},
+		{name: "other scheme", url: "ftp://example.com/hook", err: ErrInvalidWebhookURL},
+		{name: "unknown event", url: "https://example.com/hook", events: []string{"coupon.deleted"}, err: ErrUnknownEventType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewCampaignManager()
+			endpoint, err := m.RegisterWebhook("brand", tt.url, "", tt.events)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if endpoint.Secret == "" {
+				t.Errorf("secret was not generated")
+			}
+			if listed := m.ListWebhooks("brand"); len(listed) != 1 || listed[0].Secret != "" {
+				t.Errorf("ListWebhooks = %+v, want one endpoint without secret", listed)
+			}
+		})
+	}
+}
+
+func TestOutboxEnqueue(t *testing.T) {
+	m := NewCampaignManager()
+	all, _ := m.RegisterWebhook("brand", "https://example.com/all", "s", nil)
+	issued, _ := m.RegisterWebhook("brand", "https://example.com/issued", "s", []string{EventCouponIssued})
+	m.RegisterWebhook("other", "https://example.com/other", "s", nil)
+
+	now := time.Now()
+	m.outbox.enqueue(Event{Type: EventCouponIssued, Time: now, TenantId: "brand", CampaignId: "spring"})
+	m.outbox.enqueue(Event{Type: EventCouponRedeemed, Time: now, TenantId: "brand", CampaignId: "spring"})
+
+	deliveries, endpoints := m.DueDeliveries(now, 10)
+	got := make(map[string][]string)
+	for _, d := range deliveries {
+		got[d.EndpointId] = append(got[d.EndpointId], d.Event.Type)
+		if _, ok := endpoints[d.EndpointId]; !ok {
+			t.Errorf("delivery %d has no endpoint", d.Id)
+		}
+	}
+
+	// 다른 tenant endpoint 에는 가지 않고, 구독하지 않은 이벤트도 보내지 않음
+	if len(deliveries) != 3 || len(got[all.Id]) != 2 || len(got[issued.Id]) != 1 || got[issued.Id][0] != EventCouponIssued {
+		t.Errorf("deliveries = %v", got)
+	}
+	if deliveries[0].Event.Id == "" || deliveries[0].Event.Id != deliveries[1].Event.Id {
+		t.Errorf("same event has different ids: %s, %s", deliveries[0].Event.Id, deliveries[1].Event.Id)
+	}
+}
+
+func TestDeliveryLifecycle(t *testing.T) {
+	m := NewCampaignManager()
+	endpoint, _ := m.RegisterWebhook("brand", "https://example.com/hook", "s", nil)
+	now := time.Now()
+	m.outbox.enqueue(Event{Type: EventCouponIssued, Time: now, TenantId: "brand"})
+	m.outbox.enqueue(Event{Type: EventCouponIssued, Time: now, TenantId: "brand"})
+
+	due, _ := m.DueDeliveries(now, 1)
+	if len(due) != 1 {
+		t.Fatalf("DueDeliveries(limit 1) = %d deliveries", len(due))
+	}
+	first := due[0].Id
+
+	// 전송중인 delivery 는 다시 꺼내지 않음
+	if due, _ = m.DueDeliveries(now, 10); len(due) != 1 || due[0].Id == first {
+		t.Fatalf("DueDeliveries while in flight = %+v", due)
+	}
+	second := due[0].Id
+	m.CompleteDelivery(second)
+
+	// 재시도 시각 전에는 꺼내지 않음
+	m.FailDelivery(first, errors.New("503"), now.Add(time.Minute))
+	if due, _ = m.DueDeliveries(now, 10); len(due) != 0 {
+		t.Fatalf("DueDeliveries before retry = %+v", due)
+	}
+	if due, _ = m.DueDeliveries(now.Add(time.Minute), 10); len(due) != 1 || due[0].Attempts != 1 || due[0].LastError != "503" {
+		t.Fatalf("DueDeliveries after backoff = %+v", due)
+	}
+
+	m.FailDelivery(first, errors.New("timeout"), time.Time{})
+	dead := m.DeadLetters("brand")
+	if len(dead) != 1 || dead[0].Id != first || dead[0].DeadAt == nil || dead[0].Attempts != 2 {
+		t.Fatalf("DeadLetters = %+v", dead)
+	}
+	if len(m.DeadLetters("other")) != 0 {
+		t.Errorf("dead letters visible to other tenant")
+	}
+
+	if _, err := m.ReplayDeadLetters("other", []int64{first}); !errors.Is(err, ErrDeliveryNotExists) {
+		t.Errorf("ReplayDeadLetters(other): err = %v, want %v", err, ErrDeliveryNotExists)
+	}
+	if n, err := m.ReplayDeadLetters("brand", nil); err != nil || n != 1 {
+		t.Fatalf("ReplayDeadLetters = %d, %v", n, err)
+	}
+	if due, _ = m.DueDeliveries(time.Now(), 10); len(due) != 1 || due[0].Attempts != 0 || due[0].DeadAt != nil {
+		t.Fatalf("DueDeliveries after replay = %+v", due)
+	}
+
+	// endpoint 를 지우면 남은 delivery 도 지워지고, 전송 결과는 무시됨
+	if err := m.DeleteWebhook("other", endpoint.Id); !errors.Is(err, ErrWebhookNotExists) {
+		t.Errorf("DeleteWebhook(other): err = %v, want %v", err, ErrWebhookNotExists)
+	}
+	if err := m.DeleteWebhook("brand", endpoint.Id); err != nil {
+		t.Fatalf("DeleteWebhook: %v", err)
+	}
+	m.FailDelivery(first, errors.New("503"), time.Time{})
+	if len(m.DeadLetters("brand")) != 0 {
+		t.Errorf("dead letter left after DeleteWebhook")
+	}
+}
+
+// TestPublishEnqueuesEvents : 마지막 쿠폰 발급시 campaign.exhausted 도 같이 쌓임
+func TestPublishEnqueuesEvents(t *testing.T) {
+	m := NewCampaignManager()
+	m.RegisterWebhook("brand", "https://example.com/hook", "s", nil)
+	newTestCampaign(t, m, "brand", "spring", 1, CampaignOptions{})
+
+	coupon, err := m.PublishCoupon(context.Background(), "brand", "spring", "u1", IssueOptions{})
+	if err != nil {
+		t.Fatalf("PublishCoupon: %v", err)
+	}
+
+	due, _ := m.DueDeliveries(time.Now(), 10)
+	if len(due) != 2 || due[0].Event.Type != EventCouponIssued || due[1].Event.Type != EventCampaignExhausted {
+		t.Fatalf("deliveries = %+v", due)
+	}
+	if due[0].Event.CouponCode != coupon.CouponId || due[0].Event.UserId != "u1" {
+		t.Errorf("coupon.issued event = %+v", due[0].Event)
+	}
+}
@@ -0,0 +1,473 @@
+package cache
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/models"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/tracing"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// DrawAlgorithm : 추첨 결과를 다시 계산하는 방법, ExportDraw 에 같이 내려감
+const DrawAlgorithm = `rank entrants by hex(sha256(seed + ":" + userId)) ascending; the first N (N = available coupons) win, the rest form the waitlist in that order; seedCommitment = hex(sha256(seed))`
+
+// 응모자 기준 추첨 상태
+const (
+	DrawNotEntered = "not_entered"
+	DrawEntered    = "entered"    // 추첨 전
+	DrawWon        = "won"        // 쿠폰 발급됨
+	DrawWaitlisted = "waitlisted" // 당첨자가 쿠폰을 돌려주면 순서대로 발급됨 (LeaveWaitlist 로 빠진 경우 순서 0)
+	DrawReclaimed  = "reclaimed"  // 당첨됐지만 기한 안에 사용하지 않았거나 관리자가 회수함
+)
+
+// reclaimReason : 기한 안에 사용하지 않은 당첨 쿠폰 회수 사유
+const reclaimReason = "unclaimed"
+
+// Raffle : 추첨 캠페인 설정, 있으면 PublishCoupon 대신 EnterDraw 로 응모하고 DrawAt 이 지나면 추첨해서 발급함
+type Raffle struct {
+	RegistrationStart time.Time     `json:"registrationStart"`
+	RegistrationEnd   time.Time     `json:"registrationEnd"`
+	DrawAt            time.Time     `json:"drawAt"`
+	ClaimTTL          time.Duration `json:"claimTTL,omitempty"` // 발급 후 이 시간 안에 사용(예약 포함)하지 않으면 회수해서 대기자에게 넘김, 0 이면 회수 안함
+}
+
+type Entrant struct {
+	UserId    string    `json:"userId"`
+	EnteredAt time.Time `json:"enteredAt"`
+}
+
+// Winner : 추첨 또는 대기자 순서로 쿠폰을 받은 응모자
+type Winner struct {
+	UserId     string    `json:"userId"`
+	CouponCode string    `json:"couponCode"`
+	Rank       int       `json:"rank"` // 1 부터
+	IssuedAt   time.Time `json:"issuedAt"`
+	Backfill   bool      `json:"backfill,omitempty"`  // 대기자 순서로 받은 경우
+	Reclaimed  bool      `json:"reclaimed,omitempty"` // 기한 안에 사용하지 않았거나 관리자가 회수함
+}
+
+// drawState : 추첨 진행 상태, 캠페인 lock 으로 보호
+type drawState struct {
+	seed     string // 추첨 전에는 commitment 만 공개함
+	entrants []Entrant
+	entered  map[string]struct{}
+	drawnAt  time.Time
+	coupons  int      // 추첨 시점에 발급 가능했던 쿠폰 수
+	ranking  []string // 추첨 순위 (전체), 당첨되지 않은 응모자는 이 순서로 캠페인 대기자가 됨
+	winners  []Winner
+}
+
+// DrawResult : GetDrawResult 응답, userId 가 있으면 해당 응모자의 결과를 같이 채움
+type DrawResult struct {
+	Raffle         Raffle
+	SeedCommitment string
+	Seed           string    // 추첨 후에만 채워짐
+	DrawnAt        time.Time // 추첨 전이면 zero
+	Entrants       int
+	Winners        int
+	Waitlist       int
+
+	Status           string
+	CouponCode       string
+	Rank             int
+	WaitlistPosition int // 1 부터, 대기중이 아니면 0
+}
+
+// DrawRecord : 추첨 결과 검증용 export, Seed 는 추첨 후에만 채워짐
+type DrawRecord struct {
+	CampaignId     string    `json:"campaignId"`
+	Algorithm      string    `json:"algorithm"`
+	SeedCommitment string    `json:"seedCommitment"`
+	Seed           string    `json:"seed,omitempty"`
+	DrawnAt        time.Time `json:"drawnAt,omitempty"`
+	Coupons        int       `json:"coupons"` // 추첨 시점에 발급 가능했던 쿠폰 수
+	Entrants       []Entrant `json:"entrants"`
+	Ranking        []string  `json:"ranking,omitempty"`
+	Winners        []Winner  `json:"winners,omitempty"`
+}
+
+// DrawSnapshot : 추첨 진행 상태 저장용, seed 는 추첨 전이라도 저장함
+type DrawSnapshot struct {
+	Seed     string    `json:"seed"`
+	Entrants []Entrant `json:"entrants"`
+	DrawnAt  time.Time `json:"drawnAt"`
+	Coupons  int       `json:"coupons,omitempty"`
+	Ranking  []string  `json:"ranking,omitempty"`
+	Winners  []Winner  `json:"winners,omitempty"`
+}
+
+func (d *drawState) snapshot() *DrawSnapshot {
+	if d == nil {
+		return nil
+	}
+	return &DrawSnapshot{
+		Seed:     d.seed,
+		Entrants: slices.Clone(d.entrants),
+		DrawnAt:  d.drawnAt,
+		Coupons:  d.coupons,
+		Ranking:  slices.Clone(d.ranking),
+		Winners:  slices.Clone(d.winners),
+	}
+}
+
+func (s *DrawSnapshot) restore() *drawState {
+	if s == nil {
+		return nil
+	}
+
+	d := &drawState{
+		seed:     s.Seed,
+		entrants: s.Entrants,
+		entered:  make(map[string]struct{}, len(s.Entrants)),
+		drawnAt:  s.DrawnAt,
+		coupons:  s.Coupons,
+		ranking:  s.Ranking,
+		winners:  s.Winners,
+	}
+	for _, entrant := range s.Entrants {
+		d.entered[entrant.UserId] = struct{}{}
+	}
+	return d
+}
+
+// Validate : 캠페인 생성 시점에 확인
+func (r *Raffle) Validate(start, end time.Time) error {
+	invalid := func(format string, args ...any) error {
+		return fmt.Errorf("%w: %s", ErrInvalidRaffle, fmt.Sprintf(format, args...))
+	}
+
+	if !r.RegistrationStart.Before(r.RegistrationEnd) {
+		return invalid("registrationEnd must be after registrationStart")
+	}
+	if r.DrawAt.Before(r.RegistrationEnd) {
+		return invalid("drawAt must not be before registrationEnd")
+	}
+	if r.DrawAt.Before(start) || r.DrawAt.After(end) {
+		return invalid("drawAt must be within the campaign period")
+	}
+	if r.ClaimTTL < 0 {
+		return invalid("claimTTL must not be negative")
+	}
+	return nil
+}
+
+func newDrawState() (*drawState, error) {
+	seed := make([]byte, 32)
+	if _, err := rand.Read(seed); err != nil {
+		return nil, fmt.Errorf("failed to generate draw seed: %w", err)
+	}
+	return &drawState{seed: hex.EncodeToString(seed), entered: make(map[string]struct{})}, nil
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// SeedCommitment : 추첨 전에 공개하는 seed 의 hash, 추첨 후 공개된 seed 와 비교해서 seed 를 바꾸지 않았는지 확인함
+func SeedCommitment(seed string) string {
+	return sha256Hex(seed)
+}
+
+// RankEntrants : DrawAlgorithm 대로 응모자 순위를 매김, export 한 seed 와 응모자 목록으로 누구나 다시 계산할 수 있음
+func RankEntrants(seed string, userIds []string) []string {
+	keys := make(map[string]string, len(userIds))
+	for _, userId := range userIds {
+		keys[userId] = sha256Hex(seed + ":" + userId)
+	}
+
+	ranking := slices.Clone(userIds)
+	sort.Slice(ranking, func(i, j int) bool {
+		return keys[ranking[i]] < keys[ranking[j]]
+	})
+	return ranking
+}
+
+// EnterDraw : 응모 기간 안에 한번만 응모할 수 있음, 캠페인 발급 조건이 있으면 응모할때 평가함
+func (v *CampaignManager) EnterDraw(ctx context.Context, tenantId, campaignId, userId string, attributes map[string]string) (err error) {
+	ctx, span := tracing.Start(ctx, "CampaignManager.EnterDraw")
+	span.SetAttributes(attribute.String("tenant.id", tenantId), attribute.String("campaign.id", campaignId))
+	defer func() { tracing.End(span, err) }()
+
+	v.commitMutex.RLock()
+	defer v.commitMutex.RUnlock()
+
+	_, campaign, exists := v.getCampaign(tenantId, campaignId)
+	if !exists {
+		return ErrCampaignNotExists
+	}
+	if campaign.Raffle == nil {
+		return ErrNotRaffle
+	}
+	if userId == "" {
+		return fmt.Errorf("%w: userId is required", ErrDrawNotOpen)
+	}
+
+	if len(campaign.Rules) > 0 {
+		if err := checkEligibility(campaign.Rules, withUserId(attributes, userId), time.Now()); err != nil {
+			return err
+		}
+	}
+
+	v.lockCampaign(ctx, campaign, "enter_draw")
+	defer campaign.mutex.Unlock()
+
+	now := time.Now()
+	if now.Before(campaign.Raffle.RegistrationStart) {
+		return &ScheduleError{Cause: ErrDrawNotOpen, NextRelease: campaign.Raffle.RegistrationStart}
+	}
+	if !now.Before(campaign.Raffle.RegistrationEnd) || !campaign.draw.drawnAt.IsZero() {
+		return ErrDrawNotOpen
+	}
+
+	if _, exists := campaign.draw.entered[userId]; exists {
+		return ErrAlreadyEntered
+	}
+	campaign.draw.entered[userId] = struct{}{}
+	campaign.draw.entrants = append(campaign.draw.entrants, Entrant{UserId: userId, EnteredAt: now})
+
+	return nil
+}
+
+// RunDueDraws : 추첨 시각이 지난 캠페인을 추첨하고, 기한 안에 사용하지 않은 당첨 쿠폰을 회수해서 대기자에게 넘김
+func (v *CampaignManager) RunDueDraws(now time.Time) (drawn, reclaimed int) {
+	v.commitMutex.RLock()
+	defer v.commitMutex.RUnlock()
+
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	ctx := context.Background()
+	for tenantId, tenant := range v.tenants {
+		for _, campaign := range tenant.campaigns {
+			if campaign.Raffle == nil || now.Before(campaign.Raffle.DrawAt) {
+				continue
+			}
+
+			campaign.mutex.Lock()
+			switch {
+			case campaign.paused:
+				// 발급을 다시 시작한 뒤에 추첨, 회수함
+			case campaign.draw.drawnAt.IsZero():
+				v.runDraw(ctx, tenantId, campaign, now)
+				drawn++
+			case campaign.Raffle.ClaimTTL > 0 && !now.After(campaign.ExpiredDate):
+				reclaimed += v.reclaimUnclaimed(ctx, tenantId, campaign, now)
+			}
+			campaign.mutex.Unlock()
+		}
+	}
+
+	return drawn, reclaimed
+}
+
+// runDraw : 캠페인 lock 을 잡은 상태에서 호출
+func (v *CampaignManager) runDraw(ctx context.Context, tenantId string, campaign *Campaign, now time.Time) {
+	draw := campaign.draw
+	userIds := make([]string, 0, len(draw.entrants))
+	for _, entrant := range draw.entrants {
+		userIds = append(userIds, entrant.UserId)
+	}
+
+	coupons := len(campaign.UnPublishedCouponIds)
+	draw.coupons = coupons
+	draw.ranking = RankEntrants(draw.seed, userIds)
+	draw.drawnAt = now
+
+	// 추첨 순위대로 대기자에 넣고 발급 가능한 쿠폰 수만큼 바로 발급함
+	campaign.waitlist = make([]WaitlistEntry, 0, len(draw.ranking))
+	for _, userId := range draw.ranking {
+		campaign.waitlist = append(campaign.waitlist, WaitlistEntry{UserId: userId, JoinedAt: now})
+	}
+
+	v.audit(ctx, AuditEvent{
+		Action:     AuditCampaignDrawn,
+		TenantId:   tenantId,
+		CampaignId: campaign.CampaignId,
+		After: map[string]any{
+			"seed":           draw.seed,
+			"seedCommitment": SeedCommitment(draw.seed),
+			"entrants":       len(draw.entrants),
+			"coupons":        coupons,
+		},
+	})
+
+	issued := v.backfill(ctx, tenantId, campaign, now)
+	slog.Info("raffle drawn", "tenantId", tenantId, "campaignId", campaign.CampaignId, "entrants", len(draw.entrants), "winners", issued)
+}
+
+// reclaimUnclaimed : ClaimTTL 안에 사용하지 않은 당첨 쿠폰을 발급 대기 목록으로 돌려놓고 다음 대기자에게 발급함
+func (v *CampaignManager) reclaimUnclaimed(ctx context.Context, tenantId string, campaign *Campaign, now time.Time) int {
+	if len(campaign.waitlist) == 0 {
+		return 0
+	}
+
+	reclaimed := 0
+	for i := range campaign.draw.winners {
+		winner := &campaign.draw.winners[i]
+		if winner.Reclaimed || now.Before(winner.IssuedAt.Add(campaign.Raffle.ClaimTTL)) {
+			continue
+		}
+
+		coupon := campaign.Coupons[winner.CouponCode]
+		if coupon.State != models.CouponIssued || coupon.UserId != winner.UserId {
+			continue
+		}
+
+		err := v.transition(ctx, campaign, coupon, models.CouponAvailable, couponChange{
+			action:   AuditCouponRevoked,
+			tenantId: tenantId,
+			extra:    map[string]any{"reason": reclaimReason, "returnToPool": true},
+		})
+		if err != nil {
+			continue
+		}
+
+		campaign.draw.markReclaimed(coupon.CouponId, winner.UserId)
+		campaign.UnPublishedCouponIds = append(campaign.UnPublishedCouponIds, coupon.CouponId)
+		v.outbox.enqueue(Event{Type: EventCouponRevoked, Time: now, TenantId: tenantId, CampaignId: campaign.CampaignId, CouponCode: coupon.CouponId, UserId: winner.UserId})
+		reclaimed++
+
+		// 대기자 수만큼만 회수함
+		if reclaimed >= len(campaign.waitlist) {
+			break
+		}
+	}
+
+	v.backfill(ctx, tenantId, campaign, now)
+	return reclaimed
+}
+
+// markReclaimed : 당첨 쿠폰이 회수된 경우 당첨 기록에 남김, 캠페인 lock 을 잡은 상태에서 호출
+func (d *drawState) markReclaimed(couponId, userId string) {
+	if d == nil {
+		return
+	}
+	for i := range d.winners {
+		if d.winners[i].CouponCode == couponId && d.winners[i].UserId == userId {
+			d.winners[i].Reclaimed = true
+		}
+	}
+}
+
+// RunDrawScheduler : ctx 가 끝날때까지 interval 마다 추첨, 당첨 쿠폰 회수, releaseAt 이 지난 tier 예약 수량 정리
+func (v *CampaignManager) RunDrawScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if drawn, reclaimed := v.RunDueDraws(now); drawn > 0 || reclaimed > 0 {
+				slog.Info("raffle scheduler", "drawn", drawn, "reclaimed", reclaimed)
+			}
+			if released := v.ReleaseTierQuotas(now); released > 0 {
+				slog.Info("released tier quotas", "count", released)
+			}
+		}
+	}
+}
+
+// GetDrawResult : userId 가 비어있으면 추첨 전체 결과만 채움
+func (v *CampaignManager) GetDrawResult(tenantId, campaignId, userId string) (*DrawResult, error) {
+	_, campaign, exists := v.getCampaign(tenantId, campaignId)
+	if !exists {
+		return nil, ErrCampaignNotExists
+	}
+	if campaign.Raffle == nil {
+		return nil, ErrNotRaffle
+	}
+
+	campaign.mutex.RLock()
+	defer campaign.mutex.RUnlock()
+
+	draw := campaign.draw
+	ret := &DrawResult{
+		Raffle:         *campaign.Raffle,
+		SeedCommitment: SeedCommitment(draw.seed),
+		DrawnAt:        draw.drawnAt,
+		Entrants:       len(draw.entrants),
+		Winners:        len(draw.winners),
+		Waitlist:       len(campaign.waitlist),
+		Status:         DrawNotEntered,
+	}
+	if !draw.drawnAt.IsZero() {
+		ret.Seed = draw.seed
+	}
+
+	if _, entered := draw.entered[userId]; userId == "" || !entered {
+		return ret, nil
+	}
+
+	ret.Status = DrawEntered
+	if draw.drawnAt.IsZero() {
+		return ret, nil
+	}
+
+	ret.Rank = slices.Index(draw.ranking, userId) + 1
+	ret.Status = DrawWaitlisted
+	for _, winner := range draw.winners {
+		if winner.UserId != userId {
+			continue
+		}
+		ret.Status, ret.CouponCode = DrawWon, winner.CouponCode
+		if winner.Reclaimed {
+			ret.Status = DrawReclaimed
+		}
+		return ret, nil
+	}
+
+	ret.WaitlistPosition = campaign.waitlistIndex(userId) + 1
+	return ret, nil
+}
+
+// ExportDraw : 추첨 결과를 다시 계산할 수 있도록 seed 와 응모자 목록을 내보냄
+// public 이면 (admin 이 아닌 호출자) 추첨이 끝난 뒤에만 내보내고, 당첨자의 쿠폰 코드는 빼고 내보냄
+func (v *CampaignManager) ExportDraw(tenantId, campaignId string, public bool) (*DrawRecord, error) {
+	_, campaign, exists := v.getCampaign(tenantId, campaignId)
+	if !exists {
+		return nil, ErrCampaignNotExists
+	}
+	if campaign.Raffle == nil {
+		return nil, ErrNotRaffle
+	}
+
+	campaign.mutex.RLock()
+	defer campaign.mutex.RUnlock()
+
+	draw := campaign.draw
+	if public && draw.drawnAt.IsZero() {
+		return nil, ErrDrawNotDrawn
+	}
+
+	ret := &DrawRecord{
+		CampaignId:     campaign.CampaignId,
+		Algorithm:      DrawAlgorithm,
+		SeedCommitment: SeedCommitment(draw.seed),
+		Entrants:       slices.Clone(draw.entrants),
+	}
+	if !draw.drawnAt.IsZero() {
+		ret.Seed = draw.seed
+		ret.DrawnAt = draw.drawnAt
+		ret.Ranking = slices.Clone(draw.ranking)
+		ret.Winners = slices.Clone(draw.winners)
+		ret.Coupons = draw.coupons
+	}
+	if public {
+		for i := range ret.Winners {
+			ret.Winners[i].CouponCode = ""
+		}
+	}
+	return ret, nil
+}
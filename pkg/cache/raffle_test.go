@@ -0,0 +1,154 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestRankEntrants(t *testing.T) {
+	tests := []struct {
+		name    string
+		seed    string
+		userIds []string
+	}{
+		{name: "empty", seed: "seed"},
+		{name: "single", seed: "seed", userIds: []string{"u1"}},
+		{name: "several", seed: "6f1c0d", userIds: []string{"u1", "u2", "u3", "u4", "u5"}},
+		{name: "different seed", seed: "a9e402", userIds: []string{"u1", "u2", "u3", "u4", "u5"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// DrawAlgorithm 설명대로 따로 계산한 순위
+			type keyed struct{ key, userId string }
+			keys := make([]keyed, 0, len(tt.userIds))
+			for _, userId := range tt.userIds {
+				sum := sha256.Sum256([]byte(tt.seed + ":" + userId))
+				keys = append(keys, keyed{hex.EncodeToString(sum[:]), userId})
+			}
+			sort.Slice(keys, func(i, j int) bool { return keys[i].key < keys[j].key })
+			want := make([]string, 0, len(keys))
+			for _, k := range keys {
+				want = append(want, k.userId)
+			}
+
+			got := RankEntrants(tt.seed, tt.userIds)
+			if !slices.Equal(got, want) {
+				t.Errorf("RankEntrants = %v, want %v", got, want)
+			}
+			// 응모 순서와 상관없이 같은 순위
+			reversed := slices.Clone(tt.userIds)
+			slices.Reverse(reversed)
+			if reversed = RankEntrants(tt.seed, reversed); !slices.Equal(reversed, want) {
+				t.Errorf("RankEntrants(reversed) = %v, want %v", reversed, want)
+			}
+		})
+	}
+}
+
+func TestSeedCommitment(t *testing.T) {
+	sum := sha256.Sum256([]byte("seed"))
+	if got, want := SeedCommitment("seed"), hex.EncodeToString(sum[:]); got != want {
+		t.Errorf("SeedCommitment = %s, want %s", got, want)
+	}
+}
+
+func TestRaffleDrawAndExport(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	m := NewCampaignManager()
+	raffle := &Raffle{RegistrationStart: now.Add(-time.Minute), RegistrationEnd: now.Add(time.Minute), DrawAt: now.Add(time.Minute)}
+	newTestCampaign(t, m, "brand", "raffle", 2, CampaignOptions{Raffle: raffle})
+
+	userIds := []string{"u1", "u2", "u3", "u4", "u5"}
+	for _, userId := range userIds {
+		if err := m.EnterDraw(ctx, "brand", "raffle", userId, nil); err != nil {
+			t.Fatalf("EnterDraw(%s): %v", userId, err)
+		}
+	}
+	if err := m.EnterDraw(ctx, "brand", "raffle", "u1", nil); !errors.Is(err, ErrAlreadyEntered) {
+		t.Errorf("EnterDraw again: err = %v, want %v", err, ErrAlreadyEntered)
+	}
+
+	// 추첨 전 : client 는 내보낼 수 없고 admin 은 seed 없이 응모자만 받음
+	if _, err := m.ExportDraw("brand", "raffle", true); !errors.Is(err, ErrDrawNotDrawn) {
+		t.Errorf("public ExportDraw before draw: err = %v, want %v", err, ErrDrawNotDrawn)
+	}
+	before, err := m.ExportDraw("brand", "raffle", false)
+	if err != nil {
+		t.Fatalf("ExportDraw before draw: %v", err)
+	}
+	if before.Seed != "" || len(before.Entrants) != len(userIds) {
+		t.Errorf("ExportDraw before draw: seed %q, %d entrants", before.Seed, len(before.Entrants))
+	}
+
+	if drawn, _ := m.RunDueDraws(now.Add(2 * time.Minute)); drawn != 1 {
+		t.Fatalf("RunDueDraws drawn = %d, want 1", drawn)
+	}
+
+	record, err := m.ExportDraw("brand", "raffle", true)
+	if err != nil {
+		t.Fatalf("public ExportDraw: %v", err)
+	}
+	if SeedCommitment(record.Seed) != before.SeedCommitment || record.SeedCommitment != before.SeedCommitment {
+		t.Errorf("seed does not match the commitment published before the draw")
+	}
+	ranking := RankEntrants(record.Seed, userIds)
+	if !slices.Equal(record.Ranking, ranking) {
+		t.Errorf("Ranking = %v, want %v", record.Ranking, ranking)
+	}
+	if record.Coupons != 2 || len(record.Winners) != 2 {
+		t.Fatalf("coupons %d, winners %d, want 2, 2", record.Coupons, len(record.Winners))
+	}
+	for i, winner := range record.Winners {
+		if winner.UserId != ranking[i] || winner.Rank != i+1 {
+			t.Errorf("winner %d = %s (rank %d), want %s", i, winner.UserId, winner.Rank, ranking[i])
+		}
+		if winner.CouponCode != "" {
+			t.Errorf("public export has coupon code for %s", winner.UserId)
+		}
+	}
+
+	admin, err := m.ExportDraw("brand", "raffle", false)
+	if err != nil {
+		t.Fatalf("ExportDraw: %v", err)
+	}
+	for _, winner := range admin.Winners {
+		if winner.CouponCode == "" {
+			t.Errorf("admin export has no coupon code for %s", winner.UserId)
+		}
+	}
+
+	tests := []struct {
+		userId   string
+		status   string
+		position int
+	}{
+		{userId: ranking[0], status: DrawWon},
+		{userId: ranking[1], status: DrawWon},
+		{userId: ranking[2], status: DrawWaitlisted, position: 1},
+		{userId: ranking[4], status: DrawWaitlisted, position: 3},
+		{userId: "u9", status: DrawNotEntered},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("result %s", tt.userId), func(t *testing.T) {
+			result, err := m.GetDrawResult("brand", "raffle", tt.userId)
+			if err != nil {
+				t.Fatalf("GetDrawResult: %v", err)
+			}
+			if result.Status != tt.status || result.WaitlistPosition != tt.position {
+				t.Errorf("status %s, position %d, want %s, %d", result.Status, result.WaitlistPosition, tt.status, tt.position)
+			}
+			if result.Seed != record.Seed {
+				t.Errorf("GetDrawResult seed %q, want %q", result.Seed, record.Seed)
+			}
+		})
+	}
+}
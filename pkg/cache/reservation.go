@@ -0,0 +1,212 @@
+package cache
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/models"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/tracing"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// 예약 해제 사유 : 감사 이력에 남김
+const (
+	releaseRequested = "released"
+	releaseTimeout   = "timeout"
+)
+
+// SetReservationTTL : ttl 을 지정하지 않은 예약에 defaultTTL, 그보다 길게 요청하면 maxTTL 로 줄임
+func (v *CampaignManager) SetReservationTTL(defaultTTL, maxTTL time.Duration) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	v.reservationTTL = defaultTTL
+	v.maxReservationTTL = maxTTL
+}
+
+func (v *CampaignManager) holdTTL(ttl time.Duration) time.Duration {
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	if ttl <= 0 {
+		return v.reservationTTL
+	}
+	return min(ttl, v.maxReservationTTL)
+}
+
+// ReserveCoupon : 결제가 끝날때까지 발급된 쿠폰을 held 상태로 잡아둠
+// 반환된 쿠폰의 ReservationId 로 ConfirmRedemption / ReleaseReservation 을 호출함
+func (v *CampaignManager) ReserveCoupon(ctx context.Context, tenantId, campaignId, couponId, userId string, ttl time.Duration) (_ *models.Coupon, err error) {
+	ctx, span := tracing.Start(ctx, "CampaignManager.ReserveCoupon")
+	span.SetAttributes(attribute.String("tenant.id", tenantId), attribute.String("campaign.id", campaignId))
+	defer func() { tracing.End(span, err) }()
+
+	ttl = v.holdTTL(ttl)
+
+	v.commitMutex.RLock()
+	defer v.commitMutex.RUnlock()
+
+	_, campaign, exists := v.getCampaign(tenantId, campaignId)
+	if !exists {
+		return nil, ErrCampaignNotExists
+	}
+
+	v.lockCampaign(ctx, campaign, "reserve")
+	defer campaign.mutex.Unlock()
+
+	coupon, exists := campaign.Coupons[couponId]
+	if !exists {
+		return nil, ErrCouponNotExists
+	}
+
+	now := time.Now()
+	v.releaseExpiredHold(ctx, tenantId, campaign, coupon, now)
+
+	if err := checkUsable(ctx, coupon, userId, "", now); err != nil {
+		return nil, err
+	}
+
+	reservationId := newId("rsv")
+	heldUntil := now.Add(ttl)
+	err = v.transition(ctx, campaign, coupon, models.CouponHeld, couponChange{
+		action:   AuditCouponReserved,
+		tenantId: tenantId,
+		extra:    map[string]any{"reservationId": reservationId, "heldUntil": heldUntil.Format(time.RFC3339)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	coupon.ReservationId, coupon.HeldUntil = reservationId, heldUntil
+
+	copied := *coupon
+	return &copied, nil
+}
+
+// ConfirmRedemption : 결제가 끝나면 예약한 쿠폰을 사용 처리, cart 는 UseCoupon 과 같음
+func (v *CampaignManager) ConfirmRedemption(ctx context.Context, tenantId, campaignId, couponId, reservationId string, cart *Cart) (_ *Discount, err error) {
+	ctx, span := tracing.Start(ctx, "CampaignManager.ConfirmRedemption")
+	span.SetAttributes(attribute.String("tenant.id", tenantId), attribute.String("campaign.id", campaignId))
+	defer func() { tracing.End(span, err) }()
+
+	v.commitMutex.RLock()
+	defer v.commitMutex.RUnlock()
+
+	campaign, coupon, err := v.heldCoupon(ctx, tenantId, campaignId, couponId, reservationId, "confirm")
+	if err != nil {
+		return nil, err
+	}
+	defer campaign.mutex.Unlock()
+
+	now := time.Now()
+	if v.releaseExpiredHold(ctx, tenantId, campaign, coupon, now) {
+		return nil, ErrReservationExpired
+	}
+
+	return v.redeem(ctx, tenantId, campaign, coupon, "", reservationId, cart, now)
+}
+
+// ReleaseReservation : 결제가 실패하면 예약을 풀어서 다시 issued 상태로 돌림
+func (v *CampaignManager) ReleaseReservation(ctx context.Context, tenantId, campaignId, couponId, reservationId string) (err error) {
+	ctx, span := tracing.Start(ctx, "CampaignManager.ReleaseReservation")
+	span.SetAttributes(attribute.String("tenant.id", tenantId), attribute.String("campaign.id", campaignId))
+	defer func() { tracing.End(span, err) }()
+
+	v.commitMutex.RLock()
+	defer v.commitMutex.RUnlock()
+
+	campaign, coupon, err := v.heldCoupon(ctx, tenantId, campaignId, couponId, reservationId, "release")
+	if err != nil {
+		return err
+	}
+	defer campaign.mutex.Unlock()
+
+	return v.transition(ctx, campaign, coupon, models.CouponIssued, couponChange{
+		action:   AuditCouponReleased,
+		tenantId: tenantId,
+		extra:    map[string]any{"reason": releaseRequested},
+	})
+}
+
+// heldCoupon : reservationId 로 예약된 쿠폰을 찾아서 캠페인 lock 을 잡은 채로 반환함, 에러면 lock 을 잡지 않음
+func (v *CampaignManager) heldCoupon(ctx context.Context, tenantId, campaignId, couponId, reservationId, operation string) (*Campaign, *models.Coupon, error) {
+	_, campaign, exists := v.getCampaign(tenantId, campaignId)
+	if !exists {
+		return nil, nil, ErrCampaignNotExists
+	}
+
+	v.lockCampaign(ctx, campaign, operation)
+
+	coupon, exists := campaign.Coupons[couponId]
+	if !exists {
+		campaign.mutex.Unlock()
+		return nil, nil, ErrCouponNotExists
+	}
+
+	if reservationId == "" || coupon.State != models.CouponHeld || coupon.ReservationId != reservationId {
+		campaign.mutex.Unlock()
+		return nil, nil, ErrReservationNotExists
+	}
+
+	return campaign, coupon, nil
+}
+
+// releaseExpiredHold : 예약 시간이 지났으면 issued 로 되돌림, 캠페인 lock 을 잡은 상태에서 호출
+func (v *CampaignManager) releaseExpiredHold(ctx context.Context, tenantId string, campaign *Campaign, coupon *models.Coupon, now time.Time) bool {
+	if coupon.State != models.CouponHeld || coupon.HeldUntil.After(now) {
+		return false
+	}
+
+	v.transition(ctx, campaign, coupon, models.CouponIssued, couponChange{
+		action:   AuditCouponReleased,
+		tenantId: tenantId,
+		extra:    map[string]any{"reason": releaseTimeout},
+	})
+	return true
+}
+
+// ReleaseExpiredHolds : 결제 결과가 오지 않아 예약 시간이 지난 쿠폰을 issued 로 되돌림
+func (v *CampaignManager) ReleaseExpiredHolds(now time.Time) int {
+	v.commitMutex.RLock()
+	defer v.commitMutex.RUnlock()
+
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	ctx := context.Background()
+	released := 0
+
+	for tenantId, tenant := range v.tenants {
+		for _, campaign := range tenant.campaigns {
+			campaign.mutex.Lock()
+			if campaign.holds > 0 {
+				for _, coupon := range campaign.Coupons {
+					if v.releaseExpiredHold(ctx, tenantId, campaign, coupon, now) {
+						released++
+					}
+				}
+			}
+			campaign.mutex.Unlock()
+		}
+	}
+
+	return released
+}
+
+// RunReservationSweeper : ctx 가 끝날때까지 interval 마다 만료된 예약 정리
+func (v *CampaignManager) RunReservationSweeper(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if released := v.ReleaseExpiredHolds(now); released > 0 {
+				slog.Info("released expired coupon reservations", "count", released)
+			}
+		}
+	}
+}
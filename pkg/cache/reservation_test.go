@@ -0,0 +1,169 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/models"
+)
+
+func TestHoldTTL(t *testing.T) {
+	m := NewCampaignManager()
+	m.SetReservationTTL(5*time.Minute, 30*time.Minute)
+
+	tests := []struct {
+		ttl  time.Duration
+		want time.Duration
+	}{
+		{ttl: 0, want: 5 * time.Minute},
+		{ttl: -time.Second, want: 5 * time.Minute},
+		{ttl: time.Minute, want: time.Minute},
+		{ttl: time.Hour, want: 30 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := m.holdTTL(tt.ttl); got != tt.want {
+			t.Errorf("holdTTL(%s) = %s, want %s", tt.ttl, got, tt.want)
+		}
+	}
+}
+
+func TestReservation(t *testing.T) {
+	tests := []struct {
+		name string
+		ttl  time.Duration // 0 이면 1분
+		// run : 예약한 쿠폰으로 실행, 끝난 뒤 쿠폰 상태를 확인함
+		run   func(m *CampaignManager, held *models.Coupon) error
+		err   error
+		state models.CouponState
+	}{
+		{
+			name: "confirm",
+			run: func(m *CampaignManager, held *models.Coupon) error {
+				_, err := m.ConfirmRedemption(context.Background(), "brand", "spring", held.CouponId, held.ReservationId, nil)
+				return err
+			},
+			state: models.CouponRedeemed,
+		},
+		{
+			name: "confirm with other reservation",
+			run: func(m *CampaignManager, held *models.Coupon) error {
+				_, err := m.ConfirmRedemption(context.Background(), "brand", "spring", held.CouponId, "rsv_other", nil)
+				return err
+			},
+			err:   ErrReservationNotExists,
+			state: models.CouponHeld,
+		},
+		{
+			name: "redeem without reservation",
+			run: func(m *CampaignManager, held *models.Coupon) error {
+				_, err := m.UseCoupon(context.Background(), "brand", "spring", held.CouponId, "u1", "", nil)
+				return err
+			},
+			err:   ErrCouponHeld,
+			state: models.CouponHeld,
+		},
+		{
+			name: "redeem with reservation",
+			run: func(m *CampaignManager, held *models.Coupon) error {
+				_, err := m.UseCoupon(context.Background(), "brand", "spring", held.CouponId, "u1", held.ReservationId, nil)
+				return err
+			},
+			state: models.CouponRedeemed,
+		},
+		{
+			name: "reserve twice",
+			run: func(m *CampaignManager, held *models.Coupon) error {
+				_, err := m.ReserveCoupon(context.Background(), "brand", "spring", held.CouponId, "u1", 0)
+				return err
+			},
+			err:   ErrCouponHeld,
+			state: models.CouponHeld,
+		},
+		{
+			name: "release",
+			run: func(m *CampaignManager, held *models.Coupon) error {
+				return m.ReleaseReservation(context.Background(), "brand", "spring", held.CouponId, held.ReservationId)
+			},
+			state: models.CouponIssued,
+		},
+		{
+			name: "release twice",
+			run: func(m *CampaignManager, held *models.Coupon) error {
+				m.ReleaseReservation(context.Background(), "brand", "spring", held.CouponId, held.ReservationId)
+				return m.ReleaseReservation(context.Background(), "brand", "spring", held.CouponId, held.ReservationId)
+			},
+			err:   ErrReservationNotExists,
+			state: models.CouponIssued,
+		},
+		{
+			name: "confirm after timeout",
+			ttl:  10 * time.Millisecond,
+			run: func(m *CampaignManager, held *models.Coupon) error {
+				time.Sleep(20 * time.Millisecond)
+				_, err := m.ConfirmRedemption(context.Background(), "brand", "spring", held.CouponId, held.ReservationId, nil)
+				return err
+			},
+			err:   ErrReservationExpired,
+			state: models.CouponIssued,
+		},
+		{
+			name: "sweeper releases timeout",
+			run: func(m *CampaignManager, held *models.Coupon) error {
+				if n := m.ReleaseExpiredHolds(time.Now()); n != 0 {
+					return errors.New("released before timeout")
+				}
+				if n := m.ReleaseExpiredHolds(held.HeldUntil); n != 1 {
+					return errors.New("not released at timeout")
+				}
+				return nil
+			},
+			state: models.CouponIssued,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			m := NewCampaignManager()
+			campaign := newTestCampaign(t, m, "brand", "spring", 1, CampaignOptions{})
+			coupon, err := m.PublishCoupon(ctx, "brand", "spring", "u1", IssueOptions{})
+			if err != nil {
+				t.Fatalf("PublishCoupon: %v", err)
+			}
+
+			ttl := tt.ttl
+			if ttl == 0 {
+				ttl = time.Minute
+			}
+			if _, err := m.ReserveCoupon(ctx, "brand", "spring", coupon.CouponId, "u2", ttl); !errors.Is(err, ErrCouponNotIssuedToUser) {
+				t.Fatalf("ReserveCoupon by other user: err = %v, want %v", err, ErrCouponNotIssuedToUser)
+			}
+			held, err := m.ReserveCoupon(ctx, "brand", "spring", coupon.CouponId, "u1", ttl)
+			if err != nil {
+				t.Fatalf("ReserveCoupon: %v", err)
+			}
+			if held.State != models.CouponHeld || held.ReservationId == "" || campaign.holds != 1 {
+				t.Fatalf("reserved coupon = %+v, holds %d", held, campaign.holds)
+			}
+
+			if err := tt.run(m, held); !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+
+			got := campaign.Coupons[coupon.CouponId]
+			wantHolds := 0
+			if tt.state == models.CouponHeld {
+				wantHolds = 1
+			}
+			if got.State != tt.state || got.UserId != "u1" || campaign.holds != wantHolds {
+				t.Errorf("state %s, user %s, holds %d, want %s, u1, %d", got.State, got.UserId, campaign.holds, tt.state, wantHolds)
+			}
+			if tt.state != models.CouponHeld && (got.ReservationId != "" || !got.HeldUntil.IsZero()) {
+				t.Errorf("reservation left on coupon: %+v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,287 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Schedule : 캠페인 기간 안에서 쿠폰을 나눠서 풀어주는 규칙, 모두 비어있으면 기간 안에서 제한 없이 발급
+type Schedule struct {
+	Waves      []Wave        `json:"waves,omitempty"`      // 시각마다 풀리는 수량, 있으면 지금까지 풀린 수량까지만 발급
+	Windows    []Window      `json:"windows,omitempty"`    // 매일 발급하는 시간대, 있으면 시간대 밖에서는 발급하지 않음
+	RateLimit  int           `json:"rateLimit,omitempty"`  // RatePeriod 동안 최대 발급 수
+	RatePeriod time.Duration `json:"ratePeriod,omitempty"` // 최근 RatePeriod 동안의 발급 수로 제한 (rolling)
+}
+
+// Wave : At 이 되면 Quota 만큼 추가로 발급 가능
+type Wave struct {
+	At    time.Time `json:"at"`
+	Quota int64     `json:"quota"`
+}
+
+// Window : 매일 Start ~ End (서버 local time, "15:04" 형식), End 는 포함하지 않음
+type Window struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// ScheduleError : 발급 일정 때문에 지금은 발급할 수 없는 경우, NextRelease 이후에 다시 요청하면 됨
+// errors.Is 로 원래 에러(ErrNoMoreCoupon 등)를 확인할 수 있음
+type ScheduleError struct {
+	Cause       error
+	NextRelease time.Time
+}
+
+func (e *ScheduleError) Error() string {
+	return fmt.Sprintf("%s (next release at %s)", e.Cause, e.NextRelease.Format(time.RFC3339))
+}
+
+func (e *ScheduleError) Unwrap() error {
+	return e.Cause
+}
+
+// Validate : 캠페인 생성 시점에 확인, wave 는 시각 순으로 정렬함
+func (s *Schedule) Validate(start, end time.Time, maxCoupon int64) error {
+	invalid := func(format string, args ...any) error {
+		return fmt.Errorf("%w: %s", ErrInvalidSchedule, fmt.Sprintf(format, args...))
+	}
+
+	total := int64(0)
+	for i, wave := range s.Waves {
+		if wave.Quota <= 0 {
+			return invalid("waves[%d]: quota must be positive", i)
+		}
+		if wave.At.Before(start) || wave.At.After(end) {
+			return invalid("waves[%d]: at must be within the campaign period", i)
+		}
+		total += wave.Quota
+	}
+	if total > maxCoupon {
+		return invalid("total wave quota %d exceeds maxCoupon %d", total, maxCoupon)
+	}
+	sort.Slice(s.Waves, func(i, j int) bool {
+		return s.Waves[i].At.Before(s.Waves[j].At)
+	})
+
+	for i, window := range s.Windows {
+		from, fromErr := minuteOfDay(window.Start)
+		to, toErr := minuteOfDay(window.End)
+		if fromErr != nil || toErr != nil {
+			return invalid("windows[%d]: start and end must be hh:mm", i)
+		}
+		if from >= to {
+			return invalid("windows[%d]: end must be after start", i)
+		}
+	}
+
+	if s.RateLimit < 0 || s.RatePeriod < 0 {
+		return invalid("rateLimit and ratePeriod must not be negative")
+	}
+	if (s.RateLimit > 0) != (s.RatePeriod > 0) {
+		return invalid("rateLimit and ratePeriod must be set together")
+	}
+
+	return nil
+}
+
+func minuteOfDay(hhmm string) (int, error) {
+	t, err := time.Parse("15:04", hhmm)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// released : at 시점까지 풀린 수량, wave 가 없으면 제한 없음(-1)
+func (s *Schedule) released(at time.Time) int64 {
+	if len(s.Waves) == 0 {
+		return -1
+	}
+
+	total := int64(0)
+	for _, wave := range s.Waves {
+		if wave.At.After(at) {
+			break
+		}
+		total += wave.Quota
+	}
+	return total
+}
+
+// inWindow : 시간대가 없으면 항상 true
+func (s *Schedule) inWindow(at time.Time) bool {
+	if len(s.Windows) == 0 {
+		return true
+	}
+
+	at = at.In(time.Local)
+	minute := at.Hour()*60 + at.Minute()
+	for _, window := range s.Windows {
+		from, _ := minuteOfDay(window.Start)
+		to, _ := minuteOfDay(window.End)
+		if minute >= from && minute < to {
+			return true
+		}
+	}
+	return false
+}
+
+// nextWindowStart : at 이후 처음 열리는 시간대의 시작 시각
+func (s *Schedule) nextWindowStart(at time.Time) time.Time {
+	at = at.In(time.Local)
+	var next time.Time
+
+	for day := 0; day <= 1; day++ {
+		date := at.AddDate(0, 0, day)
+		for _, window := range s.Windows {
+			from, _ := minuteOfDay(window.Start)
+			start := time.Date(date.Year(), date.Month(), date.Day(), from/60, from%60, 0, 0, time.Local)
+			if start.After(at) && (next.IsZero() || start.Before(next)) {
+				next = start
+			}
+		}
+	}
+	return next
+}
+
+// issuedCount : wave 수량과 비교하는 발급 수, 회수해서 발급 대기 목록으로 돌려놓은 쿠폰은 다시 발급 가능함
+func (c *Campaign) issuedCount() int64 {
+	return c.MaxCoupons - int64(len(c.UnPublishedCouponIds))
+}
+
+// recentIssues : 최근 RatePeriod 안의 발급 수
+func (c *Campaign) recentIssues(now time.Time) int {
+	cutoff := now.Add(-c.Schedule.RatePeriod)
+	return len(c.issueTimes) - sort.Search(len(c.issueTimes), func(i int) bool {
+		return c.issueTimes[i].After(cutoff)
+	})
+}
+
+// recordIssue : rate 제한이 있는 캠페인만 발급 시각을 남김, 캠페인 lock 을 잡은 상태에서 호출
+// 발급 시각은 snapshot 에 남기지 않아서 재시작하면 rate 는 처음부터 다시 셈
+func (c *Campaign) recordIssue(now time.Time) {
+	if c.Schedule == nil || c.Schedule.RateLimit == 0 {
+		return
+	}
+	if len(c.issueTimes) >= c.Schedule.RateLimit {
+		c.issueTimes = c.issueTimes[len(c.issueTimes)-c.Schedule.RateLimit+1:]
+	}
+	c.issueTimes = append(c.issueTimes, now)
+}
+
+// checkSchedule : 캠페인 lock (read 포함) 을 잡은 상태에서 호출, 발급할 수 없으면 다음 발급 가능 시각을 같이 반환함
+func (c *Campaign) checkSchedule(now time.Time) error {
+	if now.Before(c.StartDate) {
+		return &ScheduleError{Cause: ErrCampaignNotValidTime, NextRelease: c.resumeAt(now)}
+	}
+	if now.After(c.ExpiredDate) {
+		return ErrCampaignNotValidTime
+	}
+	if len(c.UnPublishedCouponIds) == 0 {
+		return ErrNoMoreCoupon
+	}
+	// 남은 쿠폰이 모두 tier 에 예약되어 있으면 예약 수량이 일반 발급으로 돌아가는 시각까지 기다림
+	if c.exhausted(now) {
+		if next := c.nextTierRelease(now); !next.IsZero() {
+			return &ScheduleError{Cause: ErrNoMoreCoupon, NextRelease: next}
+		}
+		return ErrNoMoreCoupon
+	}
+
+	s := c.Schedule
+	if s == nil {
+		return nil
+	}
+
+	var cause error
+	switch {
+	case s.released(now) >= 0 && c.issuedCount() >= s.released(now):
+		cause = ErrNoMoreCoupon
+	case !s.inWindow(now):
+		cause = ErrOutsideIssueWindow
+	case s.RateLimit > 0 && c.recentIssues(now) >= s.RateLimit:
+		cause = ErrIssueRateLimited
+	default:
+		return nil
+	}
+
+	next := c.resumeAt(now)
+	if next.IsZero() {
+		return cause
+	}
+	return &ScheduleError{Cause: cause, NextRelease: next}
+}
+
+// resumeAt : now 이후 발급 가능해지는 가장 빠른 시각, 더 풀릴 쿠폰이 없으면 zero
+func (c *Campaign) resumeAt(now time.Time) time.Time {
+	if len(c.UnPublishedCouponIds) == 0 {
+		return time.Time{}
+	}
+
+	at := now
+	if at.Before(c.StartDate) {
+		at = c.StartDate
+	}
+
+	if s := c.Schedule; s != nil {
+		if s.RateLimit > 0 && len(c.issueTimes) >= s.RateLimit {
+			if until := c.issueTimes[len(c.issueTimes)-s.RateLimit].Add(s.RatePeriod); until.After(at) {
+				at = until
+			}
+		}
+
+		// wave 가 풀리는 시각까지 미룸
+		if limit := s.released(at); limit >= 0 && c.issuedCount() >= limit {
+			found := false
+			for _, wave := range s.Waves {
+				if wave.At.After(at) && s.released(wave.At) > c.issuedCount() {
+					at, found = wave.At, true
+					break
+				}
+			}
+			if !found {
+				return time.Time{}
+			}
+		}
+
+		if !s.inWindow(at) {
+			at = s.nextWindowStart(at)
+		}
+	}
+
+	if at.After(c.ExpiredDate) {
+		return time.Time{}
+	}
+	return at
+}
+
+// nextRelease : GetCampaign 에 내려주는 다음 발급 시각
+// 지금 발급할 수 없으면 다시 발급 가능해지는 시각, 발급 가능하면 다음 wave 시각
+// 추첨 캠페인은 추첨 전이면 추첨 시각, tier 예약 수량 때문에 발급할 수 없으면 예약이 풀리는 시각
+func (c *Campaign) nextRelease(now time.Time) time.Time {
+	if c.Raffle != nil {
+		if c.draw.drawnAt.IsZero() {
+			return c.Raffle.DrawAt
+		}
+		return time.Time{}
+	}
+
+	if err := c.checkSchedule(now); err != nil {
+		var scheduled *ScheduleError
+		if errors.As(err, &scheduled) {
+			return scheduled.NextRelease
+		}
+		return time.Time{}
+	}
+
+	if c.Schedule != nil {
+		for _, wave := range c.Schedule.Waves {
+			if wave.At.After(now) {
+				return wave.At
+			}
+		}
+	}
+	return time.Time{}
+}
@@ -0,0 +1,130 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestScheduleValidate(t *testing.T) {
+	start := time.Date(2025, 5, 12, 9, 0, 0, 0, time.Local)
+	end := start.Add(24 * time.Hour)
+
+	tests := []struct {
+		name     string
+		schedule Schedule
+		ok       bool
+	}{
+		{name: "empty", ok: true},
+		{name: "waves", schedule: Schedule{Waves: []Wave{{At: start.Add(time.Hour), Quota: 5}, {At: start, Quota: 5}}}, ok: true},
+		{name: "wave quota", schedule: Schedule{Waves: []Wave{{At: start, Quota: 0}}}},
+		{name: "wave before start", schedule: Schedule{Waves: []Wave{{At: start.Add(-time.Second), Quota: 1}}}},
+		{name: "wave after end", schedule: Schedule{Waves: []Wave{{At: end.Add(time.Second), Quota: 1}}}},
+		{name: "waves over maxCoupon", schedule: Schedule{Waves: []Wave{{At: start, Quota: 6}, {At: end, Quota: 5}}}},
+		{name: "window", schedule: Schedule{Windows: []Window{{Start: "12:00", End: "13:30"}}}, ok: true},
+		{name: "window format", schedule: Schedule{Windows: []Window{{Start: "noon", End: "13:00"}}}},
+		{name: "window end before start", schedule: Schedule{Windows: []Window{{Start: "13:00", End: "12:00"}}}},
+		{name: "rate", schedule: Schedule{RateLimit: 10, RatePeriod: time.Minute}, ok: true},
+		{name: "rate without period", schedule: Schedule{RateLimit: 10}},
+		{name: "negative rate", schedule: Schedule{RateLimit: -1, RatePeriod: time.Minute}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schedule.Validate(start, end, 10)
+			if tt.ok && err != nil {
+				t.Fatalf("Validate: %v", err)
+			}
+			if !tt.ok && !errors.Is(err, ErrInvalidSchedule) {
+				t.Fatalf("err = %v, want %v", err, ErrInvalidSchedule)
+			}
+		})
+	}
+
+	s := Schedule{Waves: []Wave{{At: end, Quota: 1}, {At: start, Quota: 1}}}
+	s.Validate(start, end, 10)
+	if !s.Waves[0].At.Equal(start) {
+		t.Errorf("waves are not sorted: %+v", s.Waves)
+	}
+}
+
+func TestCheckSchedule(t *testing.T) {
+	day := time.Date(2025, 5, 12, 0, 0, 0, 0, time.Local)
+	at := func(hour, minute int) time.Time {
+		return day.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+	}
+	now := at(10, 0)
+
+	tests := []struct {
+		name       string
+		now        time.Time
+		schedule   *Schedule
+		issued     int // 캠페인 쿠폰 10개 중 발급된 수
+		issueTimes []time.Time
+		err        error
+		next       time.Time // zero 면 ScheduleError 가 아님
+	}{
+		{name: "no schedule", now: now},
+		{name: "before start", now: at(8, 0), err: ErrCampaignNotValidTime, next: at(9, 0)},
+		{name: "after end", now: at(40, 1), err: ErrCampaignNotValidTime},
+		{name: "sold out", now: now, issued: 10, err: ErrNoMoreCoupon},
+		{name: "wave not used up", now: now, issued: 2, schedule: &Schedule{Waves: []Wave{{At: at(9, 0), Quota: 3}, {At: at(12, 0), Quota: 3}}}},
+		{name: "wave used up", now: now, issued: 3, schedule: &Schedule{Waves: []Wave{{At: at(9, 0), Quota: 3}, {At: at(12, 0), Quota: 3}}}, err: ErrNoMoreCoupon, next: at(12, 0)},
+		{name: "last wave used up", now: now, issued: 6, schedule: &Schedule{Waves: []Wave{{At: at(9, 0), Quota: 3}, {At: at(9, 30), Quota: 3}}}, err: ErrNoMoreCoupon},
+		{name: "in window", now: at(12, 30), schedule: &Schedule{Windows: []Window{{Start: "12:00", End: "13:00"}}}},
+		{name: "before window", now: now, schedule: &Schedule{Windows: []Window{{Start: "12:00", End: "13:00"}}}, err: ErrOutsideIssueWindow, next: at(12, 0)},
+		{name: "window end is exclusive", now: at(13, 0), schedule: &Schedule{Windows: []Window{{Start: "12:00", End: "13:00"}}}, err: ErrOutsideIssueWindow, next: at(36, 0)},
+		{name: "window after end", now: at(37, 0), schedule: &Schedule{Windows: []Window{{Start: "12:00", End: "13:00"}}}, err: ErrOutsideIssueWindow},
+		{name: "under rate", now: now, issued: 2, schedule: &Schedule{RateLimit: 2, RatePeriod: time.Hour}, issueTimes: []time.Time{at(8, 50), at(9, 50)}},
+		{name: "rate limited", now: now, issued: 2, schedule: &Schedule{RateLimit: 2, RatePeriod: time.Hour}, issueTimes: []time.Time{at(9, 30), at(9, 50)}, err: ErrIssueRateLimited, next: at(10, 30)},
+		{name: "rate and window", now: at(12, 50), issued: 2, schedule: &Schedule{RateLimit: 2, RatePeriod: time.Hour, Windows: []Window{{Start: "12:00", End: "13:00"}}}, issueTimes: []time.Time{at(12, 10), at(12, 20)}, err: ErrIssueRateLimited, next: at(36, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			campaign := &Campaign{
+				StartDate:   at(9, 0),
+				ExpiredDate: at(40, 0),
+				MaxCoupons:  10,
+				Schedule:    tt.schedule,
+				issueTimes:  tt.issueTimes,
+			}
+			for i := tt.issued; i < 10; i++ {
+				campaign.UnPublishedCouponIds = append(campaign.UnPublishedCouponIds, fmt.Sprintf("C%d", i))
+			}
+
+			err := campaign.checkSchedule(tt.now)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+
+			var scheduled *ScheduleError
+			isScheduled := errors.As(err, &scheduled)
+			if isScheduled != !tt.next.IsZero() {
+				t.Fatalf("err = %v, want next release %v", err, tt.next)
+			}
+			if isScheduled && !scheduled.NextRelease.Equal(tt.next) {
+				t.Errorf("NextRelease = %s, want %s", scheduled.NextRelease, tt.next)
+			}
+		})
+	}
+}
+
+func TestRecordIssueKeepsRateLimit(t *testing.T) {
+	campaign := &Campaign{Schedule: &Schedule{RateLimit: 3, RatePeriod: time.Minute}}
+	base := time.Now()
+	for i := 0; i < 10; i++ {
+		campaign.recordIssue(base.Add(time.Duration(i) * time.Second))
+	}
+	if len(campaign.issueTimes) != 3 || !campaign.issueTimes[0].Equal(base.Add(7*time.Second)) {
+		t.Errorf("issueTimes = %v, want last 3", campaign.issueTimes)
+	}
+
+	// rate 제한이 없으면 남기지 않음
+	campaign = &Campaign{}
+	campaign.recordIssue(base)
+	if len(campaign.issueTimes) != 0 {
+		t.Errorf("issueTimes recorded without rate limit")
+	}
+}
@@ -0,0 +1,214 @@
+package cache
+
+import (
+	"slices"
+	"time"
+
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/models"
+)
+
+// Snapshot : storage backend 에 저장하는 전체 상태
+type Snapshot struct {
+	SavedAt time.Time        `json:"savedAt"`
+	Tenants []TenantSnapshot `json:"tenants"`
+	Outbox  *OutboxSnapshot  `json:"outbox,omitempty"`
+}
+
+// OutboxSnapshot : 아직 보내지 못한 delivery 와 dead letter 도 같이 저장해야 재시작해도 이벤트가 유실되지 않음
+type OutboxSnapshot struct {
+	Seq       int64              `json:"seq"`
+	Endpoints []*WebhookEndpoint `json:"endpoints"`
+	Pending   []*Delivery        `json:"pending"`
+	Dead      []*Delivery        `json:"dead"`
+}
+
+type TenantSnapshot struct {
+	TenantId  string             `json:"tenantId"`
+	Campaigns []CampaignSnapshot `json:"campaigns"`
+}
+
+type CampaignSnapshot struct {
+	CampaignId           string           `json:"campaignId"`
+	StartDate            time.Time        `json:"startDate"`
+	ExpiredDate          time.Time        `json:"expiredDate"`
+	MaxCoupons           int64            `json:"maxCoupons"`
+	Benefit              *Benefit         `json:"benefit,omitempty"`
+	Rules                []Rule           `json:"rules,omitempty"`
+	Schedule             *Schedule        `json:"schedule,omitempty"`
+	Raffle               *Raffle          `json:"raffle,omitempty"`
+	Draw                 *DrawSnapshot    `json:"draw,omitempty"`
+	Waitlist             []WaitlistEntry  `json:"waitlist,omitempty"`
+	Tiers                []Tier           `json:"tiers,omitempty"`
+	ReleasedTiers        []string         `json:"releasedTiers,omitempty"`
+	Paused               bool             `json:"paused,omitempty"`
+	UnPublishedCouponIds []string         `json:"unPublishedCouponIds"`
+	Coupons              []*models.Coupon `json:"coupons"`
+}
+
+// Snapshot : 캠페인 단위로 read lock 을 잡고 복사함
+// 복사하는 동안에는 발급/사용을 막아서 outbox 와 캠페인 상태가 어긋나지 않게 함
+func (v *CampaignManager) Snapshot() *Snapshot {
+	v.commitMutex.Lock()
+	defer v.commitMutex.Unlock()
+
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	snapshot := &Snapshot{
+		SavedAt: time.Now(),
+		Tenants: make([]TenantSnapshot, 0, len(v.tenants)),
+	}
+
+	for tenantId, tenant := range v.tenants {
+		ts := TenantSnapshot{
+			TenantId:  tenantId,
+			Campaigns: make([]CampaignSnapshot, 0, len(tenant.campaigns)),
+		}
+
+		for _, campaign := range tenant.campaigns {
+			ts.Campaigns = append(ts.Campaigns, campaign.snapshot())
+		}
+
+		snapshot.Tenants = append(snapshot.Tenants, ts)
+	}
+
+	snapshot.Outbox = v.outbox.snapshot()
+
+	return snapshot
+}
+
+func (o *Outbox) snapshot() *OutboxSnapshot {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	snap := &OutboxSnapshot{
+		Seq:       o.seq,
+		Endpoints: make([]*WebhookEndpoint, 0, len(o.endpoints)),
+		Pending:   make([]*Delivery, 0, len(o.pending)),
+		Dead:      make([]*Delivery, 0, len(o.dead)),
+	}
+
+	for _, endpoint := range o.endpoints {
+		copied := *endpoint
+		snap.Endpoints = append(snap.Endpoints, &copied)
+	}
+	for _, d := range o.pending {
+		copied := *d
+		snap.Pending = append(snap.Pending, &copied)
+	}
+	for _, d := range o.dead {
+		copied := *d
+		snap.Dead = append(snap.Dead, &copied)
+	}
+
+	return snap
+}
+
+func (o *Outbox) restore(snap *OutboxSnapshot) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	o.endpoints = make(map[string]*WebhookEndpoint, len(snap.Endpoints))
+	o.pending = make(map[int64]*Delivery, len(snap.Pending))
+	o.dead = make(map[int64]*Delivery, len(snap.Dead))
+	o.inFlight = make(map[int64]bool)
+	o.seq = snap.Seq
+
+	for _, endpoint := range snap.Endpoints {
+		o.endpoints[endpoint.Id] = endpoint
+	}
+	for _, d := range snap.Pending {
+		o.pending[d.Id] = d
+	}
+	for _, d := range snap.Dead {
+		o.dead[d.Id] = d
+	}
+}
+
+func (c *Campaign) snapshot() CampaignSnapshot {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	cs := CampaignSnapshot{
+		CampaignId:           c.CampaignId,
+		StartDate:            c.StartDate,
+		ExpiredDate:          c.ExpiredDate,
+		MaxCoupons:           c.MaxCoupons,
+		Benefit:              c.Benefit,
+		Rules:                c.Rules,
+		Schedule:             c.Schedule,
+		Raffle:               c.Raffle,
+		Draw:                 c.draw.snapshot(),
+		Waitlist:             slices.Clone(c.waitlist),
+		Tiers:                c.Tiers,
+		ReleasedTiers:        slices.Clone(c.releasedTiers),
+		Paused:               c.paused,
+		UnPublishedCouponIds: append([]string(nil), c.UnPublishedCouponIds...),
+		Coupons:              make([]*models.Coupon, 0, len(c.Coupons)),
+	}
+
+	for _, coupon := range c.Coupons {
+		copied := *coupon
+		cs.Coupons = append(cs.Coupons, &copied)
+	}
+
+	return cs
+}
+
+// Restore : 서버 가동 시점에 storage 에서 읽은 상태로 교체함
+func (v *CampaignManager) Restore(snapshot *Snapshot) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	v.tenants = make(map[string]*Tenant, len(snapshot.Tenants))
+
+	for _, ts := range snapshot.Tenants {
+		tenant := v.tenant(ts.TenantId, true)
+
+		for _, cs := range ts.Campaigns {
+			campaign := &Campaign{
+				CampaignId:           cs.CampaignId,
+				StartDate:            cs.StartDate,
+				ExpiredDate:          cs.ExpiredDate,
+				MaxCoupons:           cs.MaxCoupons,
+				Benefit:              cs.Benefit,
+				Rules:                cs.Rules,
+				Schedule:             cs.Schedule,
+				Raffle:               cs.Raffle,
+				draw:                 cs.Draw.restore(),
+				waitlist:             cs.Waitlist,
+				Tiers:                cs.Tiers,
+				releasedTiers:        cs.ReleasedTiers,
+				paused:               cs.Paused,
+				tierIssued:           make(map[string]int64, len(cs.Tiers)),
+				UnPublishedCouponIds: cs.UnPublishedCouponIds,
+				Coupons:              make(map[string]*models.Coupon, len(cs.Coupons)),
+			}
+
+			for _, coupon := range cs.Coupons {
+				campaign.Coupons[coupon.CouponId] = coupon
+				tenant.couponCodes[coupon.CouponId] = cs.CampaignId
+				switch coupon.State {
+				case models.CouponRedeemed:
+					campaign.redeemed++
+				case models.CouponHeld:
+					campaign.holds++
+				}
+				if coupon.Tier != "" {
+					campaign.tierIssued[coupon.Tier]++
+				}
+			}
+
+			for i := range campaign.Tiers {
+				campaign.Tiers[i].index()
+			}
+
+			tenant.campaigns[cs.CampaignId] = campaign
+		}
+	}
+
+	// outbox 가 없는 예전 snapshot 이면 그대로 둠
+	if snapshot.Outbox != nil {
+		v.outbox.restore(snapshot.Outbox)
+	}
+}
@@ -0,0 +1,98 @@
+package cache
+
+import (
+	"sort"
+	"time"
+)
+
+// CampaignStats : metric 수집, 대시보드용 캠페인별 쿠폰 현황
+type CampaignStats struct {
+	TenantId    string
+	CampaignId  string
+	StartDate   time.Time
+	ExpiredDate time.Time
+	MaxCoupons  int64
+	Remaining   int64 // 아직 발급되지 않은 쿠폰 수
+	Issued      int64 // 발급 대기 목록에서 나간 쿠폰 수 (Held, Redeemed 포함)
+	Held        int64 // 결제 대기로 예약된 쿠폰 수
+	Redeemed    int64
+	Failures    int64 // 서버 시작 후 실패한 발급/사용 요청 수
+	Waitlist    int
+	Paused      bool
+	Active      bool // 현재 발급 가능한 기간인지
+}
+
+// Stats : 종료되지 않은 캠페인의 현황을 tenant, campaign id 순으로 정렬해서 반환함
+func (v *CampaignManager) Stats(now time.Time) []CampaignStats {
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	ret := make([]CampaignStats, 0)
+	for tenantId, tenant := range v.tenants {
+		for _, campaign := range tenant.campaigns {
+			if campaign.ExpiredDate.Before(now) {
+				continue
+			}
+			ret = append(ret, campaign.stats(tenantId, now))
+		}
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].TenantId != ret[j].TenantId {
+			return ret[i].TenantId < ret[j].TenantId
+		}
+		return ret[i].CampaignId < ret[j].CampaignId
+	})
+
+	return ret
+}
+
+// TenantStats : tenant 의 종료되지 않은 캠페인 현황, campaignId 가 있으면 종료됐어도 그 캠페인만
+func (v *CampaignManager) TenantStats(tenantId, campaignId string, now time.Time) ([]CampaignStats, error) {
+	if campaignId != "" {
+		_, campaign, exists := v.getCampaign(tenantId, campaignId)
+		if !exists {
+			return nil, ErrCampaignNotExists
+		}
+		return []CampaignStats{campaign.stats(tenantId, now)}, nil
+	}
+
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	ret := make([]CampaignStats, 0)
+	if tenant := v.tenant(tenantId, false); tenant != nil {
+		for _, campaign := range tenant.campaigns {
+			if !campaign.ExpiredDate.Before(now) {
+				ret = append(ret, campaign.stats(tenantId, now))
+			}
+		}
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].CampaignId < ret[j].CampaignId
+	})
+	return ret, nil
+}
+
+func (c *Campaign) stats(tenantId string, now time.Time) CampaignStats {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	remaining := int64(len(c.UnPublishedCouponIds))
+	return CampaignStats{
+		TenantId:    tenantId,
+		CampaignId:  c.CampaignId,
+		StartDate:   c.StartDate,
+		ExpiredDate: c.ExpiredDate,
+		MaxCoupons:  c.MaxCoupons,
+		Remaining:   remaining,
+		Issued:      int64(len(c.Coupons)) - remaining,
+		Held:        int64(c.holds),
+		Redeemed:    c.redeemed,
+		Failures:    c.activity.failureCount(),
+		Waitlist:    len(c.waitlist),
+		Paused:      c.paused,
+		Active:      !now.Before(c.StartDate),
+	}
+}
@@ -0,0 +1,182 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestStats(t *testing.T) {
+	ctx := context.Background()
+	m := NewCampaignManager()
+	newTestCampaign(t, m, "brand", "summer", 5, CampaignOptions{})
+	newTestCampaign(t, m, "brand", "spring", 5, CampaignOptions{})
+	newTestCampaign(t, m, "another", "spring", 1, CampaignOptions{})
+
+	var codes []string
+	for _, userId := range []string{"u1", "u2", "u3"} {
+		coupon, err := m.PublishCoupon(ctx, "brand", "spring", userId, IssueOptions{})
+		if err != nil {
+			t.Fatalf("PublishCoupon(%s): %v", userId, err)
+		}
+		codes = append(codes, coupon.CouponId)
+	}
+	if _, err := m.ReserveCoupon(ctx, "brand", "spring", codes[0], "u1", time.Minute); err != nil {
+		t.Fatalf("ReserveCoupon: %v", err)
+	}
+	if _, err := m.UseCoupon(ctx, "brand", "spring", codes[1], "u2", "", nil); err != nil {
+		t.Fatalf("UseCoupon: %v", err)
+	}
+	// 남의 쿠폰 사용은 실패로 셈
+	if _, err := m.UseCoupon(ctx, "brand", "spring", codes[2], "u1", "", nil); err == nil {
+		t.Fatal("UseCoupon with another user's coupon succeeded")
+	}
+
+	stats := m.Stats(time.Now())
+	var keys []string
+	for _, s := range stats {
+		keys = append(keys, s.TenantId+"/"+s.CampaignId)
+	}
+	if want := []string{"another/spring", "brand/spring", "brand/summer"}; !slices.Equal(keys, want) {
+		t.Fatalf("Stats order = %v, want %v", keys, want)
+	}
+
+	got := stats[1]
+	if got.MaxCoupons != 5 || got.Remaining != 2 || got.Issued != 3 || got.Held != 1 || got.Redeemed != 1 || got.Failures != 1 || !got.Active || got.Paused {
+		t.Fatalf("brand/spring stats = %+v", got)
+	}
+
+	// 종료된 캠페인은 빠지지만 campaignId 로 찾으면 보여줌
+	after := got.ExpiredDate.Add(time.Hour)
+	if stats := m.Stats(after); len(stats) != 0 {
+		t.Fatalf("Stats after expiry = %+v, want none", stats)
+	}
+	if stats, err := m.TenantStats("brand", "", after); err != nil || len(stats) != 0 {
+		t.Fatalf("TenantStats after expiry = %+v, %v", stats, err)
+	}
+	stats, err := m.TenantStats("brand", "spring", after)
+	if err != nil || len(stats) != 1 || stats[0].Redeemed != 1 {
+		t.Fatalf("TenantStats(brand/spring) after expiry = %+v, %v", stats, err)
+	}
+
+	stats, err = m.TenantStats("brand", "", time.Now())
+	if err != nil || len(stats) != 2 || stats[0].CampaignId != "spring" || stats[1].CampaignId != "summer" {
+		t.Fatalf("TenantStats(brand) = %+v, %v", stats, err)
+	}
+	if _, err := m.TenantStats("brand", "winter", time.Now()); err != ErrCampaignNotExists {
+		t.Fatalf("TenantStats(unknown) = %v, want ErrCampaignNotExists", err)
+	}
+}
+
+func TestSetPaused(t *testing.T) {
+	ctx := context.Background()
+	m := NewCampaignManager()
+	var actions []string
+	m.SetAuditor(func(_ context.Context, event AuditEvent) {
+		if event.CampaignId == "spring" && event.Action != AuditCampaignCreated {
+			actions = append(actions, event.Action)
+		}
+	})
+	campaign := newTestCampaign(t, m, "brand", "spring", 1, CampaignOptions{})
+
+	coupon, err := m.PublishCoupon(ctx, "brand", "spring", "u1", IssueOptions{})
+	if err != nil {
+		t.Fatalf("PublishCoupon: %v", err)
+	}
+	var waitlisted *WaitlistError
+	if _, err := m.PublishCoupon(ctx, "brand", "spring", "u2", IssueOptions{JoinWaitlist: true}); !errors.As(err, &waitlisted) {
+		t.Fatalf("PublishCoupon(u2) = %v, want WaitlistError", err)
+	}
+
+	if err := m.SetPaused(ctx, "brand", "spring", true, "stock check"); err != nil {
+		t.Fatalf("SetPaused: %v", err)
+	}
+	// 같은 상태로 다시 바꾸면 감사 이력을 남기지 않음
+	if err := m.SetPaused(ctx, "brand", "spring", true, "again"); err != nil {
+		t.Fatalf("SetPaused again: %v", err)
+	}
+	if _, err := m.PublishCoupon(ctx, "brand", "spring", "u3", IssueOptions{}); err != ErrCampaignPaused {
+		t.Fatalf("PublishCoupon while paused = %v, want ErrCampaignPaused", err)
+	}
+
+	// 멈춘 동안 회수된 쿠폰은 다시 시작할때 대기자에게 발급함
+	if _, err := m.RevokeCoupon(ctx, "brand", "spring", coupon.CouponId, true, "test"); err != nil {
+		t.Fatalf("RevokeCoupon: %v", err)
+	}
+	if code := ownerOf(campaign, "u2"); code != "" {
+		t.Fatalf("waitlist issued %s while paused", code)
+	}
+
+	if err := m.SetPaused(ctx, "brand", "spring", false, ""); err != nil {
+		t.Fatalf("SetPaused resume: %v", err)
+	}
+	if ownerOf(campaign, "u2") == "" {
+		t.Fatal("waitlist not issued after resume")
+	}
+
+	if want := []string{AuditCouponIssued, AuditCampaignPaused, AuditCouponRevoked, AuditCampaignResumed, AuditCouponIssued}; !slices.Equal(actions, want) {
+		t.Fatalf("audit actions = %v, want %v", actions, want)
+	}
+	if err := m.SetPaused(ctx, "brand", "winter", true, ""); err != ErrCampaignNotExists {
+		t.Fatalf("SetPaused(unknown) = %v, want ErrCampaignNotExists", err)
+	}
+}
+
+func TestRecentActivity(t *testing.T) {
+	ctx := context.Background()
+	m := NewCampaignManager()
+	newTestCampaign(t, m, "brand", "spring", recentEventsSize+10, CampaignOptions{})
+
+	coupon, err := m.PublishCoupon(ctx, "brand", "spring", "u0", IssueOptions{})
+	if err != nil {
+		t.Fatalf("PublishCoupon: %v", err)
+	}
+	if _, err := m.UseCoupon(ctx, "brand", "spring", coupon.CouponId, "u0", "", nil); err != nil {
+		t.Fatalf("UseCoupon: %v", err)
+	}
+	if _, err := m.UseCoupon(ctx, "brand", "spring", coupon.CouponId, "u0", "", nil); err == nil {
+		t.Fatal("second UseCoupon succeeded")
+	}
+
+	events, err := m.RecentActivity("brand", "spring")
+	if err != nil {
+		t.Fatalf("RecentActivity: %v", err)
+	}
+	var types []string
+	for _, event := range events {
+		types = append(types, event.Type)
+	}
+	if want := []string{ActivityRedeemFailed, AuditCouponRedeemed, AuditCouponIssued}; !slices.Equal(types, want) {
+		t.Fatalf("recent activity = %v, want %v", types, want)
+	}
+	if events[0].Reason == "" || events[0].CouponCode != coupon.CouponId {
+		t.Fatalf("failed event = %+v", events[0])
+	}
+
+	// 오래된 이벤트부터 밀려남
+	for i := 1; i <= recentEventsSize; i++ {
+		if _, err := m.PublishCoupon(ctx, "brand", "spring", fmt.Sprintf("u%d", i), IssueOptions{}); err != nil {
+			t.Fatalf("PublishCoupon(%d): %v", i, err)
+		}
+	}
+	events, _ = m.RecentActivity("brand", "spring")
+	if len(events) != recentEventsSize {
+		t.Fatalf("len(events) = %d, want %d", len(events), recentEventsSize)
+	}
+	for _, event := range events {
+		if event.Type != AuditCouponIssued {
+			t.Fatalf("old event kept after wrap: %+v", event)
+		}
+	}
+
+	stats, _ := m.TenantStats("brand", "spring", time.Now())
+	if stats[0].Failures != 1 {
+		t.Fatalf("Failures = %d, want 1", stats[0].Failures)
+	}
+	if _, err := m.RecentActivity("brand", "winter"); err != ErrCampaignNotExists {
+		t.Fatalf("RecentActivity(unknown) = %v, want ErrCampaignNotExists", err)
+	}
+}
@@ -0,0 +1,144 @@
+package cache
+
+import (
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// DefaultTenant : 인증정보나 헤더에 tenant 가 없을 때 사용
+const DefaultTenant = "default"
+
+// TenantQuota : 0 이면 제한 없음
+type TenantQuota struct {
+	MaxActiveCampaigns int     // 종료되지 않은 캠페인 수
+	MaxTotalCoupons    int64   // 종료되지 않은 캠페인들의 쿠폰 수 합계
+	IssueRate          float64 // 초당 쿠폰 발급 요청 수
+	IssueBurst         int     // 0 이면 IssueRate 만큼
+}
+
+// Tenant : 브랜드별로 캠페인 ID, 쿠폰 코드, 발급 속도 제한을 따로 관리함
+// campaigns, couponCodes 는 CampaignManager.mutex 로 보호됨
+type Tenant struct {
+	TenantId    string
+	campaigns   map[string]*Campaign
+	couponCodes map[string]string // coupon code -> campaign id : tenant 내에서 쿠폰 코드 중복 방지용
+	quota       TenantQuota
+	limiter     *rate.Limiter
+}
+
+func newTenant(id string, quota TenantQuota) *Tenant {
+	t := &Tenant{
+		TenantId:    id,
+		campaigns:   make(map[string]*Campaign),
+		couponCodes: make(map[string]string),
+	}
+	t.setQuota(quota)
+	return t
+}
+
+func (t *Tenant) setQuota(quota TenantQuota) {
+	t.quota = quota
+	t.limiter = nil
+
+	if quota.IssueRate > 0 {
+		burst := quota.IssueBurst
+		if burst <= 0 {
+			burst = int(quota.IssueRate)
+		}
+		if burst < 1 {
+			burst = 1
+		}
+		t.limiter = rate.NewLimiter(rate.Limit(quota.IssueRate), burst)
+	}
+}
+
+// checkQuota : 새 캠페인(maxCoupon 개)을 추가해도 quota 안에 있는지 확인
+func (t *Tenant) checkQuota(maxCoupon int64, now time.Time) error {
+	activeCampaigns, _ := t.activeUsage(now)
+	if t.quota.MaxActiveCampaigns > 0 && activeCampaigns+1 > t.quota.MaxActiveCampaigns {
+		return ErrTenantCampaignQuota
+	}
+
+	return t.checkCouponQuota(maxCoupon, now)
+}
+
+// checkCouponQuota : 쿠폰 added 개를 추가해도 quota 안에 있는지 확인 (캠페인 쿠폰 수를 늘리는 경우)
+func (t *Tenant) checkCouponQuota(added int64, now time.Time) error {
+	_, totalCoupons := t.activeUsage(now)
+	if t.quota.MaxTotalCoupons > 0 && totalCoupons+added > t.quota.MaxTotalCoupons {
+		return ErrTenantCouponQuota
+	}
+	return nil
+}
+
+// activeUsage : 종료되지 않은 캠페인 수, 쿠폰 수 합계
+func (t *Tenant) activeUsage(now time.Time) (campaigns int, coupons int64) {
+	for _, campaign := range t.campaigns {
+		if campaign.ExpiredDate.Before(now) {
+			continue
+		}
+		campaigns++
+		coupons += campaign.MaxCoupons
+	}
+	return campaigns, coupons
+}
+
+// allowIssue : 발급 요청 속도 제한
+func (t *Tenant) allowIssue() bool {
+	return t.limiter == nil || t.limiter.Allow()
+}
+
+// SetDefaultQuota : 개별 quota 가 지정되지 않은 tenant 에 적용
+func (v *CampaignManager) SetDefaultQuota(quota TenantQuota) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	v.defaultQuota = quota
+	for id, t := range v.tenants {
+		if _, custom := v.quotas[id]; !custom {
+			t.setQuota(quota)
+		}
+	}
+}
+
+func (v *CampaignManager) SetTenantQuota(tenantId string, quota TenantQuota) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	v.quotas[tenantId] = quota
+	if t, exists := v.tenants[tenantId]; exists {
+		t.setQuota(quota)
+	}
+}
+
+// tenant : v.mutex 를 잡은 상태에서 호출, create 가 false 면 없는 tenant 는 nil
+func (v *CampaignManager) tenant(tenantId string, create bool) *Tenant {
+	t, exists := v.tenants[tenantId]
+	if exists || !create {
+		return t
+	}
+
+	quota, custom := v.quotas[tenantId]
+	if !custom {
+		quota = v.defaultQuota
+	}
+
+	t = newTenant(tenantId, quota)
+	v.tenants[tenantId] = t
+	return t
+}
+
+// getCampaign : tenant 범위 안에서 캠페인 조회
+func (v *CampaignManager) getCampaign(tenantId, campaignId string) (*Tenant, *Campaign, bool) {
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	t := v.tenant(tenantId, false)
+	if t == nil {
+		return nil, nil, false
+	}
+
+	campaign, exists := t.campaigns[campaignId]
+	return t, campaign, exists
+}
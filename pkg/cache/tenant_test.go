@@ -0,0 +1,119 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestTenantIsolation : 같은 캠페인 ID, 쿠폰 코드를 써도 tenant 끼리 영향을 주지 않아야 함
+func TestTenantIsolation(t *testing.T) {
+	ctx := context.Background()
+	m := NewCampaignManager()
+	newTestCampaign(t, m, "brandA", "spring", 1, CampaignOptions{})
+	b := newTestCampaign(t, m, "brandB", "spring", 2, CampaignOptions{})
+
+	coupon, err := m.PublishCoupon(ctx, "brandA", "spring", "u1", IssueOptions{})
+	if err != nil {
+		t.Fatalf("PublishCoupon(brandA): %v", err)
+	}
+	if _, err := m.PublishCoupon(ctx, "brandA", "spring", "u2", IssueOptions{}); !errors.Is(err, ErrNoMoreCoupon) {
+		t.Errorf("PublishCoupon(brandA) after sold out: err = %v, want %v", err, ErrNoMoreCoupon)
+	}
+	if len(b.UnPublishedCouponIds) != 2 {
+		t.Errorf("brandB has %d unpublished coupons, want 2", len(b.UnPublishedCouponIds))
+	}
+
+	// 다른 tenant 의 쿠폰 코드로는 사용할 수 없음
+	if _, err := m.UseCoupon(ctx, "brandB", "spring", coupon.CouponId, "u1", "", nil); !errors.Is(err, ErrCouponNotExists) {
+		t.Errorf("UseCoupon(brandB, brandA code): err = %v, want %v", err, ErrCouponNotExists)
+	}
+	if _, err := m.GetCampaignInfo("brandC", "spring"); !errors.Is(err, ErrCampaignNotExists) {
+		t.Errorf("GetCampaignInfo(brandC): err = %v, want %v", err, ErrCampaignNotExists)
+	}
+
+	// 같은 외부 코드는 tenant 마다 따로 가져올 수 있음
+	for _, tenantId := range []string{"brandA", "brandB"} {
+		result, err := m.ImportCoupons(ctx, tenantId, "spring", importRows("GIFT-0001"), nil, false)
+		if err != nil || result.Imported != 1 {
+			t.Errorf("ImportCoupons(%s): imported %v, err %v", tenantId, result, err)
+		}
+	}
+
+	if err := m.DeleteCampaign(ctx, "brandB", "spring", true); err != nil {
+		t.Fatalf("DeleteCampaign(brandB): %v", err)
+	}
+	if got := len(m.ListCampaigns("brandA")); got != 1 {
+		t.Errorf("brandA has %d campaigns after deleting brandB's, want 1", got)
+	}
+	if got := len(m.ListCampaigns("brandB")); got != 0 {
+		t.Errorf("brandB has %d campaigns, want 0", got)
+	}
+}
+
+func TestTenantQuota(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		quota     TenantQuota
+		expired   bool  // 기존 캠페인이 이미 종료됨
+		maxCoupon int64 // 두번째 캠페인 쿠폰 수
+		err       error
+	}{
+		{name: "no quota", maxCoupon: 100},
+		{name: "campaign quota", quota: TenantQuota{MaxActiveCampaigns: 1}, maxCoupon: 1, err: ErrTenantCampaignQuota},
+		{name: "expired campaign is not counted", quota: TenantQuota{MaxActiveCampaigns: 1}, expired: true, maxCoupon: 1},
+		{name: "coupon quota", quota: TenantQuota{MaxTotalCoupons: 10}, maxCoupon: 6, err: ErrTenantCouponQuota},
+		{name: "coupon quota exact", quota: TenantQuota{MaxTotalCoupons: 10}, maxCoupon: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			m := NewCampaignManager()
+			m.SetTenantQuota("brand", tt.quota)
+
+			end := now.Add(time.Hour)
+			if tt.expired {
+				end = now.Add(-time.Minute)
+			}
+			if err := m.CreateCampaign(ctx, "brand", "first", now.Add(-time.Hour), end, 5, CampaignOptions{}); err != nil {
+				t.Fatalf("CreateCampaign(first): %v", err)
+			}
+
+			err := m.CreateCampaign(ctx, "brand", "second", now, now.Add(time.Hour), tt.maxCoupon, CampaignOptions{})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("CreateCampaign(second): err = %v, want %v", err, tt.err)
+			}
+
+			// quota 는 tenant 별로 적용됨
+			if err := m.CreateCampaign(ctx, "other", "second", now, now.Add(time.Hour), tt.maxCoupon, CampaignOptions{}); err != nil {
+				t.Errorf("CreateCampaign(other): %v", err)
+			}
+		})
+	}
+}
+
+func TestTenantIssueRate(t *testing.T) {
+	ctx := context.Background()
+	m := NewCampaignManager()
+	m.SetDefaultQuota(TenantQuota{IssueRate: 0.001, IssueBurst: 2})
+	m.SetTenantQuota("vip", TenantQuota{})
+	newTestCampaign(t, m, "brand", "spring", 10, CampaignOptions{})
+	newTestCampaign(t, m, "vip", "spring", 10, CampaignOptions{})
+
+	for i, want := range []error{nil, nil, ErrTenantRateLimited} {
+		if _, err := m.PublishCoupon(ctx, "brand", "spring", "u1", IssueOptions{}); !errors.Is(err, want) {
+			t.Errorf("PublishCoupon #%d: err = %v, want %v", i+1, err, want)
+		}
+	}
+
+	// 개별 quota 가 있는 tenant 는 기본 quota 의 제한을 받지 않음
+	for i := 0; i < 3; i++ {
+		if _, err := m.PublishCoupon(ctx, "vip", "spring", "u1", IssueOptions{}); err != nil {
+			t.Errorf("PublishCoupon(vip) #%d: %v", i+1, err)
+		}
+	}
+}
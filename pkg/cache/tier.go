@@ -0,0 +1,185 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Tier : 캠페인 안의 우선 발급 그룹 (VIP 등)
+// 멤버는 StartAt 부터 예약 수량(Quota) 에서 먼저 발급받고, 예약 수량이 다 나가면 일반 발급과 같은 조건으로 발급받음
+// 멤버는 인증 토큰의 tiers claim 에 Name 이 있거나 UserIds 에 있는 사용자
+type Tier struct {
+	Name      string    `json:"name"`
+	StartAt   time.Time `json:"startAt"`             // 캠페인 시작 전이면 먼저 발급 (early access)
+	Quota     int64     `json:"quota"`               // MaxCoupons 중 이 tier 에 예약하는 수량
+	ReleaseAt time.Time `json:"releaseAt,omitempty"` // 남은 예약 수량이 일반 발급으로 돌아가는 시각, zero 면 캠페인 종료까지 예약
+	UserIds   []string  `json:"userIds,omitempty"`
+
+	members map[string]struct{}
+}
+
+// ValidateTiers : 캠페인 생성 시점에 확인, UserIds 로 멤버 조회용 index 를 만듦
+func ValidateTiers(tiers []Tier, end time.Time, maxCoupon int64) error {
+	total := int64(0)
+	names := make(map[string]struct{}, len(tiers))
+
+	for i := range tiers {
+		tier := &tiers[i]
+		invalid := func(format string, args ...any) error {
+			return fmt.Errorf("%w: tiers[%d]: %s", ErrInvalidTier, i, fmt.Sprintf(format, args...))
+		}
+
+		if tier.Name == "" {
+			return invalid("name is required")
+		}
+		if _, exists := names[tier.Name]; exists {
+			return invalid("duplicate tier name %q", tier.Name)
+		}
+		names[tier.Name] = struct{}{}
+
+		if tier.Quota <= 0 {
+			return invalid("quota must be positive")
+		}
+		if tier.StartAt.IsZero() || tier.StartAt.After(end) {
+			return invalid("startAt must be before the campaign ends")
+		}
+		if !tier.ReleaseAt.IsZero() && (!tier.ReleaseAt.After(tier.StartAt) || tier.ReleaseAt.After(end)) {
+			return invalid("releaseAt must be after startAt and before the campaign ends")
+		}
+		total += tier.Quota
+
+		tier.index()
+	}
+
+	if total > maxCoupon {
+		return fmt.Errorf("%w: total tier quota %d exceeds maxCoupon %d", ErrInvalidTier, total, maxCoupon)
+	}
+	return nil
+}
+
+func (t *Tier) index() {
+	t.members = make(map[string]struct{}, len(t.UserIds))
+	for _, userId := range t.UserIds {
+		t.members[userId] = struct{}{}
+	}
+}
+
+func (t *Tier) isMember(userId string, claimed []string) bool {
+	if slices.Contains(claimed, t.Name) {
+		return true
+	}
+	_, exists := t.members[userId]
+	return userId != "" && exists
+}
+
+func (t *Tier) released(now time.Time) bool {
+	return !t.ReleaseAt.IsZero() && !now.Before(t.ReleaseAt)
+}
+
+// reservedRemaining : tier 에 아직 예약되어 있는 수량, 캠페인 lock 을 잡은 상태에서 호출
+func (c *Campaign) reservedRemaining(tier *Tier, now time.Time) int64 {
+	if tier.released(now) {
+		return 0
+	}
+	return max(tier.Quota-c.tierIssued[tier.Name], 0)
+}
+
+// reserved : 모든 tier 에 예약되어 있어서 일반 발급할 수 없는 수량
+func (c *Campaign) reserved(now time.Time) int64 {
+	total := int64(0)
+	for i := range c.Tiers {
+		total += c.reservedRemaining(&c.Tiers[i], now)
+	}
+	return total
+}
+
+// nextTierRelease : now 이후 예약 수량이 일반 발급으로 돌아가는 가장 빠른 시각, 없으면 zero
+func (c *Campaign) nextTierRelease(now time.Time) time.Time {
+	var next time.Time
+	for i := range c.Tiers {
+		tier := &c.Tiers[i]
+		if tier.released(now) || tier.ReleaseAt.IsZero() || c.reservedRemaining(tier, now) == 0 {
+			continue
+		}
+		if next.IsZero() || tier.ReleaseAt.Before(next) {
+			next = tier.ReleaseAt
+		}
+	}
+	return next
+}
+
+// exhausted : 일반 발급할 쿠폰이 남아있지 않음 (tier 예약 수량은 제외)
+func (c *Campaign) exhausted(now time.Time) bool {
+	return int64(len(c.UnPublishedCouponIds)) <= c.reserved(now)
+}
+
+// memberTier : 지금 예약 수량에서 발급받을 수 있는 tier
+// 없으면 예약 수량이 남았지만 아직 시작하지 않은 tier 중 가장 빠른 시작 시각을 같이 반환함
+func (c *Campaign) memberTier(userId string, claimed []string, now time.Time) (*Tier, time.Time) {
+	var startsAt time.Time
+	for i := range c.Tiers {
+		tier := &c.Tiers[i]
+		if !tier.isMember(userId, claimed) || c.reservedRemaining(tier, now) == 0 {
+			continue
+		}
+		if !now.Before(tier.StartAt) {
+			return tier, time.Time{}
+		}
+		if startsAt.IsZero() || tier.StartAt.Before(startsAt) {
+			startsAt = tier.StartAt
+		}
+	}
+	return nil, startsAt
+}
+
+// ReleaseTierQuotas : releaseAt 이 지난 tier 의 남은 예약 수량을 일반 발급으로 돌리고 대기자에게 발급함
+// 예약 수량 계산은 시각으로 바로 바뀌고, 여기서는 tier 마다 한번씩 감사 이력을 남기고 대기자 발급을 함
+func (v *CampaignManager) ReleaseTierQuotas(now time.Time) int {
+	v.commitMutex.RLock()
+	defer v.commitMutex.RUnlock()
+
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	ctx := context.Background()
+	released := 0
+
+	for tenantId, tenant := range v.tenants {
+		for _, campaign := range tenant.campaigns {
+			if len(campaign.Tiers) == 0 {
+				continue
+			}
+
+			campaign.mutex.Lock()
+			before := released
+			for i := range campaign.Tiers {
+				tier := &campaign.Tiers[i]
+				if !tier.released(now) || slices.Contains(campaign.releasedTiers, tier.Name) {
+					continue
+				}
+
+				campaign.releasedTiers = append(campaign.releasedTiers, tier.Name)
+				v.audit(ctx, AuditEvent{
+					Action:     AuditTierReleased,
+					TenantId:   tenantId,
+					CampaignId: campaign.CampaignId,
+					After: map[string]any{
+						"tier":     tier.Name,
+						"quota":    tier.Quota,
+						"issued":   campaign.tierIssued[tier.Name],
+						"returned": max(tier.Quota-campaign.tierIssued[tier.Name], 0),
+					},
+				})
+				released++
+			}
+			if released > before {
+				v.backfill(ctx, tenantId, campaign, now)
+			}
+			campaign.mutex.Unlock()
+		}
+	}
+
+	return released
+}
@@ -0,0 +1,147 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestValidateTiers(t *testing.T) {
+	start := time.Date(2025, 5, 12, 9, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	tests := []struct {
+		name  string
+		tiers []Tier
+		ok    bool
+	}{
+		{name: "valid", tiers: []Tier{{Name: "vip", StartAt: start.Add(-time.Hour), Quota: 5, ReleaseAt: start.Add(time.Hour)}, {Name: "gold", StartAt: start, Quota: 5}}, ok: true},
+		{name: "missing name", tiers: []Tier{{StartAt: start, Quota: 1}}},
+		{name: "duplicate name", tiers: []Tier{{Name: "vip", StartAt: start, Quota: 1}, {Name: "vip", StartAt: start, Quota: 1}}},
+		{name: "zero quota", tiers: []Tier{{Name: "vip", StartAt: start}}},
+		{name: "missing startAt", tiers: []Tier{{Name: "vip", Quota: 1}}},
+		{name: "starts after end", tiers: []Tier{{Name: "vip", StartAt: end.Add(time.Second), Quota: 1}}},
+		{name: "release before start", tiers: []Tier{{Name: "vip", StartAt: start, Quota: 1, ReleaseAt: start}}},
+		{name: "release after end", tiers: []Tier{{Name: "vip", StartAt: start, Quota: 1, ReleaseAt: end.Add(time.Second)}}},
+		{name: "quota over maxCoupon", tiers: []Tier{{Name: "vip", StartAt: start, Quota: 6}, {Name: "gold", StartAt: start, Quota: 5}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTiers(tt.tiers, end, 10)
+			if tt.ok && err != nil {
+				t.Fatalf("ValidateTiers: %v", err)
+			}
+			if !tt.ok && !errors.Is(err, ErrInvalidTier) {
+				t.Fatalf("err = %v, want %v", err, ErrInvalidTier)
+			}
+		})
+	}
+}
+
+func TestTierIssue(t *testing.T) {
+	ctx := context.Background()
+	m := NewCampaignManager()
+	tiers := []Tier{{Name: "vip", StartAt: time.Now().Add(-30 * time.Second), Quota: 2, UserIds: []string{"v1"}}}
+	campaign := newTestCampaign(t, m, "brand", "spring", 3, CampaignOptions{Tiers: tiers})
+
+	tests := []struct {
+		name   string
+		userId string
+		tiers  []string // 토큰의 tiers claim
+		tier   string   // 발급된 쿠폰의 tier
+		err    error
+	}{
+		{name: "general", userId: "u1"},
+		{name: "rest is reserved", userId: "u2", err: ErrNoMoreCoupon},
+		{name: "member by userIds", userId: "v1", tier: "vip"},
+		{name: "member by claim", userId: "u3", tiers: []string{"vip"}, tier: "vip"},
+		{name: "reserved quota used up", userId: "u4", tiers: []string{"vip"}, err: ErrNoMoreCoupon},
+	}
+
+	var vipCoupon string
+	for _, tt := range tests {
+		coupon, err := m.PublishCoupon(ctx, "brand", "spring", tt.userId, IssueOptions{Tiers: tt.tiers})
+		if !errors.Is(err, tt.err) {
+			t.Fatalf("%s: err = %v, want %v", tt.name, err, tt.err)
+		}
+		if err == nil && coupon.Tier != tt.tier {
+			t.Errorf("%s: coupon tier %q, want %q", tt.name, coupon.Tier, tt.tier)
+		}
+		if tt.tier != "" {
+			vipCoupon = coupon.CouponId
+		}
+	}
+
+	// 회수해서 발급 대기 목록으로 돌아온 tier 쿠폰은 다시 예약 수량이 됨
+	if _, err := m.RevokeCoupon(ctx, "brand", "spring", vipCoupon, true, "duplicate account"); err != nil {
+		t.Fatalf("RevokeCoupon: %v", err)
+	}
+	if got := campaign.tierIssued["vip"]; got != 1 {
+		t.Errorf("tierIssued = %d, want 1", got)
+	}
+	if _, err := m.PublishCoupon(ctx, "brand", "spring", "u2", IssueOptions{}); !errors.Is(err, ErrNoMoreCoupon) {
+		t.Errorf("general after revoke: err = %v, want %v", err, ErrNoMoreCoupon)
+	}
+	if coupon, err := m.PublishCoupon(ctx, "brand", "spring", "u4", IssueOptions{Tiers: []string{"vip"}}); err != nil || coupon.Tier != "vip" {
+		t.Errorf("member after revoke: %+v, %v", coupon, err)
+	}
+}
+
+// TestTierEarlyAccess : tier 멤버는 캠페인 시작 전에도 tier 시작 시각부터 발급받음
+func TestTierEarlyAccess(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	m := NewCampaignManager()
+	start := now.Add(time.Hour)
+	tiers := []Tier{{Name: "vip", StartAt: now.Add(-time.Minute), Quota: 1}}
+	if err := m.CreateCampaign(ctx, "brand", "spring", start, start.Add(time.Hour), 2, CampaignOptions{Tiers: tiers}); err != nil {
+		t.Fatalf("CreateCampaign: %v", err)
+	}
+
+	_, err := m.PublishCoupon(ctx, "brand", "spring", "u1", IssueOptions{})
+	var scheduled *ScheduleError
+	if !errors.As(err, &scheduled) || !errors.Is(err, ErrCampaignNotValidTime) || !scheduled.NextRelease.Equal(start) {
+		t.Errorf("general before start: err = %v, want next release at start", err)
+	}
+	if coupon, err := m.PublishCoupon(ctx, "brand", "spring", "v1", IssueOptions{Tiers: []string{"vip"}}); err != nil || coupon.Tier != "vip" {
+		t.Errorf("member before start: %+v, %v", coupon, err)
+	}
+	// 예약 수량이 다 나가면 멤버도 일반 발급 시작을 기다림
+	if _, err := m.PublishCoupon(ctx, "brand", "spring", "v2", IssueOptions{Tiers: []string{"vip"}}); !errors.Is(err, ErrCampaignNotValidTime) {
+		t.Errorf("member after quota: err = %v, want %v", err, ErrCampaignNotValidTime)
+	}
+}
+
+// TestReleaseTierQuotas : releaseAt 이 지나면 남은 예약 수량을 대기자에게 발급함
+func TestReleaseTierQuotas(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	m := NewCampaignManager()
+	releaseAt := now.Add(time.Hour)
+	tiers := []Tier{{Name: "vip", StartAt: now.Add(-30 * time.Second), Quota: 1, ReleaseAt: releaseAt}}
+	campaign := newTestCampaign(t, m, "brand", "spring", 1, CampaignOptions{Tiers: tiers})
+
+	_, err := m.PublishCoupon(ctx, "brand", "spring", "u1", IssueOptions{JoinWaitlist: true})
+	var waitlisted *WaitlistError
+	if !errors.As(err, &waitlisted) {
+		t.Fatalf("PublishCoupon before release: err = %v, want waitlisted", err)
+	}
+	if next := campaign.nextRelease(now); !next.Equal(releaseAt) {
+		t.Errorf("nextRelease = %s, want %s", next, releaseAt)
+	}
+
+	if n := m.ReleaseTierQuotas(now); n != 0 {
+		t.Fatalf("ReleaseTierQuotas before releaseAt = %d", n)
+	}
+	if n := m.ReleaseTierQuotas(releaseAt); n != 1 {
+		t.Fatalf("ReleaseTierQuotas = %d, want 1", n)
+	}
+	if n := m.ReleaseTierQuotas(releaseAt.Add(time.Minute)); n != 0 {
+		t.Errorf("ReleaseTierQuotas again = %d, want 0", n)
+	}
+	if ownerOf(campaign, "u1") == "" || len(campaign.waitlist) != 0 {
+		t.Errorf("waitlisted user did not get the released coupon")
+	}
+}
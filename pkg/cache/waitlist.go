@@ -0,0 +1,229 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/models"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/tracing"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// WaitlistEntry : 쿠폰이 다시 생기면 순서대로 발급받을 사용자
+// 선착순 캠페인은 소진된 뒤 IssueCoupon(joinWaitlist) 로 들어오고, 추첨 캠페인은 당첨되지 않은 응모자가 추첨 순위대로 들어옴
+type WaitlistEntry struct {
+	UserId   string    `json:"userId"`
+	JoinedAt time.Time `json:"joinedAt"`
+}
+
+// WaitlistError : 쿠폰이 소진되어 대기자로 등록된 경우, errors.Is(err, ErrNoMoreCoupon) 도 true
+type WaitlistError struct {
+	Position int // 1 부터
+}
+
+func (e *WaitlistError) Error() string {
+	return fmt.Sprintf("%s (waitlisted at position %d)", ErrNoMoreCoupon, e.Position)
+}
+
+func (e *WaitlistError) Unwrap() error {
+	return ErrNoMoreCoupon
+}
+
+// WaitlistStatus : 대기 순서 조회 결과
+type WaitlistStatus struct {
+	Position int // 1 부터
+	Size     int
+	JoinedAt time.Time
+}
+
+// waitlistIndex : 없으면 -1
+func (c *Campaign) waitlistIndex(userId string) int {
+	return slices.IndexFunc(c.waitlist, func(entry WaitlistEntry) bool {
+		return entry.UserId == userId
+	})
+}
+
+// joinWaitlist : 캠페인 lock 을 잡은 상태에서 호출, 이미 대기중이면 순서를 바꾸지 않음
+func (c *Campaign) joinWaitlist(userId string, now time.Time) int {
+	if idx := c.waitlistIndex(userId); idx >= 0 {
+		return idx + 1
+	}
+	c.waitlist = append(c.waitlist, WaitlistEntry{UserId: userId, JoinedAt: now})
+	return len(c.waitlist)
+}
+
+// GetWaitlistStatus : 대기중이 아니면 ErrNotWaitlisted
+func (v *CampaignManager) GetWaitlistStatus(tenantId, campaignId, userId string) (*WaitlistStatus, error) {
+	_, campaign, exists := v.getCampaign(tenantId, campaignId)
+	if !exists {
+		return nil, ErrCampaignNotExists
+	}
+
+	campaign.mutex.RLock()
+	defer campaign.mutex.RUnlock()
+
+	idx := campaign.waitlistIndex(userId)
+	if idx < 0 {
+		return nil, ErrNotWaitlisted
+	}
+	return &WaitlistStatus{Position: idx + 1, Size: len(campaign.waitlist), JoinedAt: campaign.waitlist[idx].JoinedAt}, nil
+}
+
+// LeaveWaitlist : 대기를 취소함, 뒤의 대기자 순서가 하나씩 당겨짐
+func (v *CampaignManager) LeaveWaitlist(ctx context.Context, tenantId, campaignId, userId string) (err error) {
+	ctx, span := tracing.Start(ctx, "CampaignManager.LeaveWaitlist")
+	span.SetAttributes(attribute.String("tenant.id", tenantId), attribute.String("campaign.id", campaignId))
+	defer func() { tracing.End(span, err) }()
+
+	v.commitMutex.RLock()
+	defer v.commitMutex.RUnlock()
+
+	_, campaign, exists := v.getCampaign(tenantId, campaignId)
+	if !exists {
+		return ErrCampaignNotExists
+	}
+
+	v.lockCampaign(ctx, campaign, "waitlist")
+	defer campaign.mutex.Unlock()
+
+	idx := campaign.waitlistIndex(userId)
+	if idx < 0 {
+		return ErrNotWaitlisted
+	}
+	campaign.waitlist = slices.Delete(campaign.waitlist, idx, idx+1)
+
+	return nil
+}
+
+// backfill : 발급 대기 목록의 쿠폰을 대기자 순서대로 발급함, 캠페인 lock 을 잡은 상태에서 호출
+// 쿠폰이 발급 대기 목록으로 돌아오거나(회수, 추첨 쿠폰 미사용) 캠페인 쿠폰 수를 늘린 경우, 추첨 직후에 호출됨
+// 대기자에게 발급할때는 waitlist.fulfilled 이벤트를 coupon.issued 와 같이 남김
+func (v *CampaignManager) backfill(ctx context.Context, tenantId string, campaign *Campaign, now time.Time) int {
+	if campaign.paused {
+		return 0 // 발급을 다시 시작할때 발급함
+	}
+
+	draw := campaign.draw
+	if draw != nil && draw.drawnAt.IsZero() {
+		return 0 // 추첨 전에는 추첨할때 같이 발급함
+	}
+
+	issued := 0
+	for len(campaign.waitlist) > 0 {
+		// 발급 일정(wave, 시간대, rate) 과 기간도 일반 발급과 똑같이 확인함
+		if err := campaign.checkSchedule(now); err != nil {
+			break
+		}
+
+		entry := campaign.waitlist[0]
+		campaign.waitlist = campaign.waitlist[1:]
+
+		lastIdx := len(campaign.UnPublishedCouponIds) - 1
+		couponId := campaign.UnPublishedCouponIds[lastIdx]
+		campaign.UnPublishedCouponIds = campaign.UnPublishedCouponIds[:lastIdx]
+
+		extra := map[string]any{"waitlist": true}
+		rank, isBackfill := 0, false
+		if draw != nil {
+			rank, isBackfill = slices.Index(draw.ranking, entry.UserId)+1, draw.drawnAt.Before(now)
+			extra = map[string]any{"draw": true, "rank": rank, "backfill": isBackfill}
+		}
+
+		err := v.transition(ctx, campaign, campaign.Coupons[couponId], models.CouponIssued, couponChange{
+			action:   AuditCouponIssued,
+			tenantId: tenantId,
+			userId:   entry.UserId,
+			extra:    extra,
+		})
+		if err != nil {
+			// 발급 대기 목록에는 available 쿠폰만 있어서 여기로 오지 않음
+			slog.Error("failed to issue coupon to waitlist", "campaignId", campaign.CampaignId, "error", err)
+			continue
+		}
+		campaign.recordIssue(now)
+
+		event := Event{Type: EventCouponIssued, Time: now, TenantId: tenantId, CampaignId: campaign.CampaignId, CouponCode: couponId, UserId: entry.UserId}
+		v.outbox.enqueue(event)
+		if draw != nil {
+			draw.winners = append(draw.winners, Winner{UserId: entry.UserId, CouponCode: couponId, Rank: rank, IssuedAt: now, Backfill: isBackfill})
+		}
+		// 추첨 직후 당첨자는 대기했던 것이 아니라서 제외함
+		if draw == nil || isBackfill {
+			event.Type = EventWaitlistFulfilled
+			v.outbox.enqueue(event)
+		}
+		issued++
+	}
+
+	if issued > 0 && len(campaign.UnPublishedCouponIds) == 0 {
+		v.outbox.enqueue(Event{Type: EventCampaignExhausted, Time: now, TenantId: tenantId, CampaignId: campaign.CampaignId})
+	}
+	return issued
+}
+
+// RaiseMaxCoupons : 캠페인 쿠폰 수를 늘림, 늘어난 쿠폰은 대기자에게 먼저 발급됨
+func (v *CampaignManager) RaiseMaxCoupons(ctx context.Context, tenantId, campaignId string, maxCoupon int64) (err error) {
+	ctx, span := tracing.Start(ctx, "CampaignManager.RaiseMaxCoupons")
+	span.SetAttributes(attribute.String("tenant.id", tenantId), attribute.String("campaign.id", campaignId), attribute.Int64("campaign.max_coupons", maxCoupon))
+	defer func() { tracing.End(span, err) }()
+
+	v.commitMutex.RLock()
+	defer v.commitMutex.RUnlock()
+
+	// 쿠폰 코드 중복 확인, tenant quota 계산 때문에 tenant 전체 lock 을 잡음
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	tenant := v.tenant(tenantId, false)
+	if tenant == nil {
+		return ErrCampaignNotExists
+	}
+	campaign, exists := tenant.campaigns[campaignId]
+	if !exists {
+		return ErrCampaignNotExists
+	}
+
+	v.lockCampaign(ctx, campaign, "raise")
+	defer campaign.mutex.Unlock()
+
+	now := time.Now()
+	if maxCoupon < campaign.MaxCoupons {
+		return fmt.Errorf("%w: maxCoupon can only be raised (current %d)", ErrInvalidMaxCoupon, campaign.MaxCoupons)
+	}
+	if now.After(campaign.ExpiredDate) {
+		return ErrCampaignNotValidTime
+	}
+
+	added := maxCoupon - campaign.MaxCoupons
+	if added == 0 {
+		return nil
+	}
+	if err := tenant.checkCouponQuota(added, now); err != nil {
+		return err
+	}
+
+	before := campaignAuditValues(campaign)
+	if err := generateCoupons(ctx, tenant, campaign, added); err != nil {
+		return err
+	}
+	campaign.MaxCoupons = maxCoupon
+	// 새 쿠폰은 다시 만료 처리 대상이 됨
+	campaign.couponsExpired = false
+
+	v.audit(ctx, AuditEvent{
+		Action:     AuditCampaignUpdated,
+		TenantId:   tenantId,
+		CampaignId: campaignId,
+		Before:     before,
+		After:      campaignAuditValues(campaign),
+	})
+
+	if issued := v.backfill(ctx, tenantId, campaign, now); issued > 0 {
+		slog.InfoContext(ctx, "issued raised coupons to waitlist", "count", issued)
+	}
+	return nil
+}
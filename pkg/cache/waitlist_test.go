@@ -0,0 +1,146 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/models"
+)
+
+// ownerOf : 사용자에게 발급된 쿠폰 코드, 없으면 ""
+func ownerOf(campaign *Campaign, userId string) string {
+	for code, coupon := range campaign.Coupons {
+		if coupon.UserId == userId && coupon.State == models.CouponIssued {
+			return code
+		}
+	}
+	return ""
+}
+
+func TestWaitlistJoinAndLeave(t *testing.T) {
+	ctx := context.Background()
+	m := NewCampaignManager()
+	newTestCampaign(t, m, "brand", "spring", 1, CampaignOptions{})
+	if _, err := m.PublishCoupon(ctx, "brand", "spring", "u1", IssueOptions{}); err != nil {
+		t.Fatalf("PublishCoupon: %v", err)
+	}
+
+	tests := []struct {
+		userId   string
+		join     bool
+		position int // 0 이면 대기자로 등록되지 않음
+	}{
+		{userId: "u2", join: false},
+		{userId: "u2", join: true, position: 1},
+		{userId: "u3", join: true, position: 2},
+		{userId: "u2", join: true, position: 1}, // 다시 요청해도 순서 유지
+		{userId: "u4", join: true, position: 3},
+	}
+	for _, tt := range tests {
+		_, err := m.PublishCoupon(ctx, "brand", "spring", tt.userId, IssueOptions{JoinWaitlist: tt.join})
+		if !errors.Is(err, ErrNoMoreCoupon) {
+			t.Fatalf("PublishCoupon(%s): err = %v, want %v", tt.userId, err, ErrNoMoreCoupon)
+		}
+		var waitlisted *WaitlistError
+		if got := errors.As(err, &waitlisted); got != (tt.position > 0) || (got && waitlisted.Position != tt.position) {
+			t.Errorf("PublishCoupon(%s, join %v): err = %v, want position %d", tt.userId, tt.join, err, tt.position)
+		}
+	}
+
+	if err := m.LeaveWaitlist(ctx, "brand", "spring", "u3"); err != nil {
+		t.Fatalf("LeaveWaitlist: %v", err)
+	}
+	if err := m.LeaveWaitlist(ctx, "brand", "spring", "u3"); !errors.Is(err, ErrNotWaitlisted) {
+		t.Errorf("LeaveWaitlist again: err = %v, want %v", err, ErrNotWaitlisted)
+	}
+
+	status, err := m.GetWaitlistStatus("brand", "spring", "u4")
+	if err != nil || status.Position != 2 || status.Size != 2 {
+		t.Errorf("GetWaitlistStatus(u4) = %+v, %v, want position 2 of 2", status, err)
+	}
+	if _, err := m.GetWaitlistStatus("brand", "spring", "u1"); !errors.Is(err, ErrNotWaitlisted) {
+		t.Errorf("GetWaitlistStatus(u1): err = %v, want %v", err, ErrNotWaitlisted)
+	}
+}
+
+func TestWaitlistBackfill(t *testing.T) {
+	ctx := context.Background()
+	m := NewCampaignManager()
+	m.RegisterWebhook("brand", "https://example.com/hook", "s", []string{EventWaitlistFulfilled})
+	campaign := newTestCampaign(t, m, "brand", "spring", 1, CampaignOptions{})
+
+	first, _ := m.PublishCoupon(ctx, "brand", "spring", "u1", IssueOptions{})
+	for _, userId := range []string{"u2", "u3", "u4"} {
+		m.PublishCoupon(ctx, "brand", "spring", userId, IssueOptions{JoinWaitlist: true})
+	}
+
+	// 회수한 쿠폰은 첫번째 대기자에게 바로 발급됨
+	if _, err := m.RevokeCoupon(ctx, "brand", "spring", first.CouponId, true, "wrong user"); err != nil {
+		t.Fatalf("RevokeCoupon: %v", err)
+	}
+	if ownerOf(campaign, "u2") != first.CouponId || len(campaign.waitlist) != 2 {
+		t.Fatalf("after revoke: u2 has %q, %d waiting", ownerOf(campaign, "u2"), len(campaign.waitlist))
+	}
+
+	// 멈춘 동안에는 발급하지 않고 다시 시작할때 발급함
+	if err := m.SetPaused(ctx, "brand", "spring", true, "check"); err != nil {
+		t.Fatalf("SetPaused: %v", err)
+	}
+	if err := m.RaiseMaxCoupons(ctx, "brand", "spring", 2); err != nil {
+		t.Fatalf("RaiseMaxCoupons: %v", err)
+	}
+	if ownerOf(campaign, "u3") != "" || len(campaign.UnPublishedCouponIds) != 1 {
+		t.Fatalf("coupon issued while paused")
+	}
+	if err := m.SetPaused(ctx, "brand", "spring", false, ""); err != nil {
+		t.Fatalf("SetPaused: %v", err)
+	}
+	if ownerOf(campaign, "u3") == "" || len(campaign.UnPublishedCouponIds) != 0 || len(campaign.waitlist) != 1 {
+		t.Fatalf("after resume: u3 has %q, %d left, %d waiting", ownerOf(campaign, "u3"), len(campaign.UnPublishedCouponIds), len(campaign.waitlist))
+	}
+
+	// 가져온 쿠폰도 대기자에게 먼저 발급됨
+	if _, err := m.ImportCoupons(ctx, "brand", "spring", importRows("GIFT-0001", "GIFT-0002"), nil, false); err != nil {
+		t.Fatalf("ImportCoupons: %v", err)
+	}
+	if ownerOf(campaign, "u4") == "" || len(campaign.waitlist) != 0 || len(campaign.UnPublishedCouponIds) != 1 {
+		t.Fatalf("after import: u4 has %q, %d waiting, %d left", ownerOf(campaign, "u4"), len(campaign.waitlist), len(campaign.UnPublishedCouponIds))
+	}
+
+	due, _ := m.DueDeliveries(time.Now(), 10)
+	if len(due) != 3 {
+		t.Errorf("%d waitlist.fulfilled deliveries, want 3", len(due))
+	}
+
+	if err := m.RaiseMaxCoupons(ctx, "brand", "spring", 1); !errors.Is(err, ErrInvalidMaxCoupon) {
+		t.Errorf("RaiseMaxCoupons lower: err = %v, want %v", err, ErrInvalidMaxCoupon)
+	}
+}
+
+// TestWaitlistBackfillFollowsSchedule : 대기자 발급도 wave 수량을 넘지 않음
+func TestWaitlistBackfillFollowsSchedule(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	m := NewCampaignManager()
+	schedule := &Schedule{Waves: []Wave{{At: now.Add(-30 * time.Second), Quota: 1}, {At: now.Add(time.Hour), Quota: 1}}}
+	campaign := newTestCampaign(t, m, "brand", "spring", 2, CampaignOptions{Schedule: schedule})
+
+	m.PublishCoupon(ctx, "brand", "spring", "u1", IssueOptions{})
+	// 다음 wave 를 기다리는 중이라 소진된 것이 아니므로 대기자로 등록하지 않음
+	_, err := m.PublishCoupon(ctx, "brand", "spring", "u2", IssueOptions{JoinWaitlist: true})
+	var waitlisted *WaitlistError
+	var scheduled *ScheduleError
+	if errors.As(err, &waitlisted) || !errors.As(err, &scheduled) {
+		t.Fatalf("PublishCoupon before next wave: err = %v, want ScheduleError", err)
+	}
+
+	campaign.waitlist = append(campaign.waitlist, WaitlistEntry{UserId: "u3", JoinedAt: now})
+	if issued := m.backfill(ctx, "brand", campaign, now); issued != 0 {
+		t.Errorf("backfill issued %d coupons before next wave", issued)
+	}
+	if issued := m.backfill(ctx, "brand", campaign, now.Add(time.Hour)); issued != 1 {
+		t.Errorf("backfill issued %d coupons after next wave, want 1", issued)
+	}
+}
@@ -0,0 +1,52 @@
+package certs
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+)
+
+var ErrClientCertRequired = errors.New("verified client certificate required")
+
+// ClientCertInterceptor : required 가 true 인 procedure 는 mTLS 로 검증된 연결에서만 호출 가능
+// 인증(API key, JWT) 과는 별개로 동작함
+type ClientCertInterceptor struct {
+	required func(procedure string) bool
+}
+
+func NewClientCertInterceptor(required func(procedure string) bool) *ClientCertInterceptor {
+	return &ClientCertInterceptor{required: required}
+}
+
+func (i *ClientCertInterceptor) check(ctx context.Context, procedure string) error {
+	if i.required(procedure) && ClientCert(ctx) == nil {
+		return connect.NewError(connect.CodePermissionDenied, ErrClientCertRequired)
+	}
+	return nil
+}
+
+func (i *ClientCertInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		if err := i.check(ctx, req.Spec().Procedure); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *ClientCertInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *ClientCertInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := i.check(ctx, conn.Spec().Procedure); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
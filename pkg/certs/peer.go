@@ -0,0 +1,27 @@
+package certs
+
+import (
+	"context"
+	"crypto/x509"
+	"net/http"
+)
+
+type clientCertKey struct{}
+
+// WithClientCert : CA 로 검증된 client 인증서를 context 에 넣어두는 HTTP middleware
+// 검증되지 않은 인증서(verify 안하는 모드)는 넣지 않음
+func WithClientCert(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 && len(r.TLS.VerifiedChains[0]) > 0 {
+			ctx := context.WithValue(r.Context(), clientCertKey{}, r.TLS.VerifiedChains[0][0])
+			r = r.WithContext(ctx)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// ClientCert : 검증된 client 인증서가 없으면 nil (h2c 연결 포함)
+func ClientCert(ctx context.Context) *x509.Certificate {
+	cert, _ := ctx.Value(clientCertKey{}).(*x509.Certificate)
+	return cert
+}
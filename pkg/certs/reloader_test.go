@@ -0,0 +1,268 @@
+package certs
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+type testCert struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+// issue : parent 가 nil 이면 self-signed CA
+func issue(t *testing.T, name string, parent *testCert, usage x509.ExtKeyUsage) *testCert {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial, _ := rand.Int(rand.Reader, big.NewInt(1<<62))
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"localhost"},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA, template.BasicConstraintsValid = true, true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		template.ExtKeyUsage = nil
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	keyDER, _ := x509.MarshalECPrivateKey(key)
+	return &testCert{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+}
+
+// write : 파일을 쓰고 mtime 을 at 으로 맞춤 (파일시스템 시각 해상도와 관계없이 변경을 감지하게)
+func write(t *testing.T, path string, data []byte, at time.Time) {
+	t.Helper()
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, at, at); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// current : 다음 handshake 에서 사용할 서버 인증서
+func current(t *testing.T, r *Reloader) *x509.Certificate {
+	t.Helper()
+	config, err := r.TLSConfig().GetConfigForClient(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(config.Certificates[0].Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func TestReloaderWatchReloadsChangedFiles(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	ca := issue(t, "ca", nil, 0)
+	first, second := issue(t, "first", ca, x509.ExtKeyUsageServerAuth), issue(t, "second", ca, x509.ExtKeyUsageServerAuth)
+
+	at := time.Now().Add(-time.Minute)
+	write(t, certFile, first.certPEM, at)
+	write(t, keyFile, first.keyPEM, at)
+	r, err := NewReloader(certFile, keyFile, "", tls.NoClientCert)
+	if err != nil {
+		t.Fatalf("NewReloader: %v", err)
+	}
+	if r.changed() {
+		t.Fatal("changed() right after load")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go r.Watch(ctx, 5*time.Millisecond)
+
+	write(t, certFile, second.certPEM, at.Add(time.Second))
+	write(t, keyFile, second.keyPEM, at.Add(time.Second))
+
+	deadline := time.Now().Add(2 * time.Second)
+	for current(t, r).Subject.CommonName != "second" {
+		if time.Now().After(deadline) {
+			t.Fatal("certificate was not reloaded after the files changed")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestReloaderKeepsCertOnBadKey(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	ca := issue(t, "ca", nil, 0)
+	first, second := issue(t, "first", ca, x509.ExtKeyUsageServerAuth), issue(t, "second", ca, x509.ExtKeyUsageServerAuth)
+
+	at := time.Now().Add(-time.Minute)
+	write(t, certFile, first.certPEM, at)
+	write(t, keyFile, first.keyPEM, at)
+	r, err := NewReloader(certFile, keyFile, "", tls.NoClientCert)
+	if err != nil {
+		t.Fatalf("NewReloader: %v", err)
+	}
+
+	// 새 인증서에 맞지 않는 개인키
+	write(t, certFile, second.certPEM, at.Add(time.Second))
+	write(t, keyFile, first.keyPEM, at.Add(time.Second))
+	if !r.changed() {
+		t.Fatal("changed() = false after files were rewritten")
+	}
+	if err := r.reload(); err == nil {
+		t.Fatal("reload accepted a key that does not match the certificate")
+	}
+	if name := current(t, r).Subject.CommonName; name != "first" {
+		t.Fatalf("serving %q after failed reload, want previous certificate", name)
+	}
+
+	// 다음 확인때 다시 시도함
+	if !r.changed() {
+		t.Error("failed reload should be retried on the next tick")
+	}
+}
+
+func TestRequireRejectsClientCertFromOtherCA(t *testing.T) {
+	dir := t.TempDir()
+	ca, other := issue(t, "ca", nil, 0), issue(t, "other-ca", nil, 0)
+	server := issue(t, "server", ca, x509.ExtKeyUsageServerAuth)
+	certFile, keyFile, caFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key"), filepath.Join(dir, "ca.crt")
+	at := time.Now()
+	write(t, certFile, server.certPEM, at)
+	write(t, keyFile, server.keyPEM, at)
+	write(t, caFile, ca.certPEM, at)
+
+	r, err := NewReloader(certFile, keyFile, caFile, tls.RequireAndVerifyClientCert)
+	if err != nil {
+		t.Fatalf("NewReloader: %v", err)
+	}
+	ts := httptest.NewUnstartedServer(WithClientCert(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ClientCert(r.Context()) == nil {
+			t.Error("no verified client certificate in context")
+		}
+	})))
+	ts.TLS = r.TLSConfig()
+	ts.StartTLS()
+	defer ts.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	get := func(client *testCert) error {
+		config := &tls.Config{RootCAs: roots, ServerName: "localhost"}
+		if client != nil {
+			config.Certificates = []tls.Certificate{{Certificate: [][]byte{client.cert.Raw}, PrivateKey: client.key}}
+		}
+		c := &http.Client{Transport: &http.Transport{TLSClientConfig: config}}
+		res, err := c.Get(ts.URL)
+		if err != nil {
+			return err
+		}
+		res.Body.Close()
+		return nil
+	}
+
+	if err := get(issue(t, "ops", ca, x509.ExtKeyUsageClientAuth)); err != nil {
+		t.Fatalf("client cert signed by client CA: %v", err)
+	}
+	if err := get(issue(t, "intruder", other, x509.ExtKeyUsageClientAuth)); err == nil {
+		t.Fatal("client cert from another CA was accepted")
+	}
+	if err := get(nil); err == nil {
+		t.Fatal("connection without client cert was accepted")
+	}
+}
+
+func TestClientCertInterceptor(t *testing.T) {
+	ca := issue(t, "ca", nil, 0)
+	client := issue(t, "ops", ca, x509.ExtKeyUsageClientAuth)
+	i := NewClientCertInterceptor(func(procedure string) bool { return procedure == "/admin" })
+
+	// middleware 를 거친 context
+	contextFor := func(state *tls.ConnectionState) context.Context {
+		var ctx context.Context
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		req.TLS = state
+		WithClientCert(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx = r.Context()
+		})).ServeHTTP(httptest.NewRecorder(), req)
+		return ctx
+	}
+
+	tests := []struct {
+		name      string
+		state     *tls.ConnectionState
+		procedure string
+		denied    bool
+	}{
+		{name: "h2c admin", procedure: "/admin", denied: true},
+		{name: "h2c client", procedure: "/client"},
+		// verify 하지 않는 모드로 받은 인증서는 검증된 chain 이 없음
+		{name: "unverified cert admin", state: &tls.ConnectionState{PeerCertificates: []*x509.Certificate{client.cert}}, procedure: "/admin", denied: true},
+		{name: "verified cert admin", state: &tls.ConnectionState{PeerCertificates: []*x509.Certificate{client.cert}, VerifiedChains: [][]*x509.Certificate{{client.cert, ca.cert}}}, procedure: "/admin"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := i.check(contextFor(tt.state), tt.procedure)
+			if !tt.denied {
+				if err != nil {
+					t.Fatalf("check = %v, want nil", err)
+				}
+				return
+			}
+			if connect.CodeOf(err) != connect.CodePermissionDenied || !errors.Is(err, ErrClientCertRequired) {
+				t.Fatalf("check = %v, want permission denied", err)
+			}
+		})
+	}
+}
+
+func TestParseClientAuth(t *testing.T) {
+	for s, want := range map[string]tls.ClientAuthType{"": tls.NoClientCert, "none": tls.NoClientCert, "verify-if-given": tls.VerifyClientCertIfGiven, "require": tls.RequireAndVerifyClientCert} {
+		if got, err := ParseClientAuth(s); err != nil || got != want {
+			t.Errorf("ParseClientAuth(%q) = %v, %v", s, got, err)
+		}
+	}
+	if _, err := ParseClientAuth("optional"); err == nil {
+		t.Error("ParseClientAuth accepted unknown mode")
+	}
+}
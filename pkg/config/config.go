@@ -0,0 +1,469 @@
+package config
+
+import (
+	"bytes"
+	"crypto/rsa"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/auth"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/cache"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/certs"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/logging"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/tracing"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/webhook"
+	"gopkg.in/yaml.v3"
+)
+
+// Config : 우선순위는 기본값 < 설정 파일 < 환경변수 < flag
+type Config struct {
+	Server      ServerConfig      `yaml:"server"`
+	TLS         TLSConfig         `yaml:"tls"`
+	Storage     StorageConfig     `yaml:"storage"`
+	Janitor     JanitorConfig     `yaml:"janitor"`
+	Reservation ReservationConfig `yaml:"reservation"`
+	Raffle      RaffleConfig      `yaml:"raffle"`
+	RateLimit   RateLimitConfig   `yaml:"rateLimit"`
+	Log         LogConfig         `yaml:"log"`
+	Auth        AuthConfig        `yaml:"auth"`
+	Metrics     MetricsConfig     `yaml:"metrics"`
+	Tracing     TracingConfig     `yaml:"tracing"`
+	Audit       AuditConfig       `yaml:"audit"`
+	Webhook     WebhookConfig     `yaml:"webhook"`
+}
+
+type ServerConfig struct {
+	Listen          string        `yaml:"listen"`          // cleartext listener, TLS 만 쓸거면 "" 로 끔
+	H2C             bool          `yaml:"h2c"`             // cleartext listener 에서 HTTP/2 허용 (로컬 개발용)
+	DrainDelay      time.Duration `yaml:"drainDelay"`      // 종료 signal 후 readiness 를 내리고 로드밸런서가 빠질때까지 대기
+	ShutdownTimeout time.Duration `yaml:"shutdownTimeout"` // 처리중인 요청을 기다리는 최대 시간
+}
+
+type TLSConfig struct {
+	Listen                 string        `yaml:"listen"` // TLS listener, 인증서가 있을때만 사용
+	CertFile               string        `yaml:"certFile"`
+	KeyFile                string        `yaml:"keyFile"`
+	ClientCAFile           string        `yaml:"clientCAFile"`           // client 인증서 검증용 CA bundle
+	ClientAuth             string        `yaml:"clientAuth"`             // none, verify-if-given, require
+	ReloadInterval         time.Duration `yaml:"reloadInterval"`         // 인증서 파일 변경 확인 주기
+	AdminRequireClientCert bool          `yaml:"adminRequireClientCert"` // admin RPC 는 mTLS 연결에서만 허용
+}
+
+func (c *TLSConfig) Enabled() bool {
+	return c.CertFile != "" || c.KeyFile != ""
+}
+
+type StorageConfig struct {
+	Backend       string        `yaml:"backend"`       // memory, file
+	Path          string        `yaml:"path"`          // file backend 의 snapshot 파일 경로
+	FlushInterval time.Duration `yaml:"flushInterval"` // file backend 의 snapshot 저장 주기
+}
+
+type JanitorConfig struct {
+	Interval  time.Duration `yaml:"interval"`  // 0 이면 만료 캠페인 정리 안함
+	Retention time.Duration `yaml:"retention"` // 종료 후 이 시간이 지난 캠페인만 정리
+}
+
+type ReservationConfig struct {
+	DefaultTTL    time.Duration `yaml:"defaultTTL"`    // ttl 을 지정하지 않은 예약의 유지 시간
+	MaxTTL        time.Duration `yaml:"maxTTL"`        // 요청할 수 있는 최대 유지 시간
+	SweepInterval time.Duration `yaml:"sweepInterval"` // 만료된 예약을 풀어주는 주기
+}
+
+type RaffleConfig struct {
+	DrawInterval time.Duration `yaml:"drawInterval"` // 추첨 시각이 지난 캠페인 추첨, 사용하지 않은 당첨 쿠폰 회수, tier 예약 수량 정리 주기
+}
+
+type QuotaConfig struct {
+	MaxActiveCampaigns int     `yaml:"maxActiveCampaigns"`
+	MaxTotalCoupons    int64   `yaml:"maxTotalCoupons"`
+	IssueRate          float64 `yaml:"issueRate"`
+	IssueBurst         int     `yaml:"issueBurst"`
+}
+
+func (q QuotaConfig) TenantQuota() cache.TenantQuota {
+	return cache.TenantQuota{
+		MaxActiveCampaigns: q.MaxActiveCampaigns,
+		MaxTotalCoupons:    q.MaxTotalCoupons,
+		IssueRate:          q.IssueRate,
+		IssueBurst:         q.IssueBurst,
+	}
+}
+
+type RateLimitConfig struct {
+	Default QuotaConfig            `yaml:"default"`
+	Tenants map[string]QuotaConfig `yaml:"tenants"`
+}
+
+type LogConfig struct {
+	Level       string `yaml:"level"`       // debug, info, warn, error
+	Format      string `yaml:"format"`      // json, text
+	CouponCodes string `yaml:"couponCodes"` // 로그에 쿠폰 코드 출력 방식 : mask, omit, full
+}
+
+type AuthConfig struct {
+	Enabled bool      `yaml:"enabled"`
+	APIKeys []string  `yaml:"apiKeys"` // role:name[@tenant]:key
+	JWT     JWTConfig `yaml:"jwt"`
+}
+
+type JWTConfig struct {
+	HS256Secret string   `yaml:"hs256Secret"`
+	RS256Keys   []string `yaml:"rs256Keys"` // [kid=]path
+	Issuer      string   `yaml:"issuer"`
+	Audience    string   `yaml:"audience"`
+}
+
+type MetricsConfig struct {
+	Enabled           bool   `yaml:"enabled"`
+	Path              string `yaml:"path"`              // 인증 없이 열리므로 외부에 노출하지 않는 listener 에서 scrape 할 것
+	MaxCampaignSeries int    `yaml:"maxCampaignSeries"` // 캠페인별 gauge 를 내보낼 최대 캠페인 수, 나머지는 "_other" 로 합침
+}
+
+type AuditConfig struct {
+	Path       string `yaml:"path"`       // JSON Lines 파일, "" 로 지정하면 메모리에만 보관 (재시작하면 사라짐, 로컬 개발용)
+	MaxEntries int    `yaml:"maxEntries"` // 메모리 보관시 최근 몇건까지 남길지
+}
+
+type WebhookConfig struct {
+	Enabled        bool          `yaml:"enabled"`        // false 면 이벤트는 outbox 에 쌓이기만 하고 전송하지 않음
+	Interval       time.Duration `yaml:"interval"`       // outbox 확인 주기
+	Timeout        time.Duration `yaml:"timeout"`        // 요청 하나의 timeout
+	MaxAttempts    int           `yaml:"maxAttempts"`    // 이 횟수만큼 실패하면 dead letter 로 옮김
+	InitialBackoff time.Duration `yaml:"initialBackoff"` // 첫 재시도 대기 시간, 실패할때마다 2배
+	MaxBackoff     time.Duration `yaml:"maxBackoff"`
+	Concurrency    int           `yaml:"concurrency"` // 동시에 보내는 요청 수
+}
+
+func (c *WebhookConfig) Options() webhook.Options {
+	return webhook.Options{
+		Interval:       c.Interval,
+		Timeout:        c.Timeout,
+		MaxAttempts:    c.MaxAttempts,
+		InitialBackoff: c.InitialBackoff,
+		MaxBackoff:     c.MaxBackoff,
+		Concurrency:    c.Concurrency,
+	}
+}
+
+type TracingConfig struct {
+	Exporter    string  `yaml:"exporter"`    // none, stdout, otlp
+	Endpoint    string  `yaml:"endpoint"`    // otlp http endpoint (host:port), 비어있으면 OTEL_EXPORTER_OTLP_ENDPOINT 또는 localhost:4318
+	Insecure    bool    `yaml:"insecure"`    // otlp 를 TLS 없이 전송
+	SampleRatio float64 `yaml:"sampleRatio"` // trace context 없이 들어온 요청 중 기록할 비율 (0 ~ 1)
+	ServiceName string  `yaml:"serviceName"`
+}
+
+func (c *TracingConfig) Options() tracing.Options {
+	return tracing.Options{
+		ServiceName: c.ServiceName,
+		Exporter:    c.Exporter,
+		Endpoint:    c.Endpoint,
+		Insecure:    c.Insecure,
+		SampleRatio: c.SampleRatio,
+	}
+}
+
+func Default() *Config {
+	return &Config{
+		Server: ServerConfig{
+			Listen:          "localhost:50051",
+			H2C:             true,
+			DrainDelay:      5 * time.Second,
+			ShutdownTimeout: 30 * time.Second,
+		},
+		TLS: TLSConfig{
+			Listen:         "localhost:50443",
+			ClientAuth:     "none",
+			ReloadInterval: 30 * time.Second,
+		},
+		Storage: StorageConfig{
+			Backend:       "memory",
+			FlushInterval: 10 * time.Second,
+		},
+		Janitor: JanitorConfig{
+			Interval:  10 * time.Minute,
+			Retention: 24 * time.Hour,
+		},
+		Reservation: ReservationConfig{
+			DefaultTTL:    5 * time.Minute,
+			MaxTTL:        30 * time.Minute,
+			SweepInterval: 5 * time.Second,
+		},
+		Raffle: RaffleConfig{
+			DrawInterval: 5 * time.Second,
+		},
+		RateLimit: RateLimitConfig{
+			Tenants: make(map[string]QuotaConfig),
+		},
+		Log: LogConfig{
+			Level:       "info",
+			Format:      "json",
+			CouponCodes: "mask",
+		},
+		Auth: AuthConfig{
+			Enabled: true,
+		},
+		Metrics: MetricsConfig{
+			Enabled:           true,
+			Path:              "/metrics",
+			MaxCampaignSeries: 100,
+		},
+		Audit: AuditConfig{
+			Path:       "./coupon-audit.jsonl",
+			MaxEntries: 100000,
+		},
+		Webhook: WebhookConfig{
+			Enabled:        true,
+			Interval:       time.Second,
+			Timeout:        5 * time.Second,
+			MaxAttempts:    8,
+			InitialBackoff: time.Second,
+			MaxBackoff:     5 * time.Minute,
+			Concurrency:    4,
+		},
+		Tracing: TracingConfig{
+			Exporter:    "none",
+			SampleRatio: 1,
+			ServiceName: "coupon-issuance",
+		},
+	}
+}
+
+// LoadFile : 파일에 없는 값은 기존 값(기본값) 유지
+func (c *Config) LoadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	if c.RateLimit.Tenants == nil {
+		c.RateLimit.Tenants = make(map[string]QuotaConfig)
+	}
+
+	return nil
+}
+
+func (c *Config) Validate() error {
+	var errs []error
+
+	if c.Server.Listen == "" && !c.TLS.Enabled() {
+		errs = append(errs, errors.New("server.listen is required when tls is not configured"))
+	}
+	if c.Server.DrainDelay < 0 || c.Server.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("server.drainDelay must not be negative and server.shutdownTimeout must be positive"))
+	}
+
+	if c.TLS.Enabled() {
+		if c.TLS.CertFile == "" || c.TLS.KeyFile == "" {
+			errs = append(errs, errors.New("tls.certFile and tls.keyFile must be set together"))
+		}
+		if c.TLS.Listen == "" {
+			errs = append(errs, errors.New("tls.listen is required when tls is configured"))
+		}
+		if c.TLS.Listen == c.Server.Listen {
+			errs = append(errs, errors.New("tls.listen and server.listen must be different"))
+		}
+		for _, f := range []string{c.TLS.CertFile, c.TLS.KeyFile, c.TLS.ClientCAFile} {
+			if _, err := os.Stat(f); f != "" && err != nil {
+				errs = append(errs, fmt.Errorf("tls: %w", err))
+			}
+		}
+		if c.TLS.ReloadInterval <= 0 {
+			errs = append(errs, errors.New("tls.reloadInterval must be positive"))
+		}
+	}
+
+	clientAuth, err := certs.ParseClientAuth(c.TLS.ClientAuth)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if clientAuth != tls.NoClientCert && c.TLS.ClientCAFile == "" {
+		errs = append(errs, errors.New("tls.clientCAFile is required when tls.clientAuth is not none"))
+	}
+	if c.TLS.AdminRequireClientCert && (!c.TLS.Enabled() || clientAuth == tls.NoClientCert) {
+		errs = append(errs, errors.New("tls.adminRequireClientCert needs tls and tls.clientAuth (verify-if-given or require)"))
+	}
+
+	switch c.Storage.Backend {
+	case "memory":
+	case "file":
+		if c.Storage.Path == "" {
+			errs = append(errs, errors.New("storage.path is required for file backend"))
+		}
+		if c.Storage.FlushInterval <= 0 {
+			errs = append(errs, errors.New("storage.flushInterval must be positive"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("unknown storage.backend %q (memory, file)", c.Storage.Backend))
+	}
+
+	if c.Janitor.Interval < 0 || c.Janitor.Retention < 0 {
+		errs = append(errs, errors.New("janitor.interval and janitor.retention must not be negative"))
+	}
+
+	if c.Reservation.DefaultTTL <= 0 || c.Reservation.MaxTTL < c.Reservation.DefaultTTL || c.Reservation.SweepInterval <= 0 {
+		errs = append(errs, errors.New("reservation.defaultTTL and reservation.sweepInterval must be positive and reservation.maxTTL must not be less than reservation.defaultTTL"))
+	}
+
+	if c.Raffle.DrawInterval <= 0 {
+		errs = append(errs, errors.New("raffle.drawInterval must be positive"))
+	}
+
+	if _, err := c.Log.SlogLevel(); err != nil {
+		errs = append(errs, err)
+	}
+	if c.Log.Format != "json" && c.Log.Format != "text" {
+		errs = append(errs, fmt.Errorf("invalid log.format %q (json, text)", c.Log.Format))
+	}
+	switch c.Log.CouponCodes {
+	case "mask", "omit", "full":
+	default:
+		errs = append(errs, fmt.Errorf("invalid log.couponCodes %q (mask, omit, full)", c.Log.CouponCodes))
+	}
+
+	quotas := map[string]QuotaConfig{"default": c.RateLimit.Default}
+	for tenantId, q := range c.RateLimit.Tenants {
+		quotas["tenants."+tenantId] = q
+	}
+	for name, q := range quotas {
+		if q.MaxActiveCampaigns < 0 || q.MaxTotalCoupons < 0 || q.IssueRate < 0 || q.IssueBurst < 0 {
+			errs = append(errs, fmt.Errorf("rateLimit.%s must not be negative", name))
+		}
+	}
+
+	if c.Metrics.Enabled && !strings.HasPrefix(c.Metrics.Path, "/") {
+		errs = append(errs, fmt.Errorf("metrics.path must start with /, got %q", c.Metrics.Path))
+	}
+	if c.Metrics.MaxCampaignSeries < 0 {
+		errs = append(errs, errors.New("metrics.maxCampaignSeries must not be negative"))
+	}
+
+	if c.Audit.Path == "" && c.Audit.MaxEntries <= 0 {
+		errs = append(errs, errors.New("audit.maxEntries must be positive when audit.path is empty"))
+	}
+
+	if c.Webhook.Enabled {
+		if c.Webhook.Interval <= 0 || c.Webhook.Timeout <= 0 {
+			errs = append(errs, errors.New("webhook.interval and webhook.timeout must be positive"))
+		}
+		if c.Webhook.MaxAttempts <= 0 || c.Webhook.Concurrency <= 0 {
+			errs = append(errs, errors.New("webhook.maxAttempts and webhook.concurrency must be positive"))
+		}
+		if c.Webhook.InitialBackoff <= 0 || c.Webhook.MaxBackoff < c.Webhook.InitialBackoff {
+			errs = append(errs, errors.New("webhook.initialBackoff must be positive and not greater than webhook.maxBackoff"))
+		}
+	}
+
+	switch c.Tracing.Exporter {
+	case "none", "stdout", "otlp":
+	default:
+		errs = append(errs, fmt.Errorf("unknown tracing.exporter %q (none, stdout, otlp)", c.Tracing.Exporter))
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		errs = append(errs, errors.New("tracing.sampleRatio must be between 0 and 1"))
+	}
+
+	if c.Auth.Enabled {
+		for _, s := range c.Auth.APIKeys {
+			if _, err := auth.ParseAPIKey(s); err != nil {
+				errs = append(errs, fmt.Errorf("auth.apiKeys: %w", redactErr(err, s)))
+			}
+		}
+		if len(c.Auth.APIKeys) == 0 && c.Auth.JWT.HS256Secret == "" && len(c.Auth.JWT.RS256Keys) == 0 {
+			errs = append(errs, errors.New("auth is enabled but no api key or jwt key is configured (set auth.enabled=false for local development)"))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *LogConfig) Options() logging.Options {
+	level, _ := c.SlogLevel()
+	return logging.Options{
+		Format:      c.Format,
+		Level:       level,
+		CouponCodes: c.CouponCodes,
+	}
+}
+
+func (c *LogConfig) SlogLevel() (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(c.Level)); err != nil {
+		return level, fmt.Errorf("invalid log.level %q (debug, info, warn, error)", c.Level)
+	}
+	return level, nil
+}
+
+// AuthenticatorConfig : RS256 public key 파일은 여기서 읽음
+func (c *AuthConfig) AuthenticatorConfig() (auth.Config, error) {
+	config := auth.Config{
+		HS256Secret: []byte(c.JWT.HS256Secret),
+		RS256Keys:   make(map[string]*rsa.PublicKey),
+		Issuer:      c.JWT.Issuer,
+		Audience:    c.JWT.Audience,
+	}
+
+	for _, s := range c.APIKeys {
+		key, err := auth.ParseAPIKey(s)
+		if err != nil {
+			return config, redactErr(err, s)
+		}
+		config.APIKeys = append(config.APIKeys, key)
+	}
+
+	for _, s := range c.JWT.RS256Keys {
+		kid, key, err := auth.LoadRSAPublicKey(s)
+		if err != nil {
+			return config, err
+		}
+		config.RS256Keys[kid] = key
+	}
+
+	return config, nil
+}
+
+const redacted = "<redacted>"
+
+// Redacted : 시작할때 출력하는 용도, secret 은 가림
+func (c *Config) Redacted() string {
+	clone := *c
+	clone.Auth.APIKeys = make([]string, 0, len(c.Auth.APIKeys))
+	for _, s := range c.Auth.APIKeys {
+		clone.Auth.APIKeys = append(clone.Auth.APIKeys, redactAPIKey(s))
+	}
+	if clone.Auth.JWT.HS256Secret != "" {
+		clone.Auth.JWT.HS256Secret = redacted
+	}
+
+	out, err := yaml.Marshal(&clone)
+	if err != nil {
+		return err.Error()
+	}
+	return string(out)
+}
+
+// redactAPIKey : role:name 은 남기고 key 만 가림
+func redactAPIKey(s string) string {
+	parts := strings.SplitN(s, ":", 3)
+	if len(parts) == 3 {
+		return parts[0] + ":" + parts[1] + ":" + redacted
+	}
+	return redacted
+}
+
+func redactErr(err error, secret string) error {
+	return errors.New(strings.ReplaceAll(err.Error(), secret, redactAPIKey(secret)))
+}
@@ -0,0 +1,85 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDefaultIsValid(t *testing.T) {
+	c := Default()
+	c.Auth.APIKeys = []string{"admin:ops:secret"}
+	if err := c.Validate(); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	if c.Audit.Path == "" {
+		t.Errorf("audit log is kept in memory by default")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(c *Config)
+		err    string // 빈 문자열이면 통과
+	}{
+		{name: "auth disabled", modify: func(c *Config) { c.Auth = AuthConfig{} }},
+		{name: "auth without keys", modify: func(c *Config) { c.Auth.APIKeys = nil }, err: "no api key or jwt key"},
+		{name: "invalid api key does not leak secret", modify: func(c *Config) { c.Auth.APIKeys = []string{"admin:secret-only"} }, err: "auth.apiKeys"},
+		{name: "no listener", modify: func(c *Config) { c.Server.Listen = "" }, err: "server.listen is required"},
+		{name: "file backend without path", modify: func(c *Config) { c.Storage.Backend = "file" }, err: "storage.path"},
+		{name: "unknown backend", modify: func(c *Config) { c.Storage.Backend = "redis" }, err: "unknown storage.backend"},
+		{name: "reservation max below default", modify: func(c *Config) { c.Reservation.MaxTTL = time.Minute }, err: "reservation.maxTTL"},
+		{name: "tls key without cert", modify: func(c *Config) { c.TLS.KeyFile = "server.key" }, err: "tls.certFile and tls.keyFile"},
+		{name: "log level", modify: func(c *Config) { c.Log.Level = "verbose" }, err: "invalid log.level"},
+		{name: "negative tenant quota", modify: func(c *Config) { c.RateLimit.Tenants["brandA"] = QuotaConfig{IssueRate: -1} }, err: "rateLimit.tenants.brandA"},
+		{name: "admin client cert without tls", modify: func(c *Config) { c.TLS.AdminRequireClientCert = true }, err: "tls.adminRequireClientCert"},
+		{name: "metrics path", modify: func(c *Config) { c.Metrics.Path = "metrics" }, err: "metrics.path"},
+		{name: "memory audit without limit", modify: func(c *Config) { c.Audit = AuditConfig{} }, err: "audit.maxEntries"},
+		{name: "file audit ignores limit", modify: func(c *Config) { c.Audit.MaxEntries = 0 }},
+		{name: "webhook backoff", modify: func(c *Config) { c.Webhook.MaxBackoff = time.Millisecond }, err: "webhook.initialBackoff"},
+		{name: "webhook disabled skips checks", modify: func(c *Config) { c.Webhook = WebhookConfig{} }},
+		{name: "sample ratio", modify: func(c *Config) { c.Tracing.SampleRatio = 2 }, err: "tracing.sampleRatio"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Default()
+			c.Auth.APIKeys = []string{"admin:ops:secret"}
+			tt.modify(c)
+
+			err := c.Validate()
+			if tt.err == "" {
+				if err != nil {
+					t.Fatalf("Validate: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("err = %v, want %q", err, tt.err)
+			}
+			if strings.Contains(err.Error(), "secret") {
+				t.Errorf("error contains api key: %v", err)
+			}
+		})
+	}
+}
+
+func TestRedacted(t *testing.T) {
+	c := Default()
+	c.Auth.APIKeys = []string{"admin:ops:my-admin-key"}
+	c.Auth.JWT.HS256Secret = "my-jwt-secret"
+
+	out := c.Redacted()
+	for _, secret := range []string{"my-admin-key", "my-jwt-secret"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("Redacted contains %q:\n%s", secret, out)
+		}
+	}
+	if !strings.Contains(out, "admin:ops:"+redacted) {
+		t.Errorf("Redacted lost api key role and name:\n%s", out)
+	}
+	if c.Auth.APIKeys[0] != "admin:ops:my-admin-key" {
+		t.Errorf("Redacted changed the config: %v", c.Auth.APIKeys)
+	}
+}
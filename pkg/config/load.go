@@ -0,0 +1,257 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// setting : 같은 항목을 환경변수와 flag 양쪽에서 덮어쓸 수 있게 한곳에 정의함
+type setting struct {
+	flag   string
+	env    string
+	usage  string
+	isBool bool
+	list   bool // 여러번 지정 가능 (환경변수는 콤마로 구분)
+	apply  func(c *Config, v string) error
+}
+
+var settings = []setting{
+	{flag: "listen", env: "COUPON_LISTEN", usage: "cleartext listen 주소 (\"\" 면 TLS listener 만 사용)",
+		apply: func(c *Config, v string) error { c.Server.Listen = v; return nil }},
+	{flag: "h2c", env: "COUPON_H2C", usage: "cleartext listener 에서 HTTP/2 허용 (로컬 개발용)", isBool: true,
+		apply: func(c *Config, v string) error { return setBool(&c.Server.H2C, v) }},
+	{flag: "drain-delay", env: "COUPON_DRAIN_DELAY", usage: "종료 signal 후 요청을 계속 받으면서 readiness 만 내리는 시간",
+		apply: func(c *Config, v string) error { return setDuration(&c.Server.DrainDelay, v) }},
+	{flag: "shutdown-timeout", env: "COUPON_SHUTDOWN_TIMEOUT", usage: "처리중인 요청을 기다리는 최대 시간",
+		apply: func(c *Config, v string) error { return setDuration(&c.Server.ShutdownTimeout, v) }},
+	{flag: "tls-listen", env: "COUPON_TLS_LISTEN", usage: "TLS listen 주소",
+		apply: func(c *Config, v string) error { c.TLS.Listen = v; return nil }},
+	{flag: "tls-cert", env: "COUPON_TLS_CERT_FILE", usage: "TLS 인증서 파일",
+		apply: func(c *Config, v string) error { c.TLS.CertFile = v; return nil }},
+	{flag: "tls-key", env: "COUPON_TLS_KEY_FILE", usage: "TLS 개인키 파일",
+		apply: func(c *Config, v string) error { c.TLS.KeyFile = v; return nil }},
+	{flag: "tls-client-ca", env: "COUPON_TLS_CLIENT_CA_FILE", usage: "client 인증서 검증용 CA bundle",
+		apply: func(c *Config, v string) error { c.TLS.ClientCAFile = v; return nil }},
+	{flag: "tls-client-auth", env: "COUPON_TLS_CLIENT_AUTH", usage: "client 인증서 검증 (none, verify-if-given, require)",
+		apply: func(c *Config, v string) error { c.TLS.ClientAuth = v; return nil }},
+	{flag: "tls-reload-interval", env: "COUPON_TLS_RELOAD_INTERVAL", usage: "인증서 파일 변경 확인 주기",
+		apply: func(c *Config, v string) error { return setDuration(&c.TLS.ReloadInterval, v) }},
+	{flag: "tls-admin-require-client-cert", env: "COUPON_TLS_ADMIN_REQUIRE_CLIENT_CERT", usage: "admin RPC 는 mTLS 연결에서만 허용", isBool: true,
+		apply: func(c *Config, v string) error { return setBool(&c.TLS.AdminRequireClientCert, v) }},
+	{flag: "storage", env: "COUPON_STORAGE_BACKEND", usage: "storage backend (memory, file)",
+		apply: func(c *Config, v string) error { c.Storage.Backend = v; return nil }},
+	{flag: "storage-path", env: "COUPON_STORAGE_PATH", usage: "file backend 의 snapshot 파일 경로",
+		apply: func(c *Config, v string) error { c.Storage.Path = v; return nil }},
+	{flag: "storage-flush-interval", env: "COUPON_STORAGE_FLUSH_INTERVAL", usage: "file backend 의 snapshot 저장 주기",
+		apply: func(c *Config, v string) error { return setDuration(&c.Storage.FlushInterval, v) }},
+	{flag: "janitor-interval", env: "COUPON_JANITOR_INTERVAL", usage: "만료 캠페인 정리 주기 (0: 정리 안함)",
+		apply: func(c *Config, v string) error { return setDuration(&c.Janitor.Interval, v) }},
+	{flag: "janitor-retention", env: "COUPON_JANITOR_RETENTION", usage: "종료 후 캠페인을 보관하는 기간",
+		apply: func(c *Config, v string) error { return setDuration(&c.Janitor.Retention, v) }},
+	{flag: "reservation-ttl", env: "COUPON_RESERVATION_TTL", usage: "ttl 을 지정하지 않은 쿠폰 예약의 유지 시간",
+		apply: func(c *Config, v string) error { return setDuration(&c.Reservation.DefaultTTL, v) }},
+	{flag: "reservation-max-ttl", env: "COUPON_RESERVATION_MAX_TTL", usage: "쿠폰 예약 최대 유지 시간",
+		apply: func(c *Config, v string) error { return setDuration(&c.Reservation.MaxTTL, v) }},
+	{flag: "reservation-sweep-interval", env: "COUPON_RESERVATION_SWEEP_INTERVAL", usage: "만료된 쿠폰 예약을 풀어주는 주기",
+		apply: func(c *Config, v string) error { return setDuration(&c.Reservation.SweepInterval, v) }},
+	{flag: "raffle-draw-interval", env: "COUPON_RAFFLE_DRAW_INTERVAL", usage: "추첨 시각이 지난 캠페인 추첨, 사용하지 않은 당첨 쿠폰 회수 주기",
+		apply: func(c *Config, v string) error { return setDuration(&c.Raffle.DrawInterval, v) }},
+	{flag: "log-level", env: "COUPON_LOG_LEVEL", usage: "로그 레벨 (debug, info, warn, error)",
+		apply: func(c *Config, v string) error { c.Log.Level = v; return nil }},
+	{flag: "log-format", env: "COUPON_LOG_FORMAT", usage: "로그 형식 (json, text)",
+		apply: func(c *Config, v string) error { c.Log.Format = v; return nil }},
+	{flag: "log-coupon-codes", env: "COUPON_LOG_COUPON_CODES", usage: "로그에 쿠폰 코드 출력 방식 (mask, omit, full)",
+		apply: func(c *Config, v string) error { c.Log.CouponCodes = v; return nil }},
+	{flag: "tenant-max-campaigns", env: "COUPON_TENANT_MAX_CAMPAIGNS", usage: "tenant 별 진행중인 캠페인 수 제한 (0: 제한 없음)",
+		apply: func(c *Config, v string) error { return setInt(&c.RateLimit.Default.MaxActiveCampaigns, v) }},
+	{flag: "tenant-max-coupons", env: "COUPON_TENANT_MAX_COUPONS", usage: "tenant 별 진행중인 캠페인의 쿠폰 수 합계 제한 (0: 제한 없음)",
+		apply: func(c *Config, v string) error { return setInt64(&c.RateLimit.Default.MaxTotalCoupons, v) }},
+	{flag: "tenant-issue-rate", env: "COUPON_TENANT_ISSUE_RATE", usage: "tenant 별 초당 쿠폰 발급 요청 수 제한 (0: 제한 없음)",
+		apply: func(c *Config, v string) error { return setFloat(&c.RateLimit.Default.IssueRate, v) }},
+	{flag: "tenant-quota", env: "COUPON_TENANT_QUOTAS", usage: "특정 tenant quota, tenant=maxCampaigns:maxCoupons:issueRate 형식", list: true,
+		apply: func(c *Config, v string) error { return c.setTenantQuota(v) }},
+	{flag: "auth", env: "COUPON_AUTH", usage: "인증 사용 여부 (false 면 누구나 모든 RPC 호출 가능, 로컬 개발용)", isBool: true,
+		apply: func(c *Config, v string) error { return setBool(&c.Auth.Enabled, v) }},
+	{flag: "api-key", env: "COUPON_API_KEYS", usage: "정적 API key, role:name[@tenant]:key 형식", list: true,
+		apply: func(c *Config, v string) error { c.Auth.APIKeys = append(c.Auth.APIKeys, v); return nil }},
+	{flag: "jwt-hs256-secret", env: "COUPON_JWT_HS256_SECRET", usage: "HS256 JWT 검증용 secret",
+		apply: func(c *Config, v string) error { c.Auth.JWT.HS256Secret = v; return nil }},
+	{flag: "jwt-rs256-key", env: "COUPON_JWT_RS256_KEYS", usage: "RS256 JWT 검증용 public key PEM 경로, [kid=]path 형식", list: true,
+		apply: func(c *Config, v string) error { c.Auth.JWT.RS256Keys = append(c.Auth.JWT.RS256Keys, v); return nil }},
+	{flag: "jwt-issuer", env: "COUPON_JWT_ISSUER", usage: "JWT iss 검증값 (비어있으면 검증 안함)",
+		apply: func(c *Config, v string) error { c.Auth.JWT.Issuer = v; return nil }},
+	{flag: "jwt-audience", env: "COUPON_JWT_AUDIENCE", usage: "JWT aud 검증값 (비어있으면 검증 안함)",
+		apply: func(c *Config, v string) error { c.Auth.JWT.Audience = v; return nil }},
+	{flag: "metrics", env: "COUPON_METRICS", usage: "Prometheus metric 노출 여부", isBool: true,
+		apply: func(c *Config, v string) error { return setBool(&c.Metrics.Enabled, v) }},
+	{flag: "metrics-path", env: "COUPON_METRICS_PATH", usage: "Prometheus scrape 경로 (인증 없음)",
+		apply: func(c *Config, v string) error { c.Metrics.Path = v; return nil }},
+	{flag: "metrics-max-campaign-series", env: "COUPON_METRICS_MAX_CAMPAIGN_SERIES", usage: "캠페인별 metric 을 내보낼 최대 캠페인 수 (나머지는 _other 로 합침)",
+		apply: func(c *Config, v string) error { return setInt(&c.Metrics.MaxCampaignSeries, v) }},
+	{flag: "audit-path", env: "COUPON_AUDIT_PATH", usage: "감사 이력 JSON Lines 파일 (\"\" 면 메모리에만 보관)",
+		apply: func(c *Config, v string) error { c.Audit.Path = v; return nil }},
+	{flag: "audit-max-entries", env: "COUPON_AUDIT_MAX_ENTRIES", usage: "메모리에 보관할 감사 이력 수",
+		apply: func(c *Config, v string) error { return setInt(&c.Audit.MaxEntries, v) }},
+	{flag: "webhook", env: "COUPON_WEBHOOK", usage: "webhook 전송 여부 (false 면 outbox 에 쌓이기만 함)", isBool: true,
+		apply: func(c *Config, v string) error { return setBool(&c.Webhook.Enabled, v) }},
+	{flag: "webhook-interval", env: "COUPON_WEBHOOK_INTERVAL", usage: "webhook outbox 확인 주기",
+		apply: func(c *Config, v string) error { return setDuration(&c.Webhook.Interval, v) }},
+	{flag: "webhook-timeout", env: "COUPON_WEBHOOK_TIMEOUT", usage: "webhook 요청 timeout",
+		apply: func(c *Config, v string) error { return setDuration(&c.Webhook.Timeout, v) }},
+	{flag: "webhook-max-attempts", env: "COUPON_WEBHOOK_MAX_ATTEMPTS", usage: "webhook 전송 시도 횟수 (넘으면 dead letter)",
+		apply: func(c *Config, v string) error { return setInt(&c.Webhook.MaxAttempts, v) }},
+	{flag: "webhook-initial-backoff", env: "COUPON_WEBHOOK_INITIAL_BACKOFF", usage: "webhook 첫 재시도 대기 시간",
+		apply: func(c *Config, v string) error { return setDuration(&c.Webhook.InitialBackoff, v) }},
+	{flag: "webhook-max-backoff", env: "COUPON_WEBHOOK_MAX_BACKOFF", usage: "webhook 재시도 대기 시간 상한",
+		apply: func(c *Config, v string) error { return setDuration(&c.Webhook.MaxBackoff, v) }},
+	{flag: "webhook-concurrency", env: "COUPON_WEBHOOK_CONCURRENCY", usage: "webhook 동시 전송 수",
+		apply: func(c *Config, v string) error { return setInt(&c.Webhook.Concurrency, v) }},
+	{flag: "tracing-exporter", env: "COUPON_TRACING_EXPORTER", usage: "OpenTelemetry span exporter (none, stdout, otlp)",
+		apply: func(c *Config, v string) error { c.Tracing.Exporter = v; return nil }},
+	{flag: "tracing-endpoint", env: "COUPON_TRACING_ENDPOINT", usage: "OTLP/HTTP collector 주소 (host:port)",
+		apply: func(c *Config, v string) error { c.Tracing.Endpoint = v; return nil }},
+	{flag: "tracing-insecure", env: "COUPON_TRACING_INSECURE", usage: "OTLP collector 로 TLS 없이 전송", isBool: true,
+		apply: func(c *Config, v string) error { return setBool(&c.Tracing.Insecure, v) }},
+	{flag: "tracing-sample-ratio", env: "COUPON_TRACING_SAMPLE_RATIO", usage: "trace context 없이 들어온 요청 중 기록할 비율 (0 ~ 1)",
+		apply: func(c *Config, v string) error { return setFloat(&c.Tracing.SampleRatio, v) }},
+}
+
+// Load : 기본값 -> 설정 파일(-config 또는 COUPON_CONFIG) -> 환경변수 -> flag 순서로 덮어씀
+// flag 는 파싱 시점에 바로 적용하지 않고 모아뒀다가 마지막에 적용함
+func Load(name string, args []string) (*Config, error) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+
+	configPath := fs.String("config", os.Getenv("COUPON_CONFIG"), "설정 파일 경로 (YAML, env: COUPON_CONFIG)")
+
+	type pending struct {
+		s setting
+		v string
+	}
+	var flagValues []pending
+
+	for _, s := range settings {
+		s := s
+		usage := fmt.Sprintf("%s (env: %s)", s.usage, s.env)
+		if s.list {
+			usage += " (여러번 지정 가능)"
+		}
+
+		record := func(v string) error {
+			flagValues = append(flagValues, pending{s: s, v: v})
+			return nil
+		}
+		if s.isBool {
+			fs.BoolFunc(s.flag, usage, record)
+		} else {
+			fs.Func(s.flag, usage, record)
+		}
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	c := Default()
+	if *configPath != "" {
+		if err := c.LoadFile(*configPath); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, s := range settings {
+		v, ok := os.LookupEnv(s.env)
+		if !ok {
+			continue
+		}
+
+		values := []string{v}
+		if s.list {
+			values = strings.Split(v, ",")
+		}
+		for _, value := range values {
+			if err := s.apply(c, value); err != nil {
+				return nil, fmt.Errorf("env %s: %w", s.env, err)
+			}
+		}
+	}
+
+	for _, p := range flagValues {
+		if err := p.s.apply(c, p.v); err != nil {
+			return nil, fmt.Errorf("flag -%s: %w", p.s.flag, err)
+		}
+	}
+
+	return c, nil
+}
+
+// setTenantQuota : "brandA=10:50000:200"
+func (c *Config) setTenantQuota(s string) error {
+	tenantId, values, ok := strings.Cut(s, "=")
+	parts := strings.Split(values, ":")
+	if !ok || tenantId == "" || len(parts) != 3 {
+		return fmt.Errorf("tenant quota must be tenant=maxCampaigns:maxCoupons:issueRate, got %q", s)
+	}
+
+	var q QuotaConfig
+	if err := setInt(&q.MaxActiveCampaigns, parts[0]); err != nil {
+		return err
+	}
+	if err := setInt64(&q.MaxTotalCoupons, parts[1]); err != nil {
+		return err
+	}
+	if err := setFloat(&q.IssueRate, parts[2]); err != nil {
+		return err
+	}
+
+	c.RateLimit.Tenants[tenantId] = q
+	return nil
+}
+
+func setBool(dst *bool, v string) error {
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return err
+	}
+	*dst = b
+	return nil
+}
+
+func setInt(dst *int, v string) error {
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return err
+	}
+	*dst = n
+	return nil
+}
+
+func setInt64(dst *int64, v string) error {
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return err
+	}
+	*dst = n
+	return nil
+}
+
+func setFloat(dst *float64, v string) error {
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return err
+	}
+	*dst = f
+	return nil
+}
+
+func setDuration(dst *time.Duration, v string) error {
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return err
+	}
+	*dst = d
+	return nil
+}
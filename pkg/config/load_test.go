@@ -0,0 +1,91 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestLoadPrecedence : 기본값 < 설정 파일 < 환경변수 < flag
+func TestLoadPrecedence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	yaml := "server:\n  listen: file:1\n  drainDelay: 1s\nlog:\n  level: debug\n  format: text\n"
+	if err := os.WriteFile(path, []byte(yaml), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("COUPON_CONFIG", path)
+	t.Setenv("COUPON_LOG_LEVEL", "warn")
+	t.Setenv("COUPON_LISTEN", "env:2")
+	t.Setenv("COUPON_API_KEYS", "admin:a:1,client:b:2")
+
+	c, err := Load("test", []string{"-listen", "flag:3", "-api-key", "admin:c:3"})
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{name: "default", got: c.Storage.Backend, want: "memory"},
+		{name: "file", got: c.Log.Format, want: "text"},
+		{name: "file duration", got: c.Server.DrainDelay, want: time.Second},
+		{name: "env over file", got: c.Log.Level, want: "warn"},
+		{name: "flag over env", got: c.Server.Listen, want: "flag:3"},
+		{name: "list from env and flag", got: len(c.Auth.APIKeys), want: 3},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		env  map[string]string
+	}{
+		{name: "invalid duration flag", args: []string{"-drain-delay", "soon"}},
+		{name: "invalid bool env", env: map[string]string{"COUPON_H2C": "maybe"}},
+		{name: "invalid tenant quota", args: []string{"-tenant-quota", "brandA=3:5000"}},
+		{name: "unknown flag", args: []string{"-no-such-flag"}},
+		{name: "missing config file", env: map[string]string{"COUPON_CONFIG": "/nonexistent/config.yaml"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			if _, err := Load("test", tt.args); err == nil {
+				t.Errorf("Load succeeded, want error")
+			}
+		})
+	}
+}
+
+func TestSetTenantQuota(t *testing.T) {
+	c := Default()
+	if err := c.setTenantQuota("brandA=3:5000:100"); err != nil {
+		t.Fatalf("setTenantQuota: %v", err)
+	}
+	want := QuotaConfig{MaxActiveCampaigns: 3, MaxTotalCoupons: 5000, IssueRate: 100}
+	if got := c.RateLimit.Tenants["brandA"]; got != want {
+		t.Errorf("quota = %+v, want %+v", got, want)
+	}
+}
+
+// TestExampleConfig : config.example.yaml 이 설정 구조와 맞는지 (모르는 항목이 있으면 실패)
+func TestExampleConfig(t *testing.T) {
+	c := Default()
+	if err := c.LoadFile("../../config.example.yaml"); err != nil {
+		t.Fatalf("LoadFile: %v", err)
+	}
+	if err := c.Validate(); err != nil {
+		t.Errorf("Validate: %v", err)
+	}
+}
@@ -0,0 +1,459 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: v1/audit.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 캠페인/쿠폰 상태 변경 이력 한건
+type AuditEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seq           int64                  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Time          string                 `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`   // RFC3339 (나노초 포함)
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"` // API key 이름 또는 JWT sub, 인증이 꺼져있으면 "anonymous", 서버 내부 작업은 "system"
+	ActorRole     string                 `protobuf:"bytes,4,opt,name=actorRole,proto3" json:"actorRole,omitempty"`
+	TenantId      string                 `protobuf:"bytes,5,opt,name=tenantId,proto3" json:"tenantId,omitempty"`
+	Rpc           string                 `protobuf:"bytes,6,opt,name=rpc,proto3" json:"rpc,omitempty"`
+	RequestId     string                 `protobuf:"bytes,7,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Action        string                 `protobuf:"bytes,8,opt,name=action,proto3" json:"action,omitempty"` // campaign.created, campaign.removed, campaign.deleted, campaign.paused, campaign.resumed, coupon.issued, coupon.reserved, coupon.released, coupon.redeemed, coupon.revoked, coupon.unredeemed, coupon.expired
+	CampaignId    string                 `protobuf:"bytes,9,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	CouponCode    string                 `protobuf:"bytes,10,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	Before        string                 `protobuf:"bytes,11,opt,name=before,proto3" json:"before,omitempty"` // 변경 전 값 (JSON), 새로 만든 경우 비어있음
+	After         string                 `protobuf:"bytes,12,opt,name=after,proto3" json:"after,omitempty"`   // 변경 후 값 (JSON), 삭제된 경우 비어있음
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_v1_audit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_audit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_v1_audit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditEntry) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetActorRole() string {
+	if x != nil {
+		return x.ActorRole
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetRpc() string {
+	if x != nil {
+		return x.Rpc
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// ========================================
+// 비어있는 조건은 적용하지 않음, 호출한 tenant 의 이력만 조회됨
+type QueryAuditLogReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	CouponCode    string                 `protobuf:"bytes,2,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Since         string                 `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`        // RFC3339 또는 yyyy-mm-dd, 이 시각 포함
+	Until         string                 `protobuf:"bytes,5,opt,name=until,proto3" json:"until,omitempty"`        // RFC3339 또는 yyyy-mm-dd, 이 시각 미포함
+	AfterSeq      int64                  `protobuf:"varint,6,opt,name=afterSeq,proto3" json:"afterSeq,omitempty"` // 다음 페이지 조회용 : 이전 응답의 nextAfterSeq
+	Limit         int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`       // 기본 100, 최대 1000 (ExportAuditLog 에서는 무시)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryAuditLogReq) Reset() {
+	*x = QueryAuditLogReq{}
+	mi := &file_v1_audit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryAuditLogReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuditLogReq) ProtoMessage() {}
+
+func (x *QueryAuditLogReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_audit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAuditLogReq.ProtoReflect.Descriptor instead.
+func (*QueryAuditLogReq) Descriptor() ([]byte, []int) {
+	return file_v1_audit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryAuditLogReq) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *QueryAuditLogReq) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *QueryAuditLogReq) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *QueryAuditLogReq) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *QueryAuditLogReq) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+func (x *QueryAuditLogReq) GetAfterSeq() int64 {
+	if x != nil {
+		return x.AfterSeq
+	}
+	return 0
+}
+
+func (x *QueryAuditLogReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type QueryAuditLogRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Entries       []*AuditEntry          `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextAfterSeq  int64                  `protobuf:"varint,3,opt,name=nextAfterSeq,proto3" json:"nextAfterSeq,omitempty"` // 더 조회할 이력이 없으면 0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryAuditLogRes) Reset() {
+	*x = QueryAuditLogRes{}
+	mi := &file_v1_audit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryAuditLogRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuditLogRes) ProtoMessage() {}
+
+func (x *QueryAuditLogRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_audit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAuditLogRes.ProtoReflect.Descriptor instead.
+func (*QueryAuditLogRes) Descriptor() ([]byte, []int) {
+	return file_v1_audit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryAuditLogRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *QueryAuditLogRes) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *QueryAuditLogRes) GetNextAfterSeq() int64 {
+	if x != nil {
+		return x.NextAfterSeq
+	}
+	return 0
+}
+
+// JSON Lines 조각, 한 메시지 안에는 완전한 줄만 들어있음
+type ExportAuditLogRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lines         []byte                 `protobuf:"bytes,1,opt,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAuditLogRes) Reset() {
+	*x = ExportAuditLogRes{}
+	mi := &file_v1_audit_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAuditLogRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAuditLogRes) ProtoMessage() {}
+
+func (x *ExportAuditLogRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_audit_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAuditLogRes.ProtoReflect.Descriptor instead.
+func (*ExportAuditLogRes) Descriptor() ([]byte, []int) {
+	return file_v1_audit_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExportAuditLogRes) GetLines() []byte {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+var File_v1_audit_proto protoreflect.FileDescriptor
+
+const file_v1_audit_proto_rawDesc = "" +
+	"\n" +
+	"\x0ev1/audit.proto\x12\x02v1\x1a\x0fv1/common.proto\"\xb8\x02\n" +
+	"\n" +
+	"AuditEntry\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x03R\x03seq\x12\x12\n" +
+	"\x04time\x18\x02 \x01(\tR\x04time\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x1c\n" +
+	"\tactorRole\x18\x04 \x01(\tR\tactorRole\x12\x1a\n" +
+	"\btenantId\x18\x05 \x01(\tR\btenantId\x12\x10\n" +
+	"\x03rpc\x18\x06 \x01(\tR\x03rpc\x12\x1c\n" +
+	"\trequestId\x18\a \x01(\tR\trequestId\x12\x16\n" +
+	"\x06action\x18\b \x01(\tR\x06action\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\t \x01(\tR\n" +
+	"campaignId\x12\x1e\n" +
+	"\n" +
+	"couponCode\x18\n" +
+	" \x01(\tR\n" +
+	"couponCode\x12\x16\n" +
+	"\x06before\x18\v \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\f \x01(\tR\x05after\"\xc6\x01\n" +
+	"\x10QueryAuditLogReq\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1e\n" +
+	"\n" +
+	"couponCode\x18\x02 \x01(\tR\n" +
+	"couponCode\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x14\n" +
+	"\x05since\x18\x04 \x01(\tR\x05since\x12\x14\n" +
+	"\x05until\x18\x05 \x01(\tR\x05until\x12\x1a\n" +
+	"\bafterSeq\x18\x06 \x01(\x03R\bafterSeq\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\"\x8a\x01\n" +
+	"\x10QueryAuditLogRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\x12(\n" +
+	"\aentries\x18\x02 \x03(\v2\x0e.v1.AuditEntryR\aentries\x12\"\n" +
+	"\fnextAfterSeq\x18\x03 \x01(\x03R\fnextAfterSeq\")\n" +
+	"\x11ExportAuditLogRes\x12\x14\n" +
+	"\x05lines\x18\x01 \x01(\fR\x05lines2\x90\x01\n" +
+	"\fAuditService\x12=\n" +
+	"\rQueryAuditLog\x12\x14.v1.QueryAuditLogReq\x1a\x14.v1.QueryAuditLogRes\"\x00\x12A\n" +
+	"\x0eExportAuditLog\x12\x14.v1.QueryAuditLogReq\x1a\x15.v1.ExportAuditLogRes\"\x000\x01B8Z6github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1;v1b\x06proto3"
+
+var (
+	file_v1_audit_proto_rawDescOnce sync.Once
+	file_v1_audit_proto_rawDescData []byte
+)
+
+func file_v1_audit_proto_rawDescGZIP() []byte {
+	file_v1_audit_proto_rawDescOnce.Do(func() {
+		file_v1_audit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_audit_proto_rawDesc), len(file_v1_audit_proto_rawDesc)))
+	})
+	return file_v1_audit_proto_rawDescData
+}
+
+var file_v1_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_v1_audit_proto_goTypes = []any{
+	(*AuditEntry)(nil),        // 0: v1.AuditEntry
+	(*QueryAuditLogReq)(nil),  // 1: v1.QueryAuditLogReq
+	(*QueryAuditLogRes)(nil),  // 2: v1.QueryAuditLogRes
+	(*ExportAuditLogRes)(nil), // 3: v1.ExportAuditLogRes
+	(*BaseResponse)(nil),      // 4: v1.BaseResponse
+}
+var file_v1_audit_proto_depIdxs = []int32{
+	4, // 0: v1.QueryAuditLogRes.result:type_name -> v1.BaseResponse
+	0, // 1: v1.QueryAuditLogRes.entries:type_name -> v1.AuditEntry
+	1, // 2: v1.AuditService.QueryAuditLog:input_type -> v1.QueryAuditLogReq
+	1, // 3: v1.AuditService.ExportAuditLog:input_type -> v1.QueryAuditLogReq
+	2, // 4: v1.AuditService.QueryAuditLog:output_type -> v1.QueryAuditLogRes
+	3, // 5: v1.AuditService.ExportAuditLog:output_type -> v1.ExportAuditLogRes
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_v1_audit_proto_init() }
+func file_v1_audit_proto_init() {
+	if File_v1_audit_proto != nil {
+		return
+	}
+	file_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_audit_proto_rawDesc), len(file_v1_audit_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_audit_proto_goTypes,
+		DependencyIndexes: file_v1_audit_proto_depIdxs,
+		MessageInfos:      file_v1_audit_proto_msgTypes,
+	}.Build()
+	File_v1_audit_proto = out.File
+	file_v1_audit_proto_goTypes = nil
+	file_v1_audit_proto_depIdxs = nil
+}
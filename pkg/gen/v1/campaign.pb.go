@@ -26,7 +26,15 @@ type CampaignInfo struct {
 	CampaignId    string                 `protobuf:"bytes,1,opt,name=CampaignId,proto3" json:"CampaignId,omitempty"`
 	StartDate     string                 `protobuf:"bytes,2,opt,name=StartDate,proto3" json:"StartDate,omitempty"`
 	ExpiredDate   string                 `protobuf:"bytes,3,opt,name=ExpiredDate,proto3" json:"ExpiredDate,omitempty"`
-	AllCouponIds  []string               `protobuf:"bytes,4,rep,name=AllCouponIds,proto3" json:"AllCouponIds,omitempty"`
+	AllCouponIds  []string               `protobuf:"bytes,4,rep,name=AllCouponIds,proto3" json:"AllCouponIds,omitempty"` // admin 에게만 내려감, client 는 couponCount 만 받음
+	CouponCount   int64                  `protobuf:"varint,5,opt,name=couponCount,proto3" json:"couponCount,omitempty"`  // 캠페인 쿠폰 코드 수
+	Benefit       *Benefit               `protobuf:"bytes,6,opt,name=benefit,proto3" json:"benefit,omitempty"`
+	Rules         []*Rule                `protobuf:"bytes,7,rep,name=rules,proto3" json:"rules,omitempty"`
+	Schedule      *Schedule              `protobuf:"bytes,8,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	NextReleaseAt string                 `protobuf:"bytes,9,opt,name=nextReleaseAt,proto3" json:"nextReleaseAt,omitempty"` // RFC3339, 지금 발급할 수 없으면 다시 발급 가능해지는 시각, 발급 가능하면 다음 wave 시각, 없으면 빈 값
+	Raffle        *Raffle                `protobuf:"bytes,10,opt,name=raffle,proto3" json:"raffle,omitempty"`
+	Tiers         []*Tier                `protobuf:"bytes,11,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	Paused        bool                   `protobuf:"varint,12,opt,name=paused,proto3" json:"paused,omitempty"` // 발급이 멈춘 상태
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -89,32 +97,1624 @@ func (x *CampaignInfo) GetAllCouponIds() []string {
 	return nil
 }
 
+func (x *CampaignInfo) GetCouponCount() int64 {
+	if x != nil {
+		return x.CouponCount
+	}
+	return 0
+}
+
+func (x *CampaignInfo) GetBenefit() *Benefit {
+	if x != nil {
+		return x.Benefit
+	}
+	return nil
+}
+
+func (x *CampaignInfo) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *CampaignInfo) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *CampaignInfo) GetNextReleaseAt() string {
+	if x != nil {
+		return x.NextReleaseAt
+	}
+	return ""
+}
+
+func (x *CampaignInfo) GetRaffle() *Raffle {
+	if x != nil {
+		return x.Raffle
+	}
+	return nil
+}
+
+func (x *CampaignInfo) GetTiers() []*Tier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+func (x *CampaignInfo) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+// 발급 일정, 캠페인 기간 안에서 쿠폰을 나눠서 풀어줌
+type Schedule struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Waves             []*Wave                `protobuf:"bytes,1,rep,name=waves,proto3" json:"waves,omitempty"`          // 있으면 지금까지 풀린 wave 수량의 합까지만 발급
+	Windows           []*Window              `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows,omitempty"`      // 있으면 매일 이 시간대에만 발급
+	RateLimit         int32                  `protobuf:"varint,3,opt,name=rateLimit,proto3" json:"rateLimit,omitempty"` // 최근 ratePeriodSeconds 동안 최대 발급 수 (rolling)
+	RatePeriodSeconds int32                  `protobuf:"varint,4,opt,name=ratePeriodSeconds,proto3" json:"ratePeriodSeconds,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	mi := &file_v1_campaign_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Schedule) GetWaves() []*Wave {
+	if x != nil {
+		return x.Waves
+	}
+	return nil
+}
+
+func (x *Schedule) GetWindows() []*Window {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *Schedule) GetRateLimit() int32 {
+	if x != nil {
+		return x.RateLimit
+	}
+	return 0
+}
+
+func (x *Schedule) GetRatePeriodSeconds() int32 {
+	if x != nil {
+		return x.RatePeriodSeconds
+	}
+	return 0
+}
+
+type Wave struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	At            string                 `protobuf:"bytes,1,opt,name=at,proto3" json:"at,omitempty"` // RFC3339 또는 yyyy-mm-dd hh:mm (서버 local time)
+	Quota         int64                  `protobuf:"varint,2,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Wave) Reset() {
+	*x = Wave{}
+	mi := &file_v1_campaign_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Wave) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Wave) ProtoMessage() {}
+
+func (x *Wave) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Wave.ProtoReflect.Descriptor instead.
+func (*Wave) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Wave) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+func (x *Wave) GetQuota() int64 {
+	if x != nil {
+		return x.Quota
+	}
+	return 0
+}
+
+type Window struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"` // hh:mm (서버 local time)
+	End           string                 `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`     // hh:mm, 포함하지 않음
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Window) Reset() {
+	*x = Window{}
+	mi := &file_v1_campaign_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Window) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Window) ProtoMessage() {}
+
+func (x *Window) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Window.ProtoReflect.Descriptor instead.
+func (*Window) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Window) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *Window) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+// 추첨 캠페인, 선착순 대신 응모 기간 동안 RaffleService.EnterDraw 로 응모받고 drawAt 이 지나면 추첨해서 발급함
+// 시각은 RFC3339 또는 yyyy-mm-dd hh:mm (서버 local time)
+type Raffle struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	RegistrationStart string                 `protobuf:"bytes,1,opt,name=registrationStart,proto3" json:"registrationStart,omitempty"`
+	RegistrationEnd   string                 `protobuf:"bytes,2,opt,name=registrationEnd,proto3" json:"registrationEnd,omitempty"`
+	DrawAt            string                 `protobuf:"bytes,3,opt,name=drawAt,proto3" json:"drawAt,omitempty"`                    // registrationEnd 이후, 캠페인 종료 전
+	ClaimTtlSeconds   int32                  `protobuf:"varint,4,opt,name=claimTtlSeconds,proto3" json:"claimTtlSeconds,omitempty"` // 당첨 후 이 시간 안에 사용하지 않으면 회수해서 다음 대기자에게 발급, 0 이면 회수 안함
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Raffle) Reset() {
+	*x = Raffle{}
+	mi := &file_v1_campaign_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Raffle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Raffle) ProtoMessage() {}
+
+func (x *Raffle) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Raffle.ProtoReflect.Descriptor instead.
+func (*Raffle) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Raffle) GetRegistrationStart() string {
+	if x != nil {
+		return x.RegistrationStart
+	}
+	return ""
+}
+
+func (x *Raffle) GetRegistrationEnd() string {
+	if x != nil {
+		return x.RegistrationEnd
+	}
+	return ""
+}
+
+func (x *Raffle) GetDrawAt() string {
+	if x != nil {
+		return x.DrawAt
+	}
+	return ""
+}
+
+func (x *Raffle) GetClaimTtlSeconds() int32 {
+	if x != nil {
+		return x.ClaimTtlSeconds
+	}
+	return 0
+}
+
+// 우선 발급 그룹 (VIP 등), 멤버는 startAt 부터 예약 수량에서 먼저 발급받음
+// 멤버 : 인증 토큰의 tiers claim 에 name 이 있거나 userIds 에 있는 사용자
+// 시각은 RFC3339 또는 yyyy-mm-dd hh:mm (서버 local time)
+type Tier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	StartAt       string                 `protobuf:"bytes,2,opt,name=startAt,proto3" json:"startAt,omitempty"`     // 캠페인 시작 전이면 예약 수량을 먼저 발급 (early access)
+	Quota         int64                  `protobuf:"varint,3,opt,name=quota,proto3" json:"quota,omitempty"`        // maxCoupon 중 이 tier 에 예약하는 수량
+	ReleaseAt     string                 `protobuf:"bytes,4,opt,name=releaseAt,proto3" json:"releaseAt,omitempty"` // 남은 예약 수량이 일반 발급으로 돌아가는 시각, 비어있으면 캠페인 종료까지 예약
+	UserIds       []string               `protobuf:"bytes,5,rep,name=userIds,proto3" json:"userIds,omitempty"`
+	Reserved      int64                  `protobuf:"varint,6,opt,name=reserved,proto3" json:"reserved,omitempty"` // GetCampaign 응답에만 채워짐 : 남은 예약 수량
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tier) Reset() {
+	*x = Tier{}
+	mi := &file_v1_campaign_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tier) ProtoMessage() {}
+
+func (x *Tier) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tier.ProtoReflect.Descriptor instead.
+func (*Tier) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Tier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tier) GetStartAt() string {
+	if x != nil {
+		return x.StartAt
+	}
+	return ""
+}
+
+func (x *Tier) GetQuota() int64 {
+	if x != nil {
+		return x.Quota
+	}
+	return 0
+}
+
+func (x *Tier) GetReleaseAt() string {
+	if x != nil {
+		return x.ReleaseAt
+	}
+	return ""
+}
+
+func (x *Tier) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *Tier) GetReserved() int64 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+// 발급 조건, 캠페인의 조건은 모두 만족해야 발급됨
+// attribute 는 IssueCouponReq.attributes 의 key (userId 는 요청의 userId 로 항상 채워짐)
+type Rule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // 실패했을때 응답에 내려가는 이름, 비어있으면 attribute_op
+	Attribute     string                 `protobuf:"bytes,2,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	Op            string                 `protobuf:"bytes,3,opt,name=op,proto3" json:"op,omitempty"`         // eq, neq, in, not_in, exists, not_exists, gt, gte, lt, lte, within_days
+	Values        []string               `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty"` // in / not_in 은 여러개, exists / not_exists 는 없음, 나머지는 한개
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
+	mi := &file_v1_campaign_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule) ProtoMessage() {}
+
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Rule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Rule) GetAttribute() string {
+	if x != nil {
+		return x.Attribute
+	}
+	return ""
+}
+
+func (x *Rule) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *Rule) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// 쿠폰 혜택, 금액은 모두 통화의 최소 단위 (KRW 는 원, USD 는 cent)
+type Benefit struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Type              string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                            // percent, fixed, free_shipping, bxgy
+	PercentOff        int32                  `protobuf:"varint,2,opt,name=percentOff,proto3" json:"percentOff,omitempty"`               // percent : 1 ~ 100
+	AmountOff         int64                  `protobuf:"varint,3,opt,name=amountOff,proto3" json:"amountOff,omitempty"`                 // fixed : 할인 금액
+	Currency          string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`                    // ISO 4217, fixed 이거나 금액 조건이 있으면 필수
+	BuyQuantity       int32                  `protobuf:"varint,5,opt,name=buyQuantity,proto3" json:"buyQuantity,omitempty"`             // bxgy : buyQuantity 개를 사면
+	GetQuantity       int32                  `protobuf:"varint,6,opt,name=getQuantity,proto3" json:"getQuantity,omitempty"`             // bxgy : getQuantity 개 무료 (묶음 안에서 가장 싼 상품부터)
+	MinOrderAmount    int64                  `protobuf:"varint,7,opt,name=minOrderAmount,proto3" json:"minOrderAmount,omitempty"`       // 이 금액 이상 주문해야 사용 가능, 0 이면 제한 없음
+	MaxDiscountAmount int64                  `protobuf:"varint,8,opt,name=maxDiscountAmount,proto3" json:"maxDiscountAmount,omitempty"` // 할인 금액 상한, 0 이면 제한 없음
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Benefit) Reset() {
+	*x = Benefit{}
+	mi := &file_v1_campaign_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Benefit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Benefit) ProtoMessage() {}
+
+func (x *Benefit) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Benefit.ProtoReflect.Descriptor instead.
+func (*Benefit) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Benefit) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Benefit) GetPercentOff() int32 {
+	if x != nil {
+		return x.PercentOff
+	}
+	return 0
+}
+
+func (x *Benefit) GetAmountOff() int64 {
+	if x != nil {
+		return x.AmountOff
+	}
+	return 0
+}
+
+func (x *Benefit) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Benefit) GetBuyQuantity() int32 {
+	if x != nil {
+		return x.BuyQuantity
+	}
+	return 0
+}
+
+func (x *Benefit) GetGetQuantity() int32 {
+	if x != nil {
+		return x.GetQuantity
+	}
+	return 0
+}
+
+func (x *Benefit) GetMinOrderAmount() int64 {
+	if x != nil {
+		return x.MinOrderAmount
+	}
+	return 0
+}
+
+func (x *Benefit) GetMaxDiscountAmount() int64 {
+	if x != nil {
+		return x.MaxDiscountAmount
+	}
+	return 0
+}
+
 // ========================================
 type CreateCampaignReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
-	StartDate     string                 `protobuf:"bytes,2,opt,name=startDate,proto3" json:"startDate,omitempty"`
-	ExpiredDate   string                 `protobuf:"bytes,3,opt,name=expiredDate,proto3" json:"expiredDate,omitempty"`
-	MaxCoupon     int64                  `protobuf:"varint,4,opt,name=maxCoupon,proto3" json:"maxCoupon,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	ExpiredDate   string                 `protobuf:"bytes,3,opt,name=expiredDate,proto3" json:"expiredDate,omitempty"`
+	MaxCoupon     int64                  `protobuf:"varint,4,opt,name=maxCoupon,proto3" json:"maxCoupon,omitempty"`
+	Benefit       *Benefit               `protobuf:"bytes,5,opt,name=benefit,proto3" json:"benefit,omitempty"`   // 없으면 할인 금액 없이 발급/사용만 관리
+	Rules         []*Rule                `protobuf:"bytes,6,rep,name=rules,proto3" json:"rules,omitempty"`       // 없으면 누구나 발급 가능
+	Schedule      *Schedule              `protobuf:"bytes,7,opt,name=schedule,proto3" json:"schedule,omitempty"` // 없으면 기간 안에서 제한 없이 발급
+	Raffle        *Raffle                `protobuf:"bytes,8,opt,name=raffle,proto3" json:"raffle,omitempty"`     // 있으면 선착순 대신 추첨으로 발급 (schedule 과 같이 쓸 수 없음)
+	Tiers         []*Tier                `protobuf:"bytes,9,rep,name=tiers,proto3" json:"tiers,omitempty"`       // 우선 발급 그룹 (schedule, raffle 과 같이 쓸 수 없음)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCampaignReq) Reset() {
+	*x = CreateCampaignReq{}
+	mi := &file_v1_campaign_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCampaignReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCampaignReq) ProtoMessage() {}
+
+func (x *CreateCampaignReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCampaignReq.ProtoReflect.Descriptor instead.
+func (*CreateCampaignReq) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateCampaignReq) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *CreateCampaignReq) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CreateCampaignReq) GetExpiredDate() string {
+	if x != nil {
+		return x.ExpiredDate
+	}
+	return ""
+}
+
+func (x *CreateCampaignReq) GetMaxCoupon() int64 {
+	if x != nil {
+		return x.MaxCoupon
+	}
+	return 0
+}
+
+func (x *CreateCampaignReq) GetBenefit() *Benefit {
+	if x != nil {
+		return x.Benefit
+	}
+	return nil
+}
+
+func (x *CreateCampaignReq) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *CreateCampaignReq) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *CreateCampaignReq) GetRaffle() *Raffle {
+	if x != nil {
+		return x.Raffle
+	}
+	return nil
+}
+
+func (x *CreateCampaignReq) GetTiers() []*Tier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+type CreateCampaignRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCampaignRes) Reset() {
+	*x = CreateCampaignRes{}
+	mi := &file_v1_campaign_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCampaignRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCampaignRes) ProtoMessage() {}
+
+func (x *CreateCampaignRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCampaignRes.ProtoReflect.Descriptor instead.
+func (*CreateCampaignRes) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateCampaignRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type GetCampaignReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCampaignReq) Reset() {
+	*x = GetCampaignReq{}
+	mi := &file_v1_campaign_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCampaignReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCampaignReq) ProtoMessage() {}
+
+func (x *GetCampaignReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCampaignReq.ProtoReflect.Descriptor instead.
+func (*GetCampaignReq) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetCampaignReq) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+type GetCampaignRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Info          *CampaignInfo          `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCampaignRes) Reset() {
+	*x = GetCampaignRes{}
+	mi := &file_v1_campaign_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCampaignRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCampaignRes) ProtoMessage() {}
+
+func (x *GetCampaignRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCampaignRes.ProtoReflect.Descriptor instead.
+func (*GetCampaignRes) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetCampaignRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *GetCampaignRes) GetInfo() *CampaignInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// tenant 범위 안의 캠페인 목록 (AllCouponIds 는 비어있음)
+type ListCampaignsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCampaignsReq) Reset() {
+	*x = ListCampaignsReq{}
+	mi := &file_v1_campaign_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampaignsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampaignsReq) ProtoMessage() {}
+
+func (x *ListCampaignsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampaignsReq.ProtoReflect.Descriptor instead.
+func (*ListCampaignsReq) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{12}
+}
+
+type ListCampaignsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Campaigns     []*CampaignInfo        `protobuf:"bytes,2,rep,name=campaigns,proto3" json:"campaigns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCampaignsRes) Reset() {
+	*x = ListCampaignsRes{}
+	mi := &file_v1_campaign_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCampaignsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCampaignsRes) ProtoMessage() {}
+
+func (x *ListCampaignsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCampaignsRes.ProtoReflect.Descriptor instead.
+func (*ListCampaignsRes) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListCampaignsRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ListCampaignsRes) GetCampaigns() []*CampaignInfo {
+	if x != nil {
+		return x.Campaigns
+	}
+	return nil
+}
+
+// 캠페인 쿠폰 수를 늘림, 늘어난 쿠폰은 대기자에게 먼저 발급되고 남은 쿠폰은 일반 발급됨
+type UpdateCampaignReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	MaxCoupon     int64                  `protobuf:"varint,2,opt,name=maxCoupon,proto3" json:"maxCoupon,omitempty"` // 지금보다 작을 수 없음
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCampaignReq) Reset() {
+	*x = UpdateCampaignReq{}
+	mi := &file_v1_campaign_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCampaignReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCampaignReq) ProtoMessage() {}
+
+func (x *UpdateCampaignReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCampaignReq.ProtoReflect.Descriptor instead.
+func (*UpdateCampaignReq) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateCampaignReq) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *UpdateCampaignReq) GetMaxCoupon() int64 {
+	if x != nil {
+		return x.MaxCoupon
+	}
+	return 0
+}
+
+type UpdateCampaignRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCampaignRes) Reset() {
+	*x = UpdateCampaignRes{}
+	mi := &file_v1_campaign_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCampaignRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCampaignRes) ProtoMessage() {}
+
+func (x *UpdateCampaignRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCampaignRes.ProtoReflect.Descriptor instead.
+func (*UpdateCampaignRes) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateCampaignRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// 캠페인과 쿠폰을 지움, 종료 전 캠페인에 사용자가 가진 쿠폰(issued, held)이 있으면 force 일때만 지움
+type DeleteCampaignReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	Force         bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCampaignReq) Reset() {
+	*x = DeleteCampaignReq{}
+	mi := &file_v1_campaign_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCampaignReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCampaignReq) ProtoMessage() {}
+
+func (x *DeleteCampaignReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCampaignReq.ProtoReflect.Descriptor instead.
+func (*DeleteCampaignReq) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteCampaignReq) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *DeleteCampaignReq) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type DeleteCampaignRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCampaignRes) Reset() {
+	*x = DeleteCampaignRes{}
+	mi := &file_v1_campaign_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCampaignRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCampaignRes) ProtoMessage() {}
+
+func (x *DeleteCampaignRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCampaignRes.ProtoReflect.Descriptor instead.
+func (*DeleteCampaignRes) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteCampaignRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// 캠페인 발급을 멈춤 (대량 발급 중 장애 대응 등), 사용은 그대로 가능하고 대기자 발급, 추첨도 다시 시작할때까지 미뤄짐
+type PauseCampaignReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // 감사 이력에 남김
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseCampaignReq) Reset() {
+	*x = PauseCampaignReq{}
+	mi := &file_v1_campaign_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseCampaignReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseCampaignReq) ProtoMessage() {}
+
+func (x *PauseCampaignReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseCampaignReq.ProtoReflect.Descriptor instead.
+func (*PauseCampaignReq) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PauseCampaignReq) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *PauseCampaignReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PauseCampaignRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseCampaignRes) Reset() {
+	*x = PauseCampaignRes{}
+	mi := &file_v1_campaign_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseCampaignRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseCampaignRes) ProtoMessage() {}
+
+func (x *PauseCampaignRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseCampaignRes.ProtoReflect.Descriptor instead.
+func (*PauseCampaignRes) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PauseCampaignRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// 멈춘 캠페인 발급을 다시 시작함, 그동안 쌓인 대기자에게 먼저 발급됨
+type ResumeCampaignReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeCampaignReq) Reset() {
+	*x = ResumeCampaignReq{}
+	mi := &file_v1_campaign_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeCampaignReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeCampaignReq) ProtoMessage() {}
+
+func (x *ResumeCampaignReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeCampaignReq.ProtoReflect.Descriptor instead.
+func (*ResumeCampaignReq) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ResumeCampaignReq) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+type ResumeCampaignRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeCampaignRes) Reset() {
+	*x = ResumeCampaignRes{}
+	mi := &file_v1_campaign_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeCampaignRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeCampaignRes) ProtoMessage() {}
+
+func (x *ResumeCampaignRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeCampaignRes.ProtoReflect.Descriptor instead.
+func (*ResumeCampaignRes) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ResumeCampaignRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// 대시보드용 캠페인 현황, client 가 주기적으로 호출해서 이전 응답과의 차이로 초당 발급 수 등을 계산함
+type GetCampaignStatsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"` // 있으면 이 캠페인만 (종료된 캠페인도), 최근 이벤트도 같이 내려감
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCampaignStatsReq) Reset() {
+	*x = GetCampaignStatsReq{}
+	mi := &file_v1_campaign_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCampaignStatsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCampaignStatsReq) ProtoMessage() {}
+
+func (x *GetCampaignStatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCampaignStatsReq.ProtoReflect.Descriptor instead.
+func (*GetCampaignStatsReq) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetCampaignStatsReq) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+type GetCampaignStatsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Time          string                 `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`           // RFC3339Nano, 집계한 서버 시각
+	Campaigns     []*CampaignStats       `protobuf:"bytes,3,rep,name=campaigns,proto3" json:"campaigns,omitempty"` // campaignId 가 없으면 종료되지 않은 캠페인 전체
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCampaignStatsRes) Reset() {
+	*x = GetCampaignStatsRes{}
+	mi := &file_v1_campaign_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCampaignStatsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCampaignStatsRes) ProtoMessage() {}
+
+func (x *GetCampaignStatsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCampaignStatsRes.ProtoReflect.Descriptor instead.
+func (*GetCampaignStatsRes) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetCampaignStatsRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *GetCampaignStatsRes) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *GetCampaignStatsRes) GetCampaigns() []*CampaignStats {
+	if x != nil {
+		return x.Campaigns
+	}
+	return nil
+}
+
+// 수치는 모두 누적값
+type CampaignStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=startDate,proto3" json:"startDate,omitempty"`     // RFC3339
+	ExpiredDate   string                 `protobuf:"bytes,3,opt,name=expiredDate,proto3" json:"expiredDate,omitempty"` // RFC3339
+	MaxCoupon     int64                  `protobuf:"varint,4,opt,name=maxCoupon,proto3" json:"maxCoupon,omitempty"`
+	Remaining     int64                  `protobuf:"varint,5,opt,name=remaining,proto3" json:"remaining,omitempty"` // 아직 발급되지 않은 쿠폰 수
+	Issued        int64                  `protobuf:"varint,6,opt,name=issued,proto3" json:"issued,omitempty"`       // 발급 대기 목록에서 나간 쿠폰 수 (held, redeemed, revoked, expired 포함)
+	Redeemed      int64                  `protobuf:"varint,7,opt,name=redeemed,proto3" json:"redeemed,omitempty"`
+	Failures      int64                  `protobuf:"varint,8,opt,name=failures,proto3" json:"failures,omitempty"` // 서버 시작 후 실패한 발급/사용 요청 수 (소진, 발급 조건, 대기자 등록 포함)
+	Waitlist      int32                  `protobuf:"varint,9,opt,name=waitlist,proto3" json:"waitlist,omitempty"`
+	Paused        bool                   `protobuf:"varint,10,opt,name=paused,proto3" json:"paused,omitempty"`
+	RecentEvents  []*CampaignEvent       `protobuf:"bytes,11,rep,name=recentEvents,proto3" json:"recentEvents,omitempty"` // campaignId 를 지정한 경우만, 최근 것부터 최대 50건
+	Held          int64                  `protobuf:"varint,12,opt,name=held,proto3" json:"held,omitempty"`                // 결제 대기로 예약된 쿠폰 수 (issued 에 포함)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CampaignStats) Reset() {
+	*x = CampaignStats{}
+	mi := &file_v1_campaign_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CampaignStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampaignStats) ProtoMessage() {}
+
+func (x *CampaignStats) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampaignStats.ProtoReflect.Descriptor instead.
+func (*CampaignStats) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CampaignStats) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *CampaignStats) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CampaignStats) GetExpiredDate() string {
+	if x != nil {
+		return x.ExpiredDate
+	}
+	return ""
+}
+
+func (x *CampaignStats) GetMaxCoupon() int64 {
+	if x != nil {
+		return x.MaxCoupon
+	}
+	return 0
+}
+
+func (x *CampaignStats) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *CampaignStats) GetIssued() int64 {
+	if x != nil {
+		return x.Issued
+	}
+	return 0
+}
+
+func (x *CampaignStats) GetRedeemed() int64 {
+	if x != nil {
+		return x.Redeemed
+	}
+	return 0
+}
+
+func (x *CampaignStats) GetFailures() int64 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *CampaignStats) GetWaitlist() int32 {
+	if x != nil {
+		return x.Waitlist
+	}
+	return 0
+}
+
+func (x *CampaignStats) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *CampaignStats) GetRecentEvents() []*CampaignEvent {
+	if x != nil {
+		return x.RecentEvents
+	}
+	return nil
+}
+
+func (x *CampaignStats) GetHeld() int64 {
+	if x != nil {
+		return x.Held
+	}
+	return 0
+}
+
+// 최근 이벤트, 서버 메모리에만 있어서 재시작하면 사라짐
+type CampaignEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"` // RFC3339Nano
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // coupon.issued, coupon.redeemed 등 감사 이력 action, 실패는 issue.failed, redeem.failed
+	CouponCode    string                 `protobuf:"bytes,3,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	UserId        string                 `protobuf:"bytes,4,opt,name=userId,proto3" json:"userId,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"` // 실패 사유 (no_more_coupon, not_eligible, waitlisted 등)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CampaignEvent) Reset() {
+	*x = CampaignEvent{}
+	mi := &file_v1_campaign_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CampaignEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampaignEvent) ProtoMessage() {}
+
+func (x *CampaignEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampaignEvent.ProtoReflect.Descriptor instead.
+func (*CampaignEvent) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CampaignEvent) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *CampaignEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CampaignEvent) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *CampaignEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CampaignEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 제휴사 상품권 코드 등 외부에서 받은 코드로 쿠폰을 만듦 (client streaming)
+// campaignId, pattern, dryRun 은 첫 메시지에만 있으면 됨, 코드는 여러 메시지로 나눠서 보냄
+// 가져온 쿠폰은 캠페인 쿠폰 수(maxCoupon)에 더해지고 IssueCoupon 으로 발급됨
+type ImportCouponsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	Pattern       string                 `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"` // 있으면 코드가 이 정규식에 맞아야 함 (RE2)
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dryRun,proto3" json:"dryRun,omitempty"`  // 가져오지 않고 검증 결과만 반환
+	Rows          []*ImportCouponRow     `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCampaignReq) Reset() {
-	*x = CreateCampaignReq{}
-	mi := &file_v1_campaign_proto_msgTypes[1]
+func (x *ImportCouponsReq) Reset() {
+	*x = ImportCouponsReq{}
+	mi := &file_v1_campaign_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCampaignReq) String() string {
+func (x *ImportCouponsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCampaignReq) ProtoMessage() {}
+func (*ImportCouponsReq) ProtoMessage() {}
 
-func (x *CreateCampaignReq) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_campaign_proto_msgTypes[1]
+func (x *ImportCouponsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -125,61 +1725,116 @@ func (x *CreateCampaignReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCampaignReq.ProtoReflect.Descriptor instead.
-func (*CreateCampaignReq) Descriptor() ([]byte, []int) {
-	return file_v1_campaign_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ImportCouponsReq.ProtoReflect.Descriptor instead.
+func (*ImportCouponsReq) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *CreateCampaignReq) GetCampaignId() string {
+func (x *ImportCouponsReq) GetCampaignId() string {
 	if x != nil {
 		return x.CampaignId
 	}
 	return ""
 }
 
-func (x *CreateCampaignReq) GetStartDate() string {
+func (x *ImportCouponsReq) GetPattern() string {
 	if x != nil {
-		return x.StartDate
+		return x.Pattern
 	}
 	return ""
 }
 
-func (x *CreateCampaignReq) GetExpiredDate() string {
+func (x *ImportCouponsReq) GetDryRun() bool {
 	if x != nil {
-		return x.ExpiredDate
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-func (x *CreateCampaignReq) GetMaxCoupon() int64 {
+func (x *ImportCouponsReq) GetRows() []*ImportCouponRow {
 	if x != nil {
-		return x.MaxCoupon
+		return x.Rows
+	}
+	return nil
+}
+
+type ImportCouponRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int64                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"` // 원본 파일의 행 번호, 0 이면 보낸 순서
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportCouponRow) Reset() {
+	*x = ImportCouponRow{}
+	mi := &file_v1_campaign_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportCouponRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCouponRow) ProtoMessage() {}
+
+func (x *ImportCouponRow) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCouponRow.ProtoReflect.Descriptor instead.
+func (*ImportCouponRow) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ImportCouponRow) GetLine() int64 {
+	if x != nil {
+		return x.Line
 	}
 	return 0
 }
 
-type CreateCampaignRes struct {
+func (x *ImportCouponRow) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ImportCouponsRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Imported      int64                  `protobuf:"varint,2,opt,name=imported,proto3" json:"imported,omitempty"` // dryRun 이면 가져올 수 있는 코드 수
+	Rejected      []*RejectedCoupon      `protobuf:"bytes,3,rep,name=rejected,proto3" json:"rejected,omitempty"`
+	MaxCoupon     int64                  `protobuf:"varint,4,opt,name=maxCoupon,proto3" json:"maxCoupon,omitempty"` // 가져온 뒤 캠페인 쿠폰 수
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCampaignRes) Reset() {
-	*x = CreateCampaignRes{}
-	mi := &file_v1_campaign_proto_msgTypes[2]
+func (x *ImportCouponsRes) Reset() {
+	*x = ImportCouponsRes{}
+	mi := &file_v1_campaign_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCampaignRes) String() string {
+func (x *ImportCouponsRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCampaignRes) ProtoMessage() {}
+func (*ImportCouponsRes) ProtoMessage() {}
 
-func (x *CreateCampaignRes) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_campaign_proto_msgTypes[2]
+func (x *ImportCouponsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -190,40 +1845,64 @@ func (x *CreateCampaignRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCampaignRes.ProtoReflect.Descriptor instead.
-func (*CreateCampaignRes) Descriptor() ([]byte, []int) {
-	return file_v1_campaign_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ImportCouponsRes.ProtoReflect.Descriptor instead.
+func (*ImportCouponsRes) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *CreateCampaignRes) GetResult() *BaseResponse {
+func (x *ImportCouponsRes) GetResult() *BaseResponse {
 	if x != nil {
 		return x.Result
 	}
 	return nil
 }
 
-type GetCampaignReq struct {
+func (x *ImportCouponsRes) GetImported() int64 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportCouponsRes) GetRejected() []*RejectedCoupon {
+	if x != nil {
+		return x.Rejected
+	}
+	return nil
+}
+
+func (x *ImportCouponsRes) GetMaxCoupon() int64 {
+	if x != nil {
+		return x.MaxCoupon
+	}
+	return 0
+}
+
+type RejectedCoupon struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	Line          int64                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // invalid_format, duplicate_in_file, already_exists
+	Detail        string                 `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCampaignReq) Reset() {
-	*x = GetCampaignReq{}
-	mi := &file_v1_campaign_proto_msgTypes[3]
+func (x *RejectedCoupon) Reset() {
+	*x = RejectedCoupon{}
+	mi := &file_v1_campaign_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCampaignReq) String() string {
+func (x *RejectedCoupon) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCampaignReq) ProtoMessage() {}
+func (*RejectedCoupon) ProtoMessage() {}
 
-func (x *GetCampaignReq) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_campaign_proto_msgTypes[3]
+func (x *RejectedCoupon) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -234,41 +1913,68 @@ func (x *GetCampaignReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCampaignReq.ProtoReflect.Descriptor instead.
-func (*GetCampaignReq) Descriptor() ([]byte, []int) {
-	return file_v1_campaign_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use RejectedCoupon.ProtoReflect.Descriptor instead.
+func (*RejectedCoupon) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetCampaignReq) GetCampaignId() string {
+func (x *RejectedCoupon) GetLine() int64 {
 	if x != nil {
-		return x.CampaignId
+		return x.Line
+	}
+	return 0
+}
+
+func (x *RejectedCoupon) GetCode() string {
+	if x != nil {
+		return x.Code
 	}
 	return ""
 }
 
-type GetCampaignRes struct {
+func (x *RejectedCoupon) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RejectedCoupon) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+// 캠페인의 모든 쿠폰을 코드 순서로 내보냄 (server streaming)
+// 쿠폰을 나눠서 복사하기 때문에 내보내는 동안 바뀐 쿠폰은 복사한 시점의 상태로 나감
+type ExportCampaignReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	Info          *CampaignInfo          `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`       // csv (기본값), jsonl
+	States        []string               `protobuf:"bytes,3,rep,name=states,proto3" json:"states,omitempty"`       // 비어있으면 전체 : available, issued, held, redeemed, revoked, expired
+	TimeField     string                 `protobuf:"bytes,4,opt,name=timeField,proto3" json:"timeField,omitempty"` // from / to 를 적용할 시각 : issued (기본값), redeemed
+	From          string                 `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`           // RFC3339 또는 yyyy-mm-dd hh:mm, 포함
+	To            string                 `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`               // 포함하지 않음, 시간 조건이 있으면 해당 시각이 없는 쿠폰은 제외
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCampaignRes) Reset() {
-	*x = GetCampaignRes{}
-	mi := &file_v1_campaign_proto_msgTypes[4]
+func (x *ExportCampaignReq) Reset() {
+	*x = ExportCampaignReq{}
+	mi := &file_v1_campaign_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCampaignRes) String() string {
+func (x *ExportCampaignReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCampaignRes) ProtoMessage() {}
+func (*ExportCampaignReq) ProtoMessage() {}
 
-func (x *GetCampaignRes) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_campaign_proto_msgTypes[4]
+func (x *ExportCampaignReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -279,21 +1985,95 @@ func (x *GetCampaignRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCampaignRes.ProtoReflect.Descriptor instead.
-func (*GetCampaignRes) Descriptor() ([]byte, []int) {
-	return file_v1_campaign_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ExportCampaignReq.ProtoReflect.Descriptor instead.
+func (*ExportCampaignReq) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetCampaignRes) GetResult() *BaseResponse {
+func (x *ExportCampaignReq) GetCampaignId() string {
 	if x != nil {
-		return x.Result
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *ExportCampaignReq) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportCampaignReq) GetStates() []string {
+	if x != nil {
+		return x.States
 	}
 	return nil
 }
 
-func (x *GetCampaignRes) GetInfo() *CampaignInfo {
+func (x *ExportCampaignReq) GetTimeField() string {
 	if x != nil {
-		return x.Info
+		return x.TimeField
+	}
+	return ""
+}
+
+func (x *ExportCampaignReq) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ExportCampaignReq) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+// csv 는 첫 메시지에 header 가 들어감, 여러 줄씩 묶어서 보내고 줄 중간에서 나누지 않음
+// 컬럼 : couponCode, state, userId, tier, issuedAt, redeemedAt (시각은 RFC3339, 없으면 빈 값)
+type ExportCampaignRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCampaignRes) Reset() {
+	*x = ExportCampaignRes{}
+	mi := &file_v1_campaign_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCampaignRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCampaignRes) ProtoMessage() {}
+
+func (x *ExportCampaignRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_campaign_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCampaignRes.ProtoReflect.Descriptor instead.
+func (*ExportCampaignRes) Descriptor() ([]byte, []int) {
+	return file_v1_campaign_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ExportCampaignRes) GetData() []byte {
+	if x != nil {
+		return x.Data
 	}
 	return nil
 }
@@ -302,21 +2082,77 @@ var File_v1_campaign_proto protoreflect.FileDescriptor
 
 const file_v1_campaign_proto_rawDesc = "" +
 	"\n" +
-	"\x11v1/campaign.proto\x12\x02v1\x1a\x0fv1/common.proto\"\x92\x01\n" +
+	"\x11v1/campaign.proto\x12\x02v1\x1a\x0fv1/common.proto\"\xa7\x03\n" +
 	"\fCampaignInfo\x12\x1e\n" +
 	"\n" +
 	"CampaignId\x18\x01 \x01(\tR\n" +
 	"CampaignId\x12\x1c\n" +
 	"\tStartDate\x18\x02 \x01(\tR\tStartDate\x12 \n" +
 	"\vExpiredDate\x18\x03 \x01(\tR\vExpiredDate\x12\"\n" +
-	"\fAllCouponIds\x18\x04 \x03(\tR\fAllCouponIds\"\x91\x01\n" +
+	"\fAllCouponIds\x18\x04 \x03(\tR\fAllCouponIds\x12 \n" +
+	"\vcouponCount\x18\x05 \x01(\x03R\vcouponCount\x12%\n" +
+	"\abenefit\x18\x06 \x01(\v2\v.v1.BenefitR\abenefit\x12\x1e\n" +
+	"\x05rules\x18\a \x03(\v2\b.v1.RuleR\x05rules\x12(\n" +
+	"\bschedule\x18\b \x01(\v2\f.v1.ScheduleR\bschedule\x12$\n" +
+	"\rnextReleaseAt\x18\t \x01(\tR\rnextReleaseAt\x12\"\n" +
+	"\x06raffle\x18\n" +
+	" \x01(\v2\n" +
+	".v1.RaffleR\x06raffle\x12\x1e\n" +
+	"\x05tiers\x18\v \x03(\v2\b.v1.TierR\x05tiers\x12\x16\n" +
+	"\x06paused\x18\f \x01(\bR\x06paused\"\x9c\x01\n" +
+	"\bSchedule\x12\x1e\n" +
+	"\x05waves\x18\x01 \x03(\v2\b.v1.WaveR\x05waves\x12$\n" +
+	"\awindows\x18\x02 \x03(\v2\n" +
+	".v1.WindowR\awindows\x12\x1c\n" +
+	"\trateLimit\x18\x03 \x01(\x05R\trateLimit\x12,\n" +
+	"\x11ratePeriodSeconds\x18\x04 \x01(\x05R\x11ratePeriodSeconds\",\n" +
+	"\x04Wave\x12\x0e\n" +
+	"\x02at\x18\x01 \x01(\tR\x02at\x12\x14\n" +
+	"\x05quota\x18\x02 \x01(\x03R\x05quota\"0\n" +
+	"\x06Window\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\tR\x03end\"\xa2\x01\n" +
+	"\x06Raffle\x12,\n" +
+	"\x11registrationStart\x18\x01 \x01(\tR\x11registrationStart\x12(\n" +
+	"\x0fregistrationEnd\x18\x02 \x01(\tR\x0fregistrationEnd\x12\x16\n" +
+	"\x06drawAt\x18\x03 \x01(\tR\x06drawAt\x12(\n" +
+	"\x0fclaimTtlSeconds\x18\x04 \x01(\x05R\x0fclaimTtlSeconds\"\x9e\x01\n" +
+	"\x04Tier\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\astartAt\x18\x02 \x01(\tR\astartAt\x12\x14\n" +
+	"\x05quota\x18\x03 \x01(\x03R\x05quota\x12\x1c\n" +
+	"\treleaseAt\x18\x04 \x01(\tR\treleaseAt\x12\x18\n" +
+	"\auserIds\x18\x05 \x03(\tR\auserIds\x12\x1a\n" +
+	"\breserved\x18\x06 \x01(\x03R\breserved\"`\n" +
+	"\x04Rule\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tattribute\x18\x02 \x01(\tR\tattribute\x12\x0e\n" +
+	"\x02op\x18\x03 \x01(\tR\x02op\x12\x16\n" +
+	"\x06values\x18\x04 \x03(\tR\x06values\"\x91\x02\n" +
+	"\aBenefit\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1e\n" +
+	"\n" +
+	"percentOff\x18\x02 \x01(\x05R\n" +
+	"percentOff\x12\x1c\n" +
+	"\tamountOff\x18\x03 \x01(\x03R\tamountOff\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12 \n" +
+	"\vbuyQuantity\x18\x05 \x01(\x05R\vbuyQuantity\x12 \n" +
+	"\vgetQuantity\x18\x06 \x01(\x05R\vgetQuantity\x12&\n" +
+	"\x0eminOrderAmount\x18\a \x01(\x03R\x0eminOrderAmount\x12,\n" +
+	"\x11maxDiscountAmount\x18\b \x01(\x03R\x11maxDiscountAmount\"\xc6\x02\n" +
 	"\x11CreateCampaignReq\x12\x1e\n" +
 	"\n" +
 	"campaignId\x18\x01 \x01(\tR\n" +
 	"campaignId\x12\x1c\n" +
 	"\tstartDate\x18\x02 \x01(\tR\tstartDate\x12 \n" +
 	"\vexpiredDate\x18\x03 \x01(\tR\vexpiredDate\x12\x1c\n" +
-	"\tmaxCoupon\x18\x04 \x01(\x03R\tmaxCoupon\"=\n" +
+	"\tmaxCoupon\x18\x04 \x01(\x03R\tmaxCoupon\x12%\n" +
+	"\abenefit\x18\x05 \x01(\v2\v.v1.BenefitR\abenefit\x12\x1e\n" +
+	"\x05rules\x18\x06 \x03(\v2\b.v1.RuleR\x05rules\x12(\n" +
+	"\bschedule\x18\a \x01(\v2\f.v1.ScheduleR\bschedule\x12\"\n" +
+	"\x06raffle\x18\b \x01(\v2\n" +
+	".v1.RaffleR\x06raffle\x12\x1e\n" +
+	"\x05tiers\x18\t \x03(\v2\b.v1.TierR\x05tiers\"=\n" +
 	"\x11CreateCampaignRes\x12(\n" +
 	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\"0\n" +
 	"\x0eGetCampaignReq\x12\x1e\n" +
@@ -325,10 +2161,112 @@ const file_v1_campaign_proto_rawDesc = "" +
 	"campaignId\"`\n" +
 	"\x0eGetCampaignRes\x12(\n" +
 	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\x12$\n" +
-	"\x04info\x18\x02 \x01(\v2\x10.v1.CampaignInfoR\x04info2\x8c\x01\n" +
+	"\x04info\x18\x02 \x01(\v2\x10.v1.CampaignInfoR\x04info\"\x12\n" +
+	"\x10ListCampaignsReq\"l\n" +
+	"\x10ListCampaignsRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\x12.\n" +
+	"\tcampaigns\x18\x02 \x03(\v2\x10.v1.CampaignInfoR\tcampaigns\"Q\n" +
+	"\x11UpdateCampaignReq\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1c\n" +
+	"\tmaxCoupon\x18\x02 \x01(\x03R\tmaxCoupon\"=\n" +
+	"\x11UpdateCampaignRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\"I\n" +
+	"\x11DeleteCampaignReq\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"=\n" +
+	"\x11DeleteCampaignRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\"J\n" +
+	"\x10PauseCampaignReq\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"<\n" +
+	"\x10PauseCampaignRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\"3\n" +
+	"\x11ResumeCampaignReq\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\"=\n" +
+	"\x11ResumeCampaignRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\"5\n" +
+	"\x13GetCampaignStatsReq\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\"\x84\x01\n" +
+	"\x13GetCampaignStatsRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\x12\x12\n" +
+	"\x04time\x18\x02 \x01(\tR\x04time\x12/\n" +
+	"\tcampaigns\x18\x03 \x03(\v2\x11.v1.CampaignStatsR\tcampaigns\"\xfa\x02\n" +
+	"\rCampaignStats\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1c\n" +
+	"\tstartDate\x18\x02 \x01(\tR\tstartDate\x12 \n" +
+	"\vexpiredDate\x18\x03 \x01(\tR\vexpiredDate\x12\x1c\n" +
+	"\tmaxCoupon\x18\x04 \x01(\x03R\tmaxCoupon\x12\x1c\n" +
+	"\tremaining\x18\x05 \x01(\x03R\tremaining\x12\x16\n" +
+	"\x06issued\x18\x06 \x01(\x03R\x06issued\x12\x1a\n" +
+	"\bredeemed\x18\a \x01(\x03R\bredeemed\x12\x1a\n" +
+	"\bfailures\x18\b \x01(\x03R\bfailures\x12\x1a\n" +
+	"\bwaitlist\x18\t \x01(\x05R\bwaitlist\x12\x16\n" +
+	"\x06paused\x18\n" +
+	" \x01(\bR\x06paused\x125\n" +
+	"\frecentEvents\x18\v \x03(\v2\x11.v1.CampaignEventR\frecentEvents\x12\x12\n" +
+	"\x04held\x18\f \x01(\x03R\x04held\"\x87\x01\n" +
+	"\rCampaignEvent\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\tR\x04time\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1e\n" +
+	"\n" +
+	"couponCode\x18\x03 \x01(\tR\n" +
+	"couponCode\x12\x16\n" +
+	"\x06userId\x18\x04 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\x8d\x01\n" +
+	"\x10ImportCouponsReq\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x18\n" +
+	"\apattern\x18\x02 \x01(\tR\apattern\x12\x16\n" +
+	"\x06dryRun\x18\x03 \x01(\bR\x06dryRun\x12'\n" +
+	"\x04rows\x18\x04 \x03(\v2\x13.v1.ImportCouponRowR\x04rows\"9\n" +
+	"\x0fImportCouponRow\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x03R\x04line\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\xa6\x01\n" +
+	"\x10ImportCouponsRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\x12\x1a\n" +
+	"\bimported\x18\x02 \x01(\x03R\bimported\x12.\n" +
+	"\brejected\x18\x03 \x03(\v2\x12.v1.RejectedCouponR\brejected\x12\x1c\n" +
+	"\tmaxCoupon\x18\x04 \x01(\x03R\tmaxCoupon\"h\n" +
+	"\x0eRejectedCoupon\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x03R\x04line\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06detail\x18\x04 \x01(\tR\x06detail\"\xa5\x01\n" +
+	"\x11ExportCampaignReq\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x16\n" +
+	"\x06states\x18\x03 \x03(\tR\x06states\x12\x1c\n" +
+	"\ttimeField\x18\x04 \x01(\tR\ttimeField\x12\x12\n" +
+	"\x04from\x18\x05 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x06 \x01(\tR\x02to\"'\n" +
+	"\x11ExportCampaignRes\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data2\x9d\x05\n" +
 	"\x0fCampaignService\x12@\n" +
 	"\x0eCreateCampaign\x12\x15.v1.CreateCampaignReq\x1a\x15.v1.CreateCampaignRes\"\x00\x127\n" +
-	"\vGetCampaign\x12\x12.v1.GetCampaignReq\x1a\x12.v1.GetCampaignRes\"\x00B8Z6github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1;v1b\x06proto3"
+	"\vGetCampaign\x12\x12.v1.GetCampaignReq\x1a\x12.v1.GetCampaignRes\"\x00\x12=\n" +
+	"\rListCampaigns\x12\x14.v1.ListCampaignsReq\x1a\x14.v1.ListCampaignsRes\"\x00\x12@\n" +
+	"\x0eUpdateCampaign\x12\x15.v1.UpdateCampaignReq\x1a\x15.v1.UpdateCampaignRes\"\x00\x12@\n" +
+	"\x0eDeleteCampaign\x12\x15.v1.DeleteCampaignReq\x1a\x15.v1.DeleteCampaignRes\"\x00\x12=\n" +
+	"\rPauseCampaign\x12\x14.v1.PauseCampaignReq\x1a\x14.v1.PauseCampaignRes\"\x00\x12@\n" +
+	"\x0eResumeCampaign\x12\x15.v1.ResumeCampaignReq\x1a\x15.v1.ResumeCampaignRes\"\x00\x12F\n" +
+	"\x10GetCampaignStats\x12\x17.v1.GetCampaignStatsReq\x1a\x17.v1.GetCampaignStatsRes\"\x00\x12?\n" +
+	"\rImportCoupons\x12\x14.v1.ImportCouponsReq\x1a\x14.v1.ImportCouponsRes\"\x00(\x01\x12B\n" +
+	"\x0eExportCampaign\x12\x15.v1.ExportCampaignReq\x1a\x15.v1.ExportCampaignRes\"\x000\x01B8Z6github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1;v1b\x06proto3"
 
 var (
 	file_v1_campaign_proto_rawDescOnce sync.Once
@@ -342,28 +2280,95 @@ func file_v1_campaign_proto_rawDescGZIP() []byte {
 	return file_v1_campaign_proto_rawDescData
 }
 
-var file_v1_campaign_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_v1_campaign_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_v1_campaign_proto_goTypes = []any{
-	(*CampaignInfo)(nil),      // 0: v1.CampaignInfo
-	(*CreateCampaignReq)(nil), // 1: v1.CreateCampaignReq
-	(*CreateCampaignRes)(nil), // 2: v1.CreateCampaignRes
-	(*GetCampaignReq)(nil),    // 3: v1.GetCampaignReq
-	(*GetCampaignRes)(nil),    // 4: v1.GetCampaignRes
-	(*BaseResponse)(nil),      // 5: v1.BaseResponse
+	(*CampaignInfo)(nil),        // 0: v1.CampaignInfo
+	(*Schedule)(nil),            // 1: v1.Schedule
+	(*Wave)(nil),                // 2: v1.Wave
+	(*Window)(nil),              // 3: v1.Window
+	(*Raffle)(nil),              // 4: v1.Raffle
+	(*Tier)(nil),                // 5: v1.Tier
+	(*Rule)(nil),                // 6: v1.Rule
+	(*Benefit)(nil),             // 7: v1.Benefit
+	(*CreateCampaignReq)(nil),   // 8: v1.CreateCampaignReq
+	(*CreateCampaignRes)(nil),   // 9: v1.CreateCampaignRes
+	(*GetCampaignReq)(nil),      // 10: v1.GetCampaignReq
+	(*GetCampaignRes)(nil),      // 11: v1.GetCampaignRes
+	(*ListCampaignsReq)(nil),    // 12: v1.ListCampaignsReq
+	(*ListCampaignsRes)(nil),    // 13: v1.ListCampaignsRes
+	(*UpdateCampaignReq)(nil),   // 14: v1.UpdateCampaignReq
+	(*UpdateCampaignRes)(nil),   // 15: v1.UpdateCampaignRes
+	(*DeleteCampaignReq)(nil),   // 16: v1.DeleteCampaignReq
+	(*DeleteCampaignRes)(nil),   // 17: v1.DeleteCampaignRes
+	(*PauseCampaignReq)(nil),    // 18: v1.PauseCampaignReq
+	(*PauseCampaignRes)(nil),    // 19: v1.PauseCampaignRes
+	(*ResumeCampaignReq)(nil),   // 20: v1.ResumeCampaignReq
+	(*ResumeCampaignRes)(nil),   // 21: v1.ResumeCampaignRes
+	(*GetCampaignStatsReq)(nil), // 22: v1.GetCampaignStatsReq
+	(*GetCampaignStatsRes)(nil), // 23: v1.GetCampaignStatsRes
+	(*CampaignStats)(nil),       // 24: v1.CampaignStats
+	(*CampaignEvent)(nil),       // 25: v1.CampaignEvent
+	(*ImportCouponsReq)(nil),    // 26: v1.ImportCouponsReq
+	(*ImportCouponRow)(nil),     // 27: v1.ImportCouponRow
+	(*ImportCouponsRes)(nil),    // 28: v1.ImportCouponsRes
+	(*RejectedCoupon)(nil),      // 29: v1.RejectedCoupon
+	(*ExportCampaignReq)(nil),   // 30: v1.ExportCampaignReq
+	(*ExportCampaignRes)(nil),   // 31: v1.ExportCampaignRes
+	(*BaseResponse)(nil),        // 32: v1.BaseResponse
 }
 var file_v1_campaign_proto_depIdxs = []int32{
-	5, // 0: v1.CreateCampaignRes.result:type_name -> v1.BaseResponse
-	5, // 1: v1.GetCampaignRes.result:type_name -> v1.BaseResponse
-	0, // 2: v1.GetCampaignRes.info:type_name -> v1.CampaignInfo
-	1, // 3: v1.CampaignService.CreateCampaign:input_type -> v1.CreateCampaignReq
-	3, // 4: v1.CampaignService.GetCampaign:input_type -> v1.GetCampaignReq
-	2, // 5: v1.CampaignService.CreateCampaign:output_type -> v1.CreateCampaignRes
-	4, // 6: v1.CampaignService.GetCampaign:output_type -> v1.GetCampaignRes
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	7,  // 0: v1.CampaignInfo.benefit:type_name -> v1.Benefit
+	6,  // 1: v1.CampaignInfo.rules:type_name -> v1.Rule
+	1,  // 2: v1.CampaignInfo.schedule:type_name -> v1.Schedule
+	4,  // 3: v1.CampaignInfo.raffle:type_name -> v1.Raffle
+	5,  // 4: v1.CampaignInfo.tiers:type_name -> v1.Tier
+	2,  // 5: v1.Schedule.waves:type_name -> v1.Wave
+	3,  // 6: v1.Schedule.windows:type_name -> v1.Window
+	7,  // 7: v1.CreateCampaignReq.benefit:type_name -> v1.Benefit
+	6,  // 8: v1.CreateCampaignReq.rules:type_name -> v1.Rule
+	1,  // 9: v1.CreateCampaignReq.schedule:type_name -> v1.Schedule
+	4,  // 10: v1.CreateCampaignReq.raffle:type_name -> v1.Raffle
+	5,  // 11: v1.CreateCampaignReq.tiers:type_name -> v1.Tier
+	32, // 12: v1.CreateCampaignRes.result:type_name -> v1.BaseResponse
+	32, // 13: v1.GetCampaignRes.result:type_name -> v1.BaseResponse
+	0,  // 14: v1.GetCampaignRes.info:type_name -> v1.CampaignInfo
+	32, // 15: v1.ListCampaignsRes.result:type_name -> v1.BaseResponse
+	0,  // 16: v1.ListCampaignsRes.campaigns:type_name -> v1.CampaignInfo
+	32, // 17: v1.UpdateCampaignRes.result:type_name -> v1.BaseResponse
+	32, // 18: v1.DeleteCampaignRes.result:type_name -> v1.BaseResponse
+	32, // 19: v1.PauseCampaignRes.result:type_name -> v1.BaseResponse
+	32, // 20: v1.ResumeCampaignRes.result:type_name -> v1.BaseResponse
+	32, // 21: v1.GetCampaignStatsRes.result:type_name -> v1.BaseResponse
+	24, // 22: v1.GetCampaignStatsRes.campaigns:type_name -> v1.CampaignStats
+	25, // 23: v1.CampaignStats.recentEvents:type_name -> v1.CampaignEvent
+	27, // 24: v1.ImportCouponsReq.rows:type_name -> v1.ImportCouponRow
+	32, // 25: v1.ImportCouponsRes.result:type_name -> v1.BaseResponse
+	29, // 26: v1.ImportCouponsRes.rejected:type_name -> v1.RejectedCoupon
+	8,  // 27: v1.CampaignService.CreateCampaign:input_type -> v1.CreateCampaignReq
+	10, // 28: v1.CampaignService.GetCampaign:input_type -> v1.GetCampaignReq
+	12, // 29: v1.CampaignService.ListCampaigns:input_type -> v1.ListCampaignsReq
+	14, // 30: v1.CampaignService.UpdateCampaign:input_type -> v1.UpdateCampaignReq
+	16, // 31: v1.CampaignService.DeleteCampaign:input_type -> v1.DeleteCampaignReq
+	18, // 32: v1.CampaignService.PauseCampaign:input_type -> v1.PauseCampaignReq
+	20, // 33: v1.CampaignService.ResumeCampaign:input_type -> v1.ResumeCampaignReq
+	22, // 34: v1.CampaignService.GetCampaignStats:input_type -> v1.GetCampaignStatsReq
+	26, // 35: v1.CampaignService.ImportCoupons:input_type -> v1.ImportCouponsReq
+	30, // 36: v1.CampaignService.ExportCampaign:input_type -> v1.ExportCampaignReq
+	9,  // 37: v1.CampaignService.CreateCampaign:output_type -> v1.CreateCampaignRes
+	11, // 38: v1.CampaignService.GetCampaign:output_type -> v1.GetCampaignRes
+	13, // 39: v1.CampaignService.ListCampaigns:output_type -> v1.ListCampaignsRes
+	15, // 40: v1.CampaignService.UpdateCampaign:output_type -> v1.UpdateCampaignRes
+	17, // 41: v1.CampaignService.DeleteCampaign:output_type -> v1.DeleteCampaignRes
+	19, // 42: v1.CampaignService.PauseCampaign:output_type -> v1.PauseCampaignRes
+	21, // 43: v1.CampaignService.ResumeCampaign:output_type -> v1.ResumeCampaignRes
+	23, // 44: v1.CampaignService.GetCampaignStats:output_type -> v1.GetCampaignStatsRes
+	28, // 45: v1.CampaignService.ImportCoupons:output_type -> v1.ImportCouponsRes
+	31, // 46: v1.CampaignService.ExportCampaign:output_type -> v1.ExportCampaignRes
+	37, // [37:47] is the sub-list for method output_type
+	27, // [27:37] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_v1_campaign_proto_init() }
@@ -378,7 +2383,7 @@ func file_v1_campaign_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_campaign_proto_rawDesc), len(file_v1_campaign_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,16 +21,217 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 할인 계산용 장바구니, 금액은 통화의 최소 단위
+type Cart struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderAmount    int64                  `protobuf:"varint,1,opt,name=orderAmount,proto3" json:"orderAmount,omitempty"` // 상품 합계 (배송비 제외), 0 이면 items 로 계산
+	ShippingAmount int64                  `protobuf:"varint,2,opt,name=shippingAmount,proto3" json:"shippingAmount,omitempty"`
+	Currency       string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Items          []*CartItem            `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"` // bxgy 쿠폰은 필수
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Cart) Reset() {
+	*x = Cart{}
+	mi := &file_v1_coupon_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cart) ProtoMessage() {}
+
+func (x *Cart) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cart.ProtoReflect.Descriptor instead.
+func (*Cart) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Cart) GetOrderAmount() int64 {
+	if x != nil {
+		return x.OrderAmount
+	}
+	return 0
+}
+
+func (x *Cart) GetShippingAmount() int64 {
+	if x != nil {
+		return x.ShippingAmount
+	}
+	return 0
+}
+
+func (x *Cart) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Cart) GetItems() []*CartItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CartItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	UnitPrice     int64                  `protobuf:"varint,2,opt,name=unitPrice,proto3" json:"unitPrice,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartItem) Reset() {
+	*x = CartItem{}
+	mi := &file_v1_coupon_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartItem) ProtoMessage() {}
+
+func (x *CartItem) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartItem.ProtoReflect.Descriptor instead.
+func (*CartItem) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CartItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CartItem) GetUnitPrice() int64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *CartItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type Discount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"` // 할인 금액 (무료 배송이면 배송비)
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	FreeShipping  bool                   `protobuf:"varint,3,opt,name=freeShipping,proto3" json:"freeShipping,omitempty"`
+	FinalAmount   int64                  `protobuf:"varint,4,opt,name=finalAmount,proto3" json:"finalAmount,omitempty"` // 상품 합계 + 배송비 - 할인 금액
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Discount) Reset() {
+	*x = Discount{}
+	mi := &file_v1_coupon_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Discount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Discount) ProtoMessage() {}
+
+func (x *Discount) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Discount.ProtoReflect.Descriptor instead.
+func (*Discount) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Discount) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Discount) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Discount) GetFreeShipping() bool {
+	if x != nil {
+		return x.FreeShipping
+	}
+	return false
+}
+
+func (x *Discount) GetFinalAmount() int64 {
+	if x != nil {
+		return x.FinalAmount
+	}
+	return 0
+}
+
+// ========================================
 type IssueCouponReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`                                                                                   // 인증된 client 는 토큰의 userId 로 대체됨
+	Attributes    map[string]string      `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 캠페인 발급 조건을 평가할 사용자 속성 (region, signupDate 등)
+	JoinWaitlist  bool                   `protobuf:"varint,4,opt,name=joinWaitlist,proto3" json:"joinWaitlist,omitempty"`                                                                      // 쿠폰이 소진된 경우 대기자로 등록함, 쿠폰이 다시 생기면 순서대로 자동 발급됨
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *IssueCouponReq) Reset() {
 	*x = IssueCouponReq{}
-	mi := &file_v1_coupon_proto_msgTypes[0]
+	mi := &file_v1_coupon_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -42,7 +243,7 @@ func (x *IssueCouponReq) String() string {
 func (*IssueCouponReq) ProtoMessage() {}
 
 func (x *IssueCouponReq) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_coupon_proto_msgTypes[0]
+	mi := &file_v1_coupon_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -55,7 +256,7 @@ func (x *IssueCouponReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IssueCouponReq.ProtoReflect.Descriptor instead.
 func (*IssueCouponReq) Descriptor() ([]byte, []int) {
-	return file_v1_coupon_proto_rawDescGZIP(), []int{0}
+	return file_v1_coupon_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *IssueCouponReq) GetCampaignId() string {
@@ -65,17 +266,42 @@ func (x *IssueCouponReq) GetCampaignId() string {
 	return ""
 }
 
+func (x *IssueCouponReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *IssueCouponReq) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *IssueCouponReq) GetJoinWaitlist() bool {
+	if x != nil {
+		return x.JoinWaitlist
+	}
+	return false
+}
+
 type IssueCouponRes struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	CouponCode    string                 `protobuf:"bytes,2,opt,name=couponCode,proto3" json:"couponCode,omitempty"` // 발급된 쿠폰 코드
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Result           *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	CouponCode       string                 `protobuf:"bytes,2,opt,name=couponCode,proto3" json:"couponCode,omitempty"`              // 발급된 쿠폰 코드
+	FailedRule       string                 `protobuf:"bytes,3,opt,name=failedRule,proto3" json:"failedRule,omitempty"`              // 발급 조건을 만족하지 못한 경우 처음 실패한 조건의 이름
+	NextReleaseAt    string                 `protobuf:"bytes,4,opt,name=nextReleaseAt,proto3" json:"nextReleaseAt,omitempty"`        // RFC3339, 발급 일정(wave, 시간대, rate) 때문에 실패한 경우 다시 요청할 시각
+	WaitlistPosition int32                  `protobuf:"varint,5,opt,name=waitlistPosition,proto3" json:"waitlistPosition,omitempty"` // joinWaitlist 로 대기자로 등록된 경우 대기 순서 (1 부터)
+	Tier             string                 `protobuf:"bytes,6,opt,name=tier,proto3" json:"tier,omitempty"`                          // tier 예약 수량에서 발급된 경우 tier 이름
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *IssueCouponRes) Reset() {
 	*x = IssueCouponRes{}
-	mi := &file_v1_coupon_proto_msgTypes[1]
+	mi := &file_v1_coupon_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -87,7 +313,7 @@ func (x *IssueCouponRes) String() string {
 func (*IssueCouponRes) ProtoMessage() {}
 
 func (x *IssueCouponRes) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_coupon_proto_msgTypes[1]
+	mi := &file_v1_coupon_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -100,7 +326,7 @@ func (x *IssueCouponRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IssueCouponRes.ProtoReflect.Descriptor instead.
 func (*IssueCouponRes) Descriptor() ([]byte, []int) {
-	return file_v1_coupon_proto_rawDescGZIP(), []int{1}
+	return file_v1_coupon_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *IssueCouponRes) GetResult() *BaseResponse {
@@ -117,22 +343,1458 @@ func (x *IssueCouponRes) GetCouponCode() string {
 	return ""
 }
 
-var File_v1_coupon_proto protoreflect.FileDescriptor
+func (x *IssueCouponRes) GetFailedRule() string {
+	if x != nil {
+		return x.FailedRule
+	}
+	return ""
+}
 
-const file_v1_coupon_proto_rawDesc = "" +
-	"\n" +
-	"\x0fv1/coupon.proto\x12\x02v1\x1a\x11v1/campaign.proto\x1a\x0fv1/common.proto\"0\n" +
-	"\x0eIssueCouponReq\x12\x1e\n" +
-	"\n" +
-	"campaignId\x18\x01 \x01(\tR\n" +
-	"campaignId\"Z\n" +
-	"\x0eIssueCouponRes\x12(\n" +
-	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\x12\x1e\n" +
+func (x *IssueCouponRes) GetNextReleaseAt() string {
+	if x != nil {
+		return x.NextReleaseAt
+	}
+	return ""
+}
+
+func (x *IssueCouponRes) GetWaitlistPosition() int32 {
+	if x != nil {
+		return x.WaitlistPosition
+	}
+	return 0
+}
+
+func (x *IssueCouponRes) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+// IssueCoupon 과 같은 조건으로 발급 가능한지만 확인, 쿠폰은 발급하지 않음
+// 수량, 기간은 확인하지 않고 발급 조건만 평가함
+type EvaluateEligibilityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"` // 인증된 client 는 토큰의 userId 로 대체됨
+	Attributes    map[string]string      `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateEligibilityReq) Reset() {
+	*x = EvaluateEligibilityReq{}
+	mi := &file_v1_coupon_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateEligibilityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateEligibilityReq) ProtoMessage() {}
+
+func (x *EvaluateEligibilityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateEligibilityReq.ProtoReflect.Descriptor instead.
+func (*EvaluateEligibilityReq) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EvaluateEligibilityReq) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *EvaluateEligibilityReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EvaluateEligibilityReq) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type RuleResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Passed        bool                   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // 실패한 이유
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleResult) Reset() {
+	*x = RuleResult{}
+	mi := &file_v1_coupon_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleResult) ProtoMessage() {}
+
+func (x *RuleResult) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleResult.ProtoReflect.Descriptor instead.
+func (*RuleResult) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RuleResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RuleResult) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *RuleResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type EvaluateEligibilityRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Eligible      bool                   `protobuf:"varint,2,opt,name=eligible,proto3" json:"eligible,omitempty"`
+	FailedRule    string                 `protobuf:"bytes,3,opt,name=failedRule,proto3" json:"failedRule,omitempty"` // 처음 실패한 조건
+	Rules         []*RuleResult          `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`           // 실패한 조건이 있어도 모든 조건의 결과
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateEligibilityRes) Reset() {
+	*x = EvaluateEligibilityRes{}
+	mi := &file_v1_coupon_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateEligibilityRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateEligibilityRes) ProtoMessage() {}
+
+func (x *EvaluateEligibilityRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateEligibilityRes.ProtoReflect.Descriptor instead.
+func (*EvaluateEligibilityRes) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EvaluateEligibilityRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *EvaluateEligibilityRes) GetEligible() bool {
+	if x != nil {
+		return x.Eligible
+	}
+	return false
+}
+
+func (x *EvaluateEligibilityRes) GetFailedRule() string {
+	if x != nil {
+		return x.FailedRule
+	}
+	return ""
+}
+
+func (x *EvaluateEligibilityRes) GetRules() []*RuleResult {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// 쿠폰이 다시 생기면 (회수, 추첨 쿠폰 미사용, 캠페인 쿠폰 수 증가) 대기 순서대로 발급됨
+// 추첨 캠페인은 당첨되지 않은 응모자가 추첨 순위대로 대기자가 됨
+type GetWaitlistPositionReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"` // 인증된 client 는 토큰의 userId 로 대체됨
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWaitlistPositionReq) Reset() {
+	*x = GetWaitlistPositionReq{}
+	mi := &file_v1_coupon_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWaitlistPositionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaitlistPositionReq) ProtoMessage() {}
+
+func (x *GetWaitlistPositionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaitlistPositionReq.ProtoReflect.Descriptor instead.
+func (*GetWaitlistPositionReq) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetWaitlistPositionReq) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *GetWaitlistPositionReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetWaitlistPositionRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Position      int32                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"` // 1 부터
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`         // 전체 대기자 수
+	JoinedAt      string                 `protobuf:"bytes,4,opt,name=joinedAt,proto3" json:"joinedAt,omitempty"`  // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWaitlistPositionRes) Reset() {
+	*x = GetWaitlistPositionRes{}
+	mi := &file_v1_coupon_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWaitlistPositionRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaitlistPositionRes) ProtoMessage() {}
+
+func (x *GetWaitlistPositionRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaitlistPositionRes.ProtoReflect.Descriptor instead.
+func (*GetWaitlistPositionRes) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetWaitlistPositionRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *GetWaitlistPositionRes) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *GetWaitlistPositionRes) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetWaitlistPositionRes) GetJoinedAt() string {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return ""
+}
+
+type LeaveWaitlistReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"` // 인증된 client 는 토큰의 userId 로 대체됨
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveWaitlistReq) Reset() {
+	*x = LeaveWaitlistReq{}
+	mi := &file_v1_coupon_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveWaitlistReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveWaitlistReq) ProtoMessage() {}
+
+func (x *LeaveWaitlistReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveWaitlistReq.ProtoReflect.Descriptor instead.
+func (*LeaveWaitlistReq) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LeaveWaitlistReq) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *LeaveWaitlistReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type LeaveWaitlistRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveWaitlistRes) Reset() {
+	*x = LeaveWaitlistRes{}
+	mi := &file_v1_coupon_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveWaitlistRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveWaitlistRes) ProtoMessage() {}
+
+func (x *LeaveWaitlistRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveWaitlistRes.ProtoReflect.Descriptor instead.
+func (*LeaveWaitlistRes) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LeaveWaitlistRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type RedeemCouponReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	CouponCode    string                 `protobuf:"bytes,2,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`               // 인증된 client 는 토큰의 userId 로 대체됨
+	ReservationId string                 `protobuf:"bytes,4,opt,name=reservationId,proto3" json:"reservationId,omitempty"` // 예약(held)된 쿠폰은 예약한 reservationId 로만 사용 가능
+	Cart          *Cart                  `protobuf:"bytes,5,opt,name=cart,proto3" json:"cart,omitempty"`                   // 있으면 최소 주문 금액 등을 확인하고 할인 금액을 계산함
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeemCouponReq) Reset() {
+	*x = RedeemCouponReq{}
+	mi := &file_v1_coupon_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemCouponReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemCouponReq) ProtoMessage() {}
+
+func (x *RedeemCouponReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemCouponReq.ProtoReflect.Descriptor instead.
+func (*RedeemCouponReq) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RedeemCouponReq) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *RedeemCouponReq) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *RedeemCouponReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RedeemCouponReq) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *RedeemCouponReq) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+type RedeemCouponRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Discount      *Discount              `protobuf:"bytes,2,opt,name=discount,proto3" json:"discount,omitempty"` // cart 를 보낸 경우에만 채워짐
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeemCouponRes) Reset() {
+	*x = RedeemCouponRes{}
+	mi := &file_v1_coupon_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemCouponRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemCouponRes) ProtoMessage() {}
+
+func (x *RedeemCouponRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemCouponRes.ProtoReflect.Descriptor instead.
+func (*RedeemCouponRes) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RedeemCouponRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *RedeemCouponRes) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+// RedeemCoupon 에 같은 cart 를 보냈을때 적용될 할인 금액, 쿠폰 상태는 바꾸지 않음
+type QuoteDiscountReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	CouponCode    string                 `protobuf:"bytes,2,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"` // 인증된 client 는 토큰의 userId 로 대체됨
+	ReservationId string                 `protobuf:"bytes,4,opt,name=reservationId,proto3" json:"reservationId,omitempty"`
+	Cart          *Cart                  `protobuf:"bytes,5,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteDiscountReq) Reset() {
+	*x = QuoteDiscountReq{}
+	mi := &file_v1_coupon_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteDiscountReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteDiscountReq) ProtoMessage() {}
+
+func (x *QuoteDiscountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteDiscountReq.ProtoReflect.Descriptor instead.
+func (*QuoteDiscountReq) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *QuoteDiscountReq) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *QuoteDiscountReq) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *QuoteDiscountReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QuoteDiscountReq) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *QuoteDiscountReq) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+type QuoteDiscountRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Discount      *Discount              `protobuf:"bytes,2,opt,name=discount,proto3" json:"discount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteDiscountRes) Reset() {
+	*x = QuoteDiscountRes{}
+	mi := &file_v1_coupon_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteDiscountRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteDiscountRes) ProtoMessage() {}
+
+func (x *QuoteDiscountRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteDiscountRes.ProtoReflect.Descriptor instead.
+func (*QuoteDiscountRes) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *QuoteDiscountRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *QuoteDiscountRes) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+// 쿠폰 상태 : available -> issued -> (held ->) redeemed, 회수되면 revoked, 사용하지 않고 기간이 끝나면 expired
+type RevokeCouponReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	CouponCode    string                 `protobuf:"bytes,2,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	ReturnToPool  bool                   `protobuf:"varint,3,opt,name=returnToPool,proto3" json:"returnToPool,omitempty"` // true 면 revoked 대신 available 로 돌려서 다른 사용자에게 다시 발급될 수 있게 함
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`              // 감사 이력에 남김
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCouponReq) Reset() {
+	*x = RevokeCouponReq{}
+	mi := &file_v1_coupon_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCouponReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCouponReq) ProtoMessage() {}
+
+func (x *RevokeCouponReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCouponReq.ProtoReflect.Descriptor instead.
+func (*RevokeCouponReq) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RevokeCouponReq) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *RevokeCouponReq) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *RevokeCouponReq) GetReturnToPool() bool {
+	if x != nil {
+		return x.ReturnToPool
+	}
+	return false
+}
+
+func (x *RevokeCouponReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RevokeCouponRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"` // 변경 후 상태 (revoked 또는 available)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCouponRes) Reset() {
+	*x = RevokeCouponRes{}
+	mi := &file_v1_coupon_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCouponRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCouponRes) ProtoMessage() {}
+
+func (x *RevokeCouponRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCouponRes.ProtoReflect.Descriptor instead.
+func (*RevokeCouponRes) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RevokeCouponRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *RevokeCouponRes) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// 주문 취소 등으로 사용을 되돌림, 같은 사용자에게 발급된 상태(issued)로 돌아감
+type UnredeemCouponReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	CouponCode    string                 `protobuf:"bytes,2,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnredeemCouponReq) Reset() {
+	*x = UnredeemCouponReq{}
+	mi := &file_v1_coupon_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnredeemCouponReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnredeemCouponReq) ProtoMessage() {}
+
+func (x *UnredeemCouponReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnredeemCouponReq.ProtoReflect.Descriptor instead.
+func (*UnredeemCouponReq) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UnredeemCouponReq) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *UnredeemCouponReq) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *UnredeemCouponReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UnredeemCouponRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnredeemCouponRes) Reset() {
+	*x = UnredeemCouponRes{}
+	mi := &file_v1_coupon_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnredeemCouponRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnredeemCouponRes) ProtoMessage() {}
+
+func (x *UnredeemCouponRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnredeemCouponRes.ProtoReflect.Descriptor instead.
+func (*UnredeemCouponRes) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UnredeemCouponRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// 결제 진행중에 쿠폰을 잡아둠 (held), 결제가 끝나면 ConfirmRedemption, 실패하면 ReleaseReservation
+// 둘 다 호출되지 않으면 heldUntil 이후 자동으로 issued 로 돌아감
+type ReserveCouponReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	CouponCode    string                 `protobuf:"bytes,2,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=userId,proto3" json:"userId,omitempty"`          // 인증된 client 는 토큰의 userId 로 대체됨
+	TtlSeconds    int32                  `protobuf:"varint,4,opt,name=ttlSeconds,proto3" json:"ttlSeconds,omitempty"` // 0 이면 서버 기본값, 서버 최대값보다 길면 최대값으로 줄임
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveCouponReq) Reset() {
+	*x = ReserveCouponReq{}
+	mi := &file_v1_coupon_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveCouponReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveCouponReq) ProtoMessage() {}
+
+func (x *ReserveCouponReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveCouponReq.ProtoReflect.Descriptor instead.
+func (*ReserveCouponReq) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReserveCouponReq) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *ReserveCouponReq) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *ReserveCouponReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReserveCouponReq) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type ReserveCouponRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	ReservationId string                 `protobuf:"bytes,2,opt,name=reservationId,proto3" json:"reservationId,omitempty"`
+	HeldUntil     string                 `protobuf:"bytes,3,opt,name=heldUntil,proto3" json:"heldUntil,omitempty"` // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveCouponRes) Reset() {
+	*x = ReserveCouponRes{}
+	mi := &file_v1_coupon_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveCouponRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveCouponRes) ProtoMessage() {}
+
+func (x *ReserveCouponRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveCouponRes.ProtoReflect.Descriptor instead.
+func (*ReserveCouponRes) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReserveCouponRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ReserveCouponRes) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ReserveCouponRes) GetHeldUntil() string {
+	if x != nil {
+		return x.HeldUntil
+	}
+	return ""
+}
+
+type ConfirmRedemptionReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	CouponCode    string                 `protobuf:"bytes,2,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	ReservationId string                 `protobuf:"bytes,3,opt,name=reservationId,proto3" json:"reservationId,omitempty"`
+	Cart          *Cart                  `protobuf:"bytes,4,opt,name=cart,proto3" json:"cart,omitempty"` // RedeemCoupon 의 cart 와 같음
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmRedemptionReq) Reset() {
+	*x = ConfirmRedemptionReq{}
+	mi := &file_v1_coupon_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmRedemptionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmRedemptionReq) ProtoMessage() {}
+
+func (x *ConfirmRedemptionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmRedemptionReq.ProtoReflect.Descriptor instead.
+func (*ConfirmRedemptionReq) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ConfirmRedemptionReq) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *ConfirmRedemptionReq) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *ConfirmRedemptionReq) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ConfirmRedemptionReq) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+type ConfirmRedemptionRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Discount      *Discount              `protobuf:"bytes,2,opt,name=discount,proto3" json:"discount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmRedemptionRes) Reset() {
+	*x = ConfirmRedemptionRes{}
+	mi := &file_v1_coupon_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmRedemptionRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmRedemptionRes) ProtoMessage() {}
+
+func (x *ConfirmRedemptionRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmRedemptionRes.ProtoReflect.Descriptor instead.
+func (*ConfirmRedemptionRes) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ConfirmRedemptionRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ConfirmRedemptionRes) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+type ReleaseReservationReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	CouponCode    string                 `protobuf:"bytes,2,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	ReservationId string                 `protobuf:"bytes,3,opt,name=reservationId,proto3" json:"reservationId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationReq) Reset() {
+	*x = ReleaseReservationReq{}
+	mi := &file_v1_coupon_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationReq) ProtoMessage() {}
+
+func (x *ReleaseReservationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservationReq.ProtoReflect.Descriptor instead.
+func (*ReleaseReservationReq) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReleaseReservationReq) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *ReleaseReservationReq) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *ReleaseReservationReq) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+type ReleaseReservationRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationRes) Reset() {
+	*x = ReleaseReservationRes{}
+	mi := &file_v1_coupon_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationRes) ProtoMessage() {}
+
+func (x *ReleaseReservationRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_coupon_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservationRes.ProtoReflect.Descriptor instead.
+func (*ReleaseReservationRes) Descriptor() ([]byte, []int) {
+	return file_v1_coupon_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReleaseReservationRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+var File_v1_coupon_proto protoreflect.FileDescriptor
+
+const file_v1_coupon_proto_rawDesc = "" +
+	"\n" +
+	"\x0fv1/coupon.proto\x12\x02v1\x1a\x11v1/campaign.proto\x1a\x0fv1/common.proto\"\x90\x01\n" +
+	"\x04Cart\x12 \n" +
+	"\vorderAmount\x18\x01 \x01(\x03R\vorderAmount\x12&\n" +
+	"\x0eshippingAmount\x18\x02 \x01(\x03R\x0eshippingAmount\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\"\n" +
+	"\x05items\x18\x04 \x03(\v2\f.v1.CartItemR\x05items\"V\n" +
+	"\bCartItem\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1c\n" +
+	"\tunitPrice\x18\x02 \x01(\x03R\tunitPrice\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\x84\x01\n" +
+	"\bDiscount\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\"\n" +
+	"\ffreeShipping\x18\x03 \x01(\bR\ffreeShipping\x12 \n" +
+	"\vfinalAmount\x18\x04 \x01(\x03R\vfinalAmount\"\xef\x01\n" +
+	"\x0eIssueCouponReq\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\tR\x06userId\x12B\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\v2\".v1.IssueCouponReq.AttributesEntryR\n" +
+	"attributes\x12\"\n" +
+	"\fjoinWaitlist\x18\x04 \x01(\bR\fjoinWaitlist\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe0\x01\n" +
+	"\x0eIssueCouponRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\x12\x1e\n" +
+	"\n" +
+	"couponCode\x18\x02 \x01(\tR\n" +
+	"couponCode\x12\x1e\n" +
+	"\n" +
+	"failedRule\x18\x03 \x01(\tR\n" +
+	"failedRule\x12$\n" +
+	"\rnextReleaseAt\x18\x04 \x01(\tR\rnextReleaseAt\x12*\n" +
+	"\x10waitlistPosition\x18\x05 \x01(\x05R\x10waitlistPosition\x12\x12\n" +
+	"\x04tier\x18\x06 \x01(\tR\x04tier\"\xdb\x01\n" +
+	"\x16EvaluateEligibilityReq\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\tR\x06userId\x12J\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\v2*.v1.EvaluateEligibilityReq.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"P\n" +
+	"\n" +
+	"RuleResult\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06passed\x18\x02 \x01(\bR\x06passed\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xa4\x01\n" +
+	"\x16EvaluateEligibilityRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\x12\x1a\n" +
+	"\beligible\x18\x02 \x01(\bR\beligible\x12\x1e\n" +
+	"\n" +
+	"failedRule\x18\x03 \x01(\tR\n" +
+	"failedRule\x12$\n" +
+	"\x05rules\x18\x04 \x03(\v2\x0e.v1.RuleResultR\x05rules\"P\n" +
+	"\x16GetWaitlistPositionReq\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\tR\x06userId\"\x8e\x01\n" +
+	"\x16GetWaitlistPositionRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\x12\x1a\n" +
+	"\bjoinedAt\x18\x04 \x01(\tR\bjoinedAt\"J\n" +
+	"\x10LeaveWaitlistReq\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\tR\x06userId\"<\n" +
+	"\x10LeaveWaitlistRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\"\xad\x01\n" +
+	"\x0fRedeemCouponReq\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1e\n" +
+	"\n" +
+	"couponCode\x18\x02 \x01(\tR\n" +
+	"couponCode\x12\x16\n" +
+	"\x06userId\x18\x03 \x01(\tR\x06userId\x12$\n" +
+	"\rreservationId\x18\x04 \x01(\tR\rreservationId\x12\x1c\n" +
+	"\x04cart\x18\x05 \x01(\v2\b.v1.CartR\x04cart\"e\n" +
+	"\x0fRedeemCouponRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\x12(\n" +
+	"\bdiscount\x18\x02 \x01(\v2\f.v1.DiscountR\bdiscount\"\xae\x01\n" +
+	"\x10QuoteDiscountReq\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1e\n" +
+	"\n" +
+	"couponCode\x18\x02 \x01(\tR\n" +
+	"couponCode\x12\x16\n" +
+	"\x06userId\x18\x03 \x01(\tR\x06userId\x12$\n" +
+	"\rreservationId\x18\x04 \x01(\tR\rreservationId\x12\x1c\n" +
+	"\x04cart\x18\x05 \x01(\v2\b.v1.CartR\x04cart\"f\n" +
+	"\x10QuoteDiscountRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\x12(\n" +
+	"\bdiscount\x18\x02 \x01(\v2\f.v1.DiscountR\bdiscount\"\x8d\x01\n" +
+	"\x0fRevokeCouponReq\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1e\n" +
+	"\n" +
+	"couponCode\x18\x02 \x01(\tR\n" +
+	"couponCode\x12\"\n" +
+	"\freturnToPool\x18\x03 \x01(\bR\freturnToPool\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"Q\n" +
+	"\x0fRevokeCouponRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\"k\n" +
+	"\x11UnredeemCouponReq\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1e\n" +
+	"\n" +
+	"couponCode\x18\x02 \x01(\tR\n" +
+	"couponCode\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"=\n" +
+	"\x11UnredeemCouponRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\"\x8a\x01\n" +
+	"\x10ReserveCouponReq\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1e\n" +
+	"\n" +
+	"couponCode\x18\x02 \x01(\tR\n" +
+	"couponCode\x12\x16\n" +
+	"\x06userId\x18\x03 \x01(\tR\x06userId\x12\x1e\n" +
+	"\n" +
+	"ttlSeconds\x18\x04 \x01(\x05R\n" +
+	"ttlSeconds\"\x80\x01\n" +
+	"\x10ReserveCouponRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\x12$\n" +
+	"\rreservationId\x18\x02 \x01(\tR\rreservationId\x12\x1c\n" +
+	"\theldUntil\x18\x03 \x01(\tR\theldUntil\"\x9a\x01\n" +
+	"\x14ConfirmRedemptionReq\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1e\n" +
+	"\n" +
+	"couponCode\x18\x02 \x01(\tR\n" +
+	"couponCode\x12$\n" +
+	"\rreservationId\x18\x03 \x01(\tR\rreservationId\x12\x1c\n" +
+	"\x04cart\x18\x04 \x01(\v2\b.v1.CartR\x04cart\"j\n" +
+	"\x14ConfirmRedemptionRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\x12(\n" +
+	"\bdiscount\x18\x02 \x01(\v2\f.v1.DiscountR\bdiscount\"}\n" +
+	"\x15ReleaseReservationReq\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1e\n" +
 	"\n" +
 	"couponCode\x18\x02 \x01(\tR\n" +
-	"couponCode2H\n" +
+	"couponCode\x12$\n" +
+	"\rreservationId\x18\x03 \x01(\tR\rreservationId\"A\n" +
+	"\x15ReleaseReservationRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result2\xfa\x05\n" +
 	"\rCouponService\x127\n" +
-	"\vIssueCoupon\x12\x12.v1.IssueCouponReq\x1a\x12.v1.IssueCouponRes\"\x00B8Z6github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1;v1b\x06proto3"
+	"\vIssueCoupon\x12\x12.v1.IssueCouponReq\x1a\x12.v1.IssueCouponRes\"\x00\x12O\n" +
+	"\x13EvaluateEligibility\x12\x1a.v1.EvaluateEligibilityReq\x1a\x1a.v1.EvaluateEligibilityRes\"\x00\x12O\n" +
+	"\x13GetWaitlistPosition\x12\x1a.v1.GetWaitlistPositionReq\x1a\x1a.v1.GetWaitlistPositionRes\"\x00\x12=\n" +
+	"\rLeaveWaitlist\x12\x14.v1.LeaveWaitlistReq\x1a\x14.v1.LeaveWaitlistRes\"\x00\x12:\n" +
+	"\fRedeemCoupon\x12\x13.v1.RedeemCouponReq\x1a\x13.v1.RedeemCouponRes\"\x00\x12=\n" +
+	"\rQuoteDiscount\x12\x14.v1.QuoteDiscountReq\x1a\x14.v1.QuoteDiscountRes\"\x00\x12:\n" +
+	"\fRevokeCoupon\x12\x13.v1.RevokeCouponReq\x1a\x13.v1.RevokeCouponRes\"\x00\x12@\n" +
+	"\x0eUnredeemCoupon\x12\x15.v1.UnredeemCouponReq\x1a\x15.v1.UnredeemCouponRes\"\x00\x12=\n" +
+	"\rReserveCoupon\x12\x14.v1.ReserveCouponReq\x1a\x14.v1.ReserveCouponRes\"\x00\x12I\n" +
+	"\x11ConfirmRedemption\x12\x18.v1.ConfirmRedemptionReq\x1a\x18.v1.ConfirmRedemptionRes\"\x00\x12L\n" +
+	"\x12ReleaseReservation\x12\x19.v1.ReleaseReservationReq\x1a\x19.v1.ReleaseReservationRes\"\x00B8Z6github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1;v1b\x06proto3"
 
 var (
 	file_v1_coupon_proto_rawDescOnce sync.Once
@@ -146,21 +1808,87 @@ func file_v1_coupon_proto_rawDescGZIP() []byte {
 	return file_v1_coupon_proto_rawDescData
 }
 
-var file_v1_coupon_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_v1_coupon_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_v1_coupon_proto_goTypes = []any{
-	(*IssueCouponReq)(nil), // 0: v1.IssueCouponReq
-	(*IssueCouponRes)(nil), // 1: v1.IssueCouponRes
-	(*BaseResponse)(nil),   // 2: v1.BaseResponse
+	(*Cart)(nil),                   // 0: v1.Cart
+	(*CartItem)(nil),               // 1: v1.CartItem
+	(*Discount)(nil),               // 2: v1.Discount
+	(*IssueCouponReq)(nil),         // 3: v1.IssueCouponReq
+	(*IssueCouponRes)(nil),         // 4: v1.IssueCouponRes
+	(*EvaluateEligibilityReq)(nil), // 5: v1.EvaluateEligibilityReq
+	(*RuleResult)(nil),             // 6: v1.RuleResult
+	(*EvaluateEligibilityRes)(nil), // 7: v1.EvaluateEligibilityRes
+	(*GetWaitlistPositionReq)(nil), // 8: v1.GetWaitlistPositionReq
+	(*GetWaitlistPositionRes)(nil), // 9: v1.GetWaitlistPositionRes
+	(*LeaveWaitlistReq)(nil),       // 10: v1.LeaveWaitlistReq
+	(*LeaveWaitlistRes)(nil),       // 11: v1.LeaveWaitlistRes
+	(*RedeemCouponReq)(nil),        // 12: v1.RedeemCouponReq
+	(*RedeemCouponRes)(nil),        // 13: v1.RedeemCouponRes
+	(*QuoteDiscountReq)(nil),       // 14: v1.QuoteDiscountReq
+	(*QuoteDiscountRes)(nil),       // 15: v1.QuoteDiscountRes
+	(*RevokeCouponReq)(nil),        // 16: v1.RevokeCouponReq
+	(*RevokeCouponRes)(nil),        // 17: v1.RevokeCouponRes
+	(*UnredeemCouponReq)(nil),      // 18: v1.UnredeemCouponReq
+	(*UnredeemCouponRes)(nil),      // 19: v1.UnredeemCouponRes
+	(*ReserveCouponReq)(nil),       // 20: v1.ReserveCouponReq
+	(*ReserveCouponRes)(nil),       // 21: v1.ReserveCouponRes
+	(*ConfirmRedemptionReq)(nil),   // 22: v1.ConfirmRedemptionReq
+	(*ConfirmRedemptionRes)(nil),   // 23: v1.ConfirmRedemptionRes
+	(*ReleaseReservationReq)(nil),  // 24: v1.ReleaseReservationReq
+	(*ReleaseReservationRes)(nil),  // 25: v1.ReleaseReservationRes
+	nil,                            // 26: v1.IssueCouponReq.AttributesEntry
+	nil,                            // 27: v1.EvaluateEligibilityReq.AttributesEntry
+	(*BaseResponse)(nil),           // 28: v1.BaseResponse
 }
 var file_v1_coupon_proto_depIdxs = []int32{
-	2, // 0: v1.IssueCouponRes.result:type_name -> v1.BaseResponse
-	0, // 1: v1.CouponService.IssueCoupon:input_type -> v1.IssueCouponReq
-	1, // 2: v1.CouponService.IssueCoupon:output_type -> v1.IssueCouponRes
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1,  // 0: v1.Cart.items:type_name -> v1.CartItem
+	26, // 1: v1.IssueCouponReq.attributes:type_name -> v1.IssueCouponReq.AttributesEntry
+	28, // 2: v1.IssueCouponRes.result:type_name -> v1.BaseResponse
+	27, // 3: v1.EvaluateEligibilityReq.attributes:type_name -> v1.EvaluateEligibilityReq.AttributesEntry
+	28, // 4: v1.EvaluateEligibilityRes.result:type_name -> v1.BaseResponse
+	6,  // 5: v1.EvaluateEligibilityRes.rules:type_name -> v1.RuleResult
+	28, // 6: v1.GetWaitlistPositionRes.result:type_name -> v1.BaseResponse
+	28, // 7: v1.LeaveWaitlistRes.result:type_name -> v1.BaseResponse
+	0,  // 8: v1.RedeemCouponReq.cart:type_name -> v1.Cart
+	28, // 9: v1.RedeemCouponRes.result:type_name -> v1.BaseResponse
+	2,  // 10: v1.RedeemCouponRes.discount:type_name -> v1.Discount
+	0,  // 11: v1.QuoteDiscountReq.cart:type_name -> v1.Cart
+	28, // 12: v1.QuoteDiscountRes.result:type_name -> v1.BaseResponse
+	2,  // 13: v1.QuoteDiscountRes.discount:type_name -> v1.Discount
+	28, // 14: v1.RevokeCouponRes.result:type_name -> v1.BaseResponse
+	28, // 15: v1.UnredeemCouponRes.result:type_name -> v1.BaseResponse
+	28, // 16: v1.ReserveCouponRes.result:type_name -> v1.BaseResponse
+	0,  // 17: v1.ConfirmRedemptionReq.cart:type_name -> v1.Cart
+	28, // 18: v1.ConfirmRedemptionRes.result:type_name -> v1.BaseResponse
+	2,  // 19: v1.ConfirmRedemptionRes.discount:type_name -> v1.Discount
+	28, // 20: v1.ReleaseReservationRes.result:type_name -> v1.BaseResponse
+	3,  // 21: v1.CouponService.IssueCoupon:input_type -> v1.IssueCouponReq
+	5,  // 22: v1.CouponService.EvaluateEligibility:input_type -> v1.EvaluateEligibilityReq
+	8,  // 23: v1.CouponService.GetWaitlistPosition:input_type -> v1.GetWaitlistPositionReq
+	10, // 24: v1.CouponService.LeaveWaitlist:input_type -> v1.LeaveWaitlistReq
+	12, // 25: v1.CouponService.RedeemCoupon:input_type -> v1.RedeemCouponReq
+	14, // 26: v1.CouponService.QuoteDiscount:input_type -> v1.QuoteDiscountReq
+	16, // 27: v1.CouponService.RevokeCoupon:input_type -> v1.RevokeCouponReq
+	18, // 28: v1.CouponService.UnredeemCoupon:input_type -> v1.UnredeemCouponReq
+	20, // 29: v1.CouponService.ReserveCoupon:input_type -> v1.ReserveCouponReq
+	22, // 30: v1.CouponService.ConfirmRedemption:input_type -> v1.ConfirmRedemptionReq
+	24, // 31: v1.CouponService.ReleaseReservation:input_type -> v1.ReleaseReservationReq
+	4,  // 32: v1.CouponService.IssueCoupon:output_type -> v1.IssueCouponRes
+	7,  // 33: v1.CouponService.EvaluateEligibility:output_type -> v1.EvaluateEligibilityRes
+	9,  // 34: v1.CouponService.GetWaitlistPosition:output_type -> v1.GetWaitlistPositionRes
+	11, // 35: v1.CouponService.LeaveWaitlist:output_type -> v1.LeaveWaitlistRes
+	13, // 36: v1.CouponService.RedeemCoupon:output_type -> v1.RedeemCouponRes
+	15, // 37: v1.CouponService.QuoteDiscount:output_type -> v1.QuoteDiscountRes
+	17, // 38: v1.CouponService.RevokeCoupon:output_type -> v1.RevokeCouponRes
+	19, // 39: v1.CouponService.UnredeemCoupon:output_type -> v1.UnredeemCouponRes
+	21, // 40: v1.CouponService.ReserveCoupon:output_type -> v1.ReserveCouponRes
+	23, // 41: v1.CouponService.ConfirmRedemption:output_type -> v1.ConfirmRedemptionRes
+	25, // 42: v1.CouponService.ReleaseReservation:output_type -> v1.ReleaseReservationRes
+	32, // [32:43] is the sub-list for method output_type
+	21, // [21:32] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_v1_coupon_proto_init() }
@@ -176,7 +1904,7 @@ func file_v1_coupon_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_coupon_proto_rawDesc), len(file_v1_coupon_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
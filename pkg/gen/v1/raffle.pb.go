@@ -0,0 +1,745 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: v1/raffle.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 추첨 캠페인 (CreateCampaignReq.raffle) 응모, 결과 조회
+// 추첨 방법 : 응모자를 hex(sha256(seed + ":" + userId)) 오름차순으로 정렬해서 앞에서부터 발급 가능한 쿠폰 수만큼 당첨, 나머지는 그 순서대로 대기자
+type EnterDrawReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`                                                                                   // 인증된 client 는 토큰의 userId 로 대체됨
+	Attributes    map[string]string      `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 캠페인 발급 조건이 있으면 응모할때 평가함
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnterDrawReq) Reset() {
+	*x = EnterDrawReq{}
+	mi := &file_v1_raffle_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnterDrawReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnterDrawReq) ProtoMessage() {}
+
+func (x *EnterDrawReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_raffle_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnterDrawReq.ProtoReflect.Descriptor instead.
+func (*EnterDrawReq) Descriptor() ([]byte, []int) {
+	return file_v1_raffle_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EnterDrawReq) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *EnterDrawReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EnterDrawReq) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type EnterDrawRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	FailedRule    string                 `protobuf:"bytes,2,opt,name=failedRule,proto3" json:"failedRule,omitempty"` // 발급 조건을 만족하지 못한 경우 처음 실패한 조건의 이름
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnterDrawRes) Reset() {
+	*x = EnterDrawRes{}
+	mi := &file_v1_raffle_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnterDrawRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnterDrawRes) ProtoMessage() {}
+
+func (x *EnterDrawRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_raffle_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnterDrawRes.ProtoReflect.Descriptor instead.
+func (*EnterDrawRes) Descriptor() ([]byte, []int) {
+	return file_v1_raffle_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EnterDrawRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *EnterDrawRes) GetFailedRule() string {
+	if x != nil {
+		return x.FailedRule
+	}
+	return ""
+}
+
+type GetDrawResultReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"` // 인증된 client 는 토큰의 userId 로 대체됨, 비어있으면 전체 결과만
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDrawResultReq) Reset() {
+	*x = GetDrawResultReq{}
+	mi := &file_v1_raffle_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDrawResultReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDrawResultReq) ProtoMessage() {}
+
+func (x *GetDrawResultReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_raffle_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDrawResultReq.ProtoReflect.Descriptor instead.
+func (*GetDrawResultReq) Descriptor() ([]byte, []int) {
+	return file_v1_raffle_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetDrawResultReq) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *GetDrawResultReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetDrawResultRes struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Result           *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	SeedCommitment   string                 `protobuf:"bytes,2,opt,name=seedCommitment,proto3" json:"seedCommitment,omitempty"` // hex(sha256(seed)), 추첨 전부터 공개
+	Seed             string                 `protobuf:"bytes,3,opt,name=seed,proto3" json:"seed,omitempty"`                     // 추첨 후에만 공개
+	DrawnAt          string                 `protobuf:"bytes,4,opt,name=drawnAt,proto3" json:"drawnAt,omitempty"`               // RFC3339, 추첨 전이면 빈 값
+	Entrants         int32                  `protobuf:"varint,5,opt,name=entrants,proto3" json:"entrants,omitempty"`
+	Winners          int32                  `protobuf:"varint,6,opt,name=winners,proto3" json:"winners,omitempty"`                    // 대기자 순서로 받은 경우 포함
+	Waitlist         int32                  `protobuf:"varint,7,opt,name=waitlist,proto3" json:"waitlist,omitempty"`                  // 아직 쿠폰을 받지 못한 응모자 수
+	Status           string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`                       // not_entered, entered, won, waitlisted, reclaimed
+	CouponCode       string                 `protobuf:"bytes,9,opt,name=couponCode,proto3" json:"couponCode,omitempty"`               // won 인 경우
+	Rank             int32                  `protobuf:"varint,10,opt,name=rank,proto3" json:"rank,omitempty"`                         // 추첨 순위 (1 부터)
+	WaitlistPosition int32                  `protobuf:"varint,11,opt,name=waitlistPosition,proto3" json:"waitlistPosition,omitempty"` // waitlisted 인 경우 대기 순서 (1 부터)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetDrawResultRes) Reset() {
+	*x = GetDrawResultRes{}
+	mi := &file_v1_raffle_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDrawResultRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDrawResultRes) ProtoMessage() {}
+
+func (x *GetDrawResultRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_raffle_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDrawResultRes.ProtoReflect.Descriptor instead.
+func (*GetDrawResultRes) Descriptor() ([]byte, []int) {
+	return file_v1_raffle_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetDrawResultRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *GetDrawResultRes) GetSeedCommitment() string {
+	if x != nil {
+		return x.SeedCommitment
+	}
+	return ""
+}
+
+func (x *GetDrawResultRes) GetSeed() string {
+	if x != nil {
+		return x.Seed
+	}
+	return ""
+}
+
+func (x *GetDrawResultRes) GetDrawnAt() string {
+	if x != nil {
+		return x.DrawnAt
+	}
+	return ""
+}
+
+func (x *GetDrawResultRes) GetEntrants() int32 {
+	if x != nil {
+		return x.Entrants
+	}
+	return 0
+}
+
+func (x *GetDrawResultRes) GetWinners() int32 {
+	if x != nil {
+		return x.Winners
+	}
+	return 0
+}
+
+func (x *GetDrawResultRes) GetWaitlist() int32 {
+	if x != nil {
+		return x.Waitlist
+	}
+	return 0
+}
+
+func (x *GetDrawResultRes) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetDrawResultRes) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *GetDrawResultRes) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *GetDrawResultRes) GetWaitlistPosition() int32 {
+	if x != nil {
+		return x.WaitlistPosition
+	}
+	return 0
+}
+
+// 추첨 결과를 다시 계산할 수 있도록 seed 와 응모자 목록을 내보냄, 응답 JSON 을 그대로 공개하면 됨
+type ExportDrawReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportDrawReq) Reset() {
+	*x = ExportDrawReq{}
+	mi := &file_v1_raffle_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportDrawReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDrawReq) ProtoMessage() {}
+
+func (x *ExportDrawReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_raffle_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportDrawReq.ProtoReflect.Descriptor instead.
+func (*ExportDrawReq) Descriptor() ([]byte, []int) {
+	return file_v1_raffle_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ExportDrawReq) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+type DrawEntrant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	EnteredAt     string                 `protobuf:"bytes,2,opt,name=enteredAt,proto3" json:"enteredAt,omitempty"` // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrawEntrant) Reset() {
+	*x = DrawEntrant{}
+	mi := &file_v1_raffle_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrawEntrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawEntrant) ProtoMessage() {}
+
+func (x *DrawEntrant) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_raffle_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawEntrant.ProtoReflect.Descriptor instead.
+func (*DrawEntrant) Descriptor() ([]byte, []int) {
+	return file_v1_raffle_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DrawEntrant) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DrawEntrant) GetEnteredAt() string {
+	if x != nil {
+		return x.EnteredAt
+	}
+	return ""
+}
+
+type DrawWinner struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	CouponCode    string                 `protobuf:"bytes,2,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	Rank          int32                  `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	IssuedAt      string                 `protobuf:"bytes,4,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`    // RFC3339
+	Backfill      bool                   `protobuf:"varint,5,opt,name=backfill,proto3" json:"backfill,omitempty"`   // 당첨자가 돌려준 쿠폰을 대기자 순서로 받은 경우
+	Reclaimed     bool                   `protobuf:"varint,6,opt,name=reclaimed,proto3" json:"reclaimed,omitempty"` // 기한 안에 사용하지 않았거나 관리자가 회수함
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrawWinner) Reset() {
+	*x = DrawWinner{}
+	mi := &file_v1_raffle_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrawWinner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawWinner) ProtoMessage() {}
+
+func (x *DrawWinner) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_raffle_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawWinner.ProtoReflect.Descriptor instead.
+func (*DrawWinner) Descriptor() ([]byte, []int) {
+	return file_v1_raffle_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DrawWinner) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DrawWinner) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *DrawWinner) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *DrawWinner) GetIssuedAt() string {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return ""
+}
+
+func (x *DrawWinner) GetBackfill() bool {
+	if x != nil {
+		return x.Backfill
+	}
+	return false
+}
+
+func (x *DrawWinner) GetReclaimed() bool {
+	if x != nil {
+		return x.Reclaimed
+	}
+	return false
+}
+
+type ExportDrawRes struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Result         *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Algorithm      string                 `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	SeedCommitment string                 `protobuf:"bytes,3,opt,name=seedCommitment,proto3" json:"seedCommitment,omitempty"`
+	Seed           string                 `protobuf:"bytes,4,opt,name=seed,proto3" json:"seed,omitempty"` // 추첨 후에만 채워짐
+	DrawnAt        string                 `protobuf:"bytes,5,opt,name=drawnAt,proto3" json:"drawnAt,omitempty"`
+	Coupons        int32                  `protobuf:"varint,6,opt,name=coupons,proto3" json:"coupons,omitempty"` // 추첨 시점에 발급 가능했던 쿠폰 수 (당첨자 수 = min(coupons, entrants))
+	Entrants       []*DrawEntrant         `protobuf:"bytes,7,rep,name=entrants,proto3" json:"entrants,omitempty"`
+	Ranking        []string               `protobuf:"bytes,8,rep,name=ranking,proto3" json:"ranking,omitempty"`
+	Winners        []*DrawWinner          `protobuf:"bytes,9,rep,name=winners,proto3" json:"winners,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExportDrawRes) Reset() {
+	*x = ExportDrawRes{}
+	mi := &file_v1_raffle_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportDrawRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDrawRes) ProtoMessage() {}
+
+func (x *ExportDrawRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_raffle_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportDrawRes.ProtoReflect.Descriptor instead.
+func (*ExportDrawRes) Descriptor() ([]byte, []int) {
+	return file_v1_raffle_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExportDrawRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ExportDrawRes) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *ExportDrawRes) GetSeedCommitment() string {
+	if x != nil {
+		return x.SeedCommitment
+	}
+	return ""
+}
+
+func (x *ExportDrawRes) GetSeed() string {
+	if x != nil {
+		return x.Seed
+	}
+	return ""
+}
+
+func (x *ExportDrawRes) GetDrawnAt() string {
+	if x != nil {
+		return x.DrawnAt
+	}
+	return ""
+}
+
+func (x *ExportDrawRes) GetCoupons() int32 {
+	if x != nil {
+		return x.Coupons
+	}
+	return 0
+}
+
+func (x *ExportDrawRes) GetEntrants() []*DrawEntrant {
+	if x != nil {
+		return x.Entrants
+	}
+	return nil
+}
+
+func (x *ExportDrawRes) GetRanking() []string {
+	if x != nil {
+		return x.Ranking
+	}
+	return nil
+}
+
+func (x *ExportDrawRes) GetWinners() []*DrawWinner {
+	if x != nil {
+		return x.Winners
+	}
+	return nil
+}
+
+var File_v1_raffle_proto protoreflect.FileDescriptor
+
+const file_v1_raffle_proto_rawDesc = "" +
+	"\n" +
+	"\x0fv1/raffle.proto\x12\x02v1\x1a\x0fv1/common.proto\"\xc7\x01\n" +
+	"\fEnterDrawReq\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\tR\x06userId\x12@\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\v2 .v1.EnterDrawReq.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"X\n" +
+	"\fEnterDrawRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\x12\x1e\n" +
+	"\n" +
+	"failedRule\x18\x02 \x01(\tR\n" +
+	"failedRule\"J\n" +
+	"\x10GetDrawResultReq\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\tR\x06userId\"\xdc\x02\n" +
+	"\x10GetDrawResultRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\x12&\n" +
+	"\x0eseedCommitment\x18\x02 \x01(\tR\x0eseedCommitment\x12\x12\n" +
+	"\x04seed\x18\x03 \x01(\tR\x04seed\x12\x18\n" +
+	"\adrawnAt\x18\x04 \x01(\tR\adrawnAt\x12\x1a\n" +
+	"\bentrants\x18\x05 \x01(\x05R\bentrants\x12\x18\n" +
+	"\awinners\x18\x06 \x01(\x05R\awinners\x12\x1a\n" +
+	"\bwaitlist\x18\a \x01(\x05R\bwaitlist\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1e\n" +
+	"\n" +
+	"couponCode\x18\t \x01(\tR\n" +
+	"couponCode\x12\x12\n" +
+	"\x04rank\x18\n" +
+	" \x01(\x05R\x04rank\x12*\n" +
+	"\x10waitlistPosition\x18\v \x01(\x05R\x10waitlistPosition\"/\n" +
+	"\rExportDrawReq\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x01 \x01(\tR\n" +
+	"campaignId\"C\n" +
+	"\vDrawEntrant\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1c\n" +
+	"\tenteredAt\x18\x02 \x01(\tR\tenteredAt\"\xae\x01\n" +
+	"\n" +
+	"DrawWinner\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1e\n" +
+	"\n" +
+	"couponCode\x18\x02 \x01(\tR\n" +
+	"couponCode\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\x05R\x04rank\x12\x1a\n" +
+	"\bissuedAt\x18\x04 \x01(\tR\bissuedAt\x12\x1a\n" +
+	"\bbackfill\x18\x05 \x01(\bR\bbackfill\x12\x1c\n" +
+	"\treclaimed\x18\x06 \x01(\bR\treclaimed\"\xb8\x02\n" +
+	"\rExportDrawRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\x12\x1c\n" +
+	"\talgorithm\x18\x02 \x01(\tR\talgorithm\x12&\n" +
+	"\x0eseedCommitment\x18\x03 \x01(\tR\x0eseedCommitment\x12\x12\n" +
+	"\x04seed\x18\x04 \x01(\tR\x04seed\x12\x18\n" +
+	"\adrawnAt\x18\x05 \x01(\tR\adrawnAt\x12\x18\n" +
+	"\acoupons\x18\x06 \x01(\x05R\acoupons\x12+\n" +
+	"\bentrants\x18\a \x03(\v2\x0f.v1.DrawEntrantR\bentrants\x12\x18\n" +
+	"\aranking\x18\b \x03(\tR\aranking\x12(\n" +
+	"\awinners\x18\t \x03(\v2\x0e.v1.DrawWinnerR\awinners2\xb7\x01\n" +
+	"\rRaffleService\x121\n" +
+	"\tEnterDraw\x12\x10.v1.EnterDrawReq\x1a\x10.v1.EnterDrawRes\"\x00\x12=\n" +
+	"\rGetDrawResult\x12\x14.v1.GetDrawResultReq\x1a\x14.v1.GetDrawResultRes\"\x00\x124\n" +
+	"\n" +
+	"ExportDraw\x12\x11.v1.ExportDrawReq\x1a\x11.v1.ExportDrawRes\"\x00B8Z6github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1;v1b\x06proto3"
+
+var (
+	file_v1_raffle_proto_rawDescOnce sync.Once
+	file_v1_raffle_proto_rawDescData []byte
+)
+
+func file_v1_raffle_proto_rawDescGZIP() []byte {
+	file_v1_raffle_proto_rawDescOnce.Do(func() {
+		file_v1_raffle_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_raffle_proto_rawDesc), len(file_v1_raffle_proto_rawDesc)))
+	})
+	return file_v1_raffle_proto_rawDescData
+}
+
+var file_v1_raffle_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_v1_raffle_proto_goTypes = []any{
+	(*EnterDrawReq)(nil),     // 0: v1.EnterDrawReq
+	(*EnterDrawRes)(nil),     // 1: v1.EnterDrawRes
+	(*GetDrawResultReq)(nil), // 2: v1.GetDrawResultReq
+	(*GetDrawResultRes)(nil), // 3: v1.GetDrawResultRes
+	(*ExportDrawReq)(nil),    // 4: v1.ExportDrawReq
+	(*DrawEntrant)(nil),      // 5: v1.DrawEntrant
+	(*DrawWinner)(nil),       // 6: v1.DrawWinner
+	(*ExportDrawRes)(nil),    // 7: v1.ExportDrawRes
+	nil,                      // 8: v1.EnterDrawReq.AttributesEntry
+	(*BaseResponse)(nil),     // 9: v1.BaseResponse
+}
+var file_v1_raffle_proto_depIdxs = []int32{
+	8, // 0: v1.EnterDrawReq.attributes:type_name -> v1.EnterDrawReq.AttributesEntry
+	9, // 1: v1.EnterDrawRes.result:type_name -> v1.BaseResponse
+	9, // 2: v1.GetDrawResultRes.result:type_name -> v1.BaseResponse
+	9, // 3: v1.ExportDrawRes.result:type_name -> v1.BaseResponse
+	5, // 4: v1.ExportDrawRes.entrants:type_name -> v1.DrawEntrant
+	6, // 5: v1.ExportDrawRes.winners:type_name -> v1.DrawWinner
+	0, // 6: v1.RaffleService.EnterDraw:input_type -> v1.EnterDrawReq
+	2, // 7: v1.RaffleService.GetDrawResult:input_type -> v1.GetDrawResultReq
+	4, // 8: v1.RaffleService.ExportDraw:input_type -> v1.ExportDrawReq
+	1, // 9: v1.RaffleService.EnterDraw:output_type -> v1.EnterDrawRes
+	3, // 10: v1.RaffleService.GetDrawResult:output_type -> v1.GetDrawResultRes
+	7, // 11: v1.RaffleService.ExportDraw:output_type -> v1.ExportDrawRes
+	9, // [9:12] is the sub-list for method output_type
+	6, // [6:9] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_v1_raffle_proto_init() }
+func file_v1_raffle_proto_init() {
+	if File_v1_raffle_proto != nil {
+		return
+	}
+	file_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_raffle_proto_rawDesc), len(file_v1_raffle_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_raffle_proto_goTypes,
+		DependencyIndexes: file_v1_raffle_proto_depIdxs,
+		MessageInfos:      file_v1_raffle_proto_msgTypes,
+	}.Build()
+	File_v1_raffle_proto = out.File
+	file_v1_raffle_proto_goTypes = nil
+	file_v1_raffle_proto_depIdxs = nil
+}
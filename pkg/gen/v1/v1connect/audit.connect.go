@@ -0,0 +1,138 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: v1/audit.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AuditServiceName is the fully-qualified name of the AuditService service.
+	AuditServiceName = "v1.AuditService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AuditServiceQueryAuditLogProcedure is the fully-qualified name of the AuditService's
+	// QueryAuditLog RPC.
+	AuditServiceQueryAuditLogProcedure = "/v1.AuditService/QueryAuditLog"
+	// AuditServiceExportAuditLogProcedure is the fully-qualified name of the AuditService's
+	// ExportAuditLog RPC.
+	AuditServiceExportAuditLogProcedure = "/v1.AuditService/ExportAuditLog"
+)
+
+// AuditServiceClient is a client for the v1.AuditService service.
+type AuditServiceClient interface {
+	QueryAuditLog(context.Context, *connect.Request[v1.QueryAuditLogReq]) (*connect.Response[v1.QueryAuditLogRes], error)
+	ExportAuditLog(context.Context, *connect.Request[v1.QueryAuditLogReq]) (*connect.ServerStreamForClient[v1.ExportAuditLogRes], error)
+}
+
+// NewAuditServiceClient constructs a client for the v1.AuditService service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAuditServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AuditServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	auditServiceMethods := v1.File_v1_audit_proto.Services().ByName("AuditService").Methods()
+	return &auditServiceClient{
+		queryAuditLog: connect.NewClient[v1.QueryAuditLogReq, v1.QueryAuditLogRes](
+			httpClient,
+			baseURL+AuditServiceQueryAuditLogProcedure,
+			connect.WithSchema(auditServiceMethods.ByName("QueryAuditLog")),
+			connect.WithClientOptions(opts...),
+		),
+		exportAuditLog: connect.NewClient[v1.QueryAuditLogReq, v1.ExportAuditLogRes](
+			httpClient,
+			baseURL+AuditServiceExportAuditLogProcedure,
+			connect.WithSchema(auditServiceMethods.ByName("ExportAuditLog")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// auditServiceClient implements AuditServiceClient.
+type auditServiceClient struct {
+	queryAuditLog  *connect.Client[v1.QueryAuditLogReq, v1.QueryAuditLogRes]
+	exportAuditLog *connect.Client[v1.QueryAuditLogReq, v1.ExportAuditLogRes]
+}
+
+// QueryAuditLog calls v1.AuditService.QueryAuditLog.
+func (c *auditServiceClient) QueryAuditLog(ctx context.Context, req *connect.Request[v1.QueryAuditLogReq]) (*connect.Response[v1.QueryAuditLogRes], error) {
+	return c.queryAuditLog.CallUnary(ctx, req)
+}
+
+// ExportAuditLog calls v1.AuditService.ExportAuditLog.
+func (c *auditServiceClient) ExportAuditLog(ctx context.Context, req *connect.Request[v1.QueryAuditLogReq]) (*connect.ServerStreamForClient[v1.ExportAuditLogRes], error) {
+	return c.exportAuditLog.CallServerStream(ctx, req)
+}
+
+// AuditServiceHandler is an implementation of the v1.AuditService service.
+type AuditServiceHandler interface {
+	QueryAuditLog(context.Context, *connect.Request[v1.QueryAuditLogReq]) (*connect.Response[v1.QueryAuditLogRes], error)
+	ExportAuditLog(context.Context, *connect.Request[v1.QueryAuditLogReq], *connect.ServerStream[v1.ExportAuditLogRes]) error
+}
+
+// NewAuditServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAuditServiceHandler(svc AuditServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	auditServiceMethods := v1.File_v1_audit_proto.Services().ByName("AuditService").Methods()
+	auditServiceQueryAuditLogHandler := connect.NewUnaryHandler(
+		AuditServiceQueryAuditLogProcedure,
+		svc.QueryAuditLog,
+		connect.WithSchema(auditServiceMethods.ByName("QueryAuditLog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	auditServiceExportAuditLogHandler := connect.NewServerStreamHandler(
+		AuditServiceExportAuditLogProcedure,
+		svc.ExportAuditLog,
+		connect.WithSchema(auditServiceMethods.ByName("ExportAuditLog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/v1.AuditService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AuditServiceQueryAuditLogProcedure:
+			auditServiceQueryAuditLogHandler.ServeHTTP(w, r)
+		case AuditServiceExportAuditLogProcedure:
+			auditServiceExportAuditLogHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAuditServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAuditServiceHandler struct{}
+
+func (UnimplementedAuditServiceHandler) QueryAuditLog(context.Context, *connect.Request[v1.QueryAuditLogReq]) (*connect.Response[v1.QueryAuditLogRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.AuditService.QueryAuditLog is not implemented"))
+}
+
+func (UnimplementedAuditServiceHandler) ExportAuditLog(context.Context, *connect.Request[v1.QueryAuditLogReq], *connect.ServerStream[v1.ExportAuditLogRes]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("v1.AuditService.ExportAuditLog is not implemented"))
+}
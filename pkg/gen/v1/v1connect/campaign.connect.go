@@ -39,12 +39,44 @@ const (
 	// CampaignServiceGetCampaignProcedure is the fully-qualified name of the CampaignService's
 	// GetCampaign RPC.
 	CampaignServiceGetCampaignProcedure = "/v1.CampaignService/GetCampaign"
+	// CampaignServiceListCampaignsProcedure is the fully-qualified name of the CampaignService's
+	// ListCampaigns RPC.
+	CampaignServiceListCampaignsProcedure = "/v1.CampaignService/ListCampaigns"
+	// CampaignServiceUpdateCampaignProcedure is the fully-qualified name of the CampaignService's
+	// UpdateCampaign RPC.
+	CampaignServiceUpdateCampaignProcedure = "/v1.CampaignService/UpdateCampaign"
+	// CampaignServiceDeleteCampaignProcedure is the fully-qualified name of the CampaignService's
+	// DeleteCampaign RPC.
+	CampaignServiceDeleteCampaignProcedure = "/v1.CampaignService/DeleteCampaign"
+	// CampaignServicePauseCampaignProcedure is the fully-qualified name of the CampaignService's
+	// PauseCampaign RPC.
+	CampaignServicePauseCampaignProcedure = "/v1.CampaignService/PauseCampaign"
+	// CampaignServiceResumeCampaignProcedure is the fully-qualified name of the CampaignService's
+	// ResumeCampaign RPC.
+	CampaignServiceResumeCampaignProcedure = "/v1.CampaignService/ResumeCampaign"
+	// CampaignServiceGetCampaignStatsProcedure is the fully-qualified name of the CampaignService's
+	// GetCampaignStats RPC.
+	CampaignServiceGetCampaignStatsProcedure = "/v1.CampaignService/GetCampaignStats"
+	// CampaignServiceImportCouponsProcedure is the fully-qualified name of the CampaignService's
+	// ImportCoupons RPC.
+	CampaignServiceImportCouponsProcedure = "/v1.CampaignService/ImportCoupons"
+	// CampaignServiceExportCampaignProcedure is the fully-qualified name of the CampaignService's
+	// ExportCampaign RPC.
+	CampaignServiceExportCampaignProcedure = "/v1.CampaignService/ExportCampaign"
 )
 
 // CampaignServiceClient is a client for the v1.CampaignService service.
 type CampaignServiceClient interface {
 	CreateCampaign(context.Context, *connect.Request[v1.CreateCampaignReq]) (*connect.Response[v1.CreateCampaignRes], error)
 	GetCampaign(context.Context, *connect.Request[v1.GetCampaignReq]) (*connect.Response[v1.GetCampaignRes], error)
+	ListCampaigns(context.Context, *connect.Request[v1.ListCampaignsReq]) (*connect.Response[v1.ListCampaignsRes], error)
+	UpdateCampaign(context.Context, *connect.Request[v1.UpdateCampaignReq]) (*connect.Response[v1.UpdateCampaignRes], error)
+	DeleteCampaign(context.Context, *connect.Request[v1.DeleteCampaignReq]) (*connect.Response[v1.DeleteCampaignRes], error)
+	PauseCampaign(context.Context, *connect.Request[v1.PauseCampaignReq]) (*connect.Response[v1.PauseCampaignRes], error)
+	ResumeCampaign(context.Context, *connect.Request[v1.ResumeCampaignReq]) (*connect.Response[v1.ResumeCampaignRes], error)
+	GetCampaignStats(context.Context, *connect.Request[v1.GetCampaignStatsReq]) (*connect.Response[v1.GetCampaignStatsRes], error)
+	ImportCoupons(context.Context) *connect.ClientStreamForClient[v1.ImportCouponsReq, v1.ImportCouponsRes]
+	ExportCampaign(context.Context, *connect.Request[v1.ExportCampaignReq]) (*connect.ServerStreamForClient[v1.ExportCampaignRes], error)
 }
 
 // NewCampaignServiceClient constructs a client for the v1.CampaignService service. By default, it
@@ -70,13 +102,69 @@ func NewCampaignServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(campaignServiceMethods.ByName("GetCampaign")),
 			connect.WithClientOptions(opts...),
 		),
+		listCampaigns: connect.NewClient[v1.ListCampaignsReq, v1.ListCampaignsRes](
+			httpClient,
+			baseURL+CampaignServiceListCampaignsProcedure,
+			connect.WithSchema(campaignServiceMethods.ByName("ListCampaigns")),
+			connect.WithClientOptions(opts...),
+		),
+		updateCampaign: connect.NewClient[v1.UpdateCampaignReq, v1.UpdateCampaignRes](
+			httpClient,
+			baseURL+CampaignServiceUpdateCampaignProcedure,
+			connect.WithSchema(campaignServiceMethods.ByName("UpdateCampaign")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteCampaign: connect.NewClient[v1.DeleteCampaignReq, v1.DeleteCampaignRes](
+			httpClient,
+			baseURL+CampaignServiceDeleteCampaignProcedure,
+			connect.WithSchema(campaignServiceMethods.ByName("DeleteCampaign")),
+			connect.WithClientOptions(opts...),
+		),
+		pauseCampaign: connect.NewClient[v1.PauseCampaignReq, v1.PauseCampaignRes](
+			httpClient,
+			baseURL+CampaignServicePauseCampaignProcedure,
+			connect.WithSchema(campaignServiceMethods.ByName("PauseCampaign")),
+			connect.WithClientOptions(opts...),
+		),
+		resumeCampaign: connect.NewClient[v1.ResumeCampaignReq, v1.ResumeCampaignRes](
+			httpClient,
+			baseURL+CampaignServiceResumeCampaignProcedure,
+			connect.WithSchema(campaignServiceMethods.ByName("ResumeCampaign")),
+			connect.WithClientOptions(opts...),
+		),
+		getCampaignStats: connect.NewClient[v1.GetCampaignStatsReq, v1.GetCampaignStatsRes](
+			httpClient,
+			baseURL+CampaignServiceGetCampaignStatsProcedure,
+			connect.WithSchema(campaignServiceMethods.ByName("GetCampaignStats")),
+			connect.WithClientOptions(opts...),
+		),
+		importCoupons: connect.NewClient[v1.ImportCouponsReq, v1.ImportCouponsRes](
+			httpClient,
+			baseURL+CampaignServiceImportCouponsProcedure,
+			connect.WithSchema(campaignServiceMethods.ByName("ImportCoupons")),
+			connect.WithClientOptions(opts...),
+		),
+		exportCampaign: connect.NewClient[v1.ExportCampaignReq, v1.ExportCampaignRes](
+			httpClient,
+			baseURL+CampaignServiceExportCampaignProcedure,
+			connect.WithSchema(campaignServiceMethods.ByName("ExportCampaign")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // campaignServiceClient implements CampaignServiceClient.
 type campaignServiceClient struct {
-	createCampaign *connect.Client[v1.CreateCampaignReq, v1.CreateCampaignRes]
-	getCampaign    *connect.Client[v1.GetCampaignReq, v1.GetCampaignRes]
+	createCampaign   *connect.Client[v1.CreateCampaignReq, v1.CreateCampaignRes]
+	getCampaign      *connect.Client[v1.GetCampaignReq, v1.GetCampaignRes]
+	listCampaigns    *connect.Client[v1.ListCampaignsReq, v1.ListCampaignsRes]
+	updateCampaign   *connect.Client[v1.UpdateCampaignReq, v1.UpdateCampaignRes]
+	deleteCampaign   *connect.Client[v1.DeleteCampaignReq, v1.DeleteCampaignRes]
+	pauseCampaign    *connect.Client[v1.PauseCampaignReq, v1.PauseCampaignRes]
+	resumeCampaign   *connect.Client[v1.ResumeCampaignReq, v1.ResumeCampaignRes]
+	getCampaignStats *connect.Client[v1.GetCampaignStatsReq, v1.GetCampaignStatsRes]
+	importCoupons    *connect.Client[v1.ImportCouponsReq, v1.ImportCouponsRes]
+	exportCampaign   *connect.Client[v1.ExportCampaignReq, v1.ExportCampaignRes]
 }
 
 // CreateCampaign calls v1.CampaignService.CreateCampaign.
@@ -89,10 +177,58 @@ func (c *campaignServiceClient) GetCampaign(ctx context.Context, req *connect.Re
 	return c.getCampaign.CallUnary(ctx, req)
 }
 
+// ListCampaigns calls v1.CampaignService.ListCampaigns.
+func (c *campaignServiceClient) ListCampaigns(ctx context.Context, req *connect.Request[v1.ListCampaignsReq]) (*connect.Response[v1.ListCampaignsRes], error) {
+	return c.listCampaigns.CallUnary(ctx, req)
+}
+
+// UpdateCampaign calls v1.CampaignService.UpdateCampaign.
+func (c *campaignServiceClient) UpdateCampaign(ctx context.Context, req *connect.Request[v1.UpdateCampaignReq]) (*connect.Response[v1.UpdateCampaignRes], error) {
+	return c.updateCampaign.CallUnary(ctx, req)
+}
+
+// DeleteCampaign calls v1.CampaignService.DeleteCampaign.
+func (c *campaignServiceClient) DeleteCampaign(ctx context.Context, req *connect.Request[v1.DeleteCampaignReq]) (*connect.Response[v1.DeleteCampaignRes], error) {
+	return c.deleteCampaign.CallUnary(ctx, req)
+}
+
+// PauseCampaign calls v1.CampaignService.PauseCampaign.
+func (c *campaignServiceClient) PauseCampaign(ctx context.Context, req *connect.Request[v1.PauseCampaignReq]) (*connect.Response[v1.PauseCampaignRes], error) {
+	return c.pauseCampaign.CallUnary(ctx, req)
+}
+
+// ResumeCampaign calls v1.CampaignService.ResumeCampaign.
+func (c *campaignServiceClient) ResumeCampaign(ctx context.Context, req *connect.Request[v1.ResumeCampaignReq]) (*connect.Response[v1.ResumeCampaignRes], error) {
+	return c.resumeCampaign.CallUnary(ctx, req)
+}
+
+// GetCampaignStats calls v1.CampaignService.GetCampaignStats.
+func (c *campaignServiceClient) GetCampaignStats(ctx context.Context, req *connect.Request[v1.GetCampaignStatsReq]) (*connect.Response[v1.GetCampaignStatsRes], error) {
+	return c.getCampaignStats.CallUnary(ctx, req)
+}
+
+// ImportCoupons calls v1.CampaignService.ImportCoupons.
+func (c *campaignServiceClient) ImportCoupons(ctx context.Context) *connect.ClientStreamForClient[v1.ImportCouponsReq, v1.ImportCouponsRes] {
+	return c.importCoupons.CallClientStream(ctx)
+}
+
+// ExportCampaign calls v1.CampaignService.ExportCampaign.
+func (c *campaignServiceClient) ExportCampaign(ctx context.Context, req *connect.Request[v1.ExportCampaignReq]) (*connect.ServerStreamForClient[v1.ExportCampaignRes], error) {
+	return c.exportCampaign.CallServerStream(ctx, req)
+}
+
 // CampaignServiceHandler is an implementation of the v1.CampaignService service.
 type CampaignServiceHandler interface {
 	CreateCampaign(context.Context, *connect.Request[v1.CreateCampaignReq]) (*connect.Response[v1.CreateCampaignRes], error)
 	GetCampaign(context.Context, *connect.Request[v1.GetCampaignReq]) (*connect.Response[v1.GetCampaignRes], error)
+	ListCampaigns(context.Context, *connect.Request[v1.ListCampaignsReq]) (*connect.Response[v1.ListCampaignsRes], error)
+	UpdateCampaign(context.Context, *connect.Request[v1.UpdateCampaignReq]) (*connect.Response[v1.UpdateCampaignRes], error)
+	DeleteCampaign(context.Context, *connect.Request[v1.DeleteCampaignReq]) (*connect.Response[v1.DeleteCampaignRes], error)
+	PauseCampaign(context.Context, *connect.Request[v1.PauseCampaignReq]) (*connect.Response[v1.PauseCampaignRes], error)
+	ResumeCampaign(context.Context, *connect.Request[v1.ResumeCampaignReq]) (*connect.Response[v1.ResumeCampaignRes], error)
+	GetCampaignStats(context.Context, *connect.Request[v1.GetCampaignStatsReq]) (*connect.Response[v1.GetCampaignStatsRes], error)
+	ImportCoupons(context.Context, *connect.ClientStream[v1.ImportCouponsReq]) (*connect.Response[v1.ImportCouponsRes], error)
+	ExportCampaign(context.Context, *connect.Request[v1.ExportCampaignReq], *connect.ServerStream[v1.ExportCampaignRes]) error
 }
 
 // NewCampaignServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -114,12 +250,76 @@ func NewCampaignServiceHandler(svc CampaignServiceHandler, opts ...connect.Handl
 		connect.WithSchema(campaignServiceMethods.ByName("GetCampaign")),
 		connect.WithHandlerOptions(opts...),
 	)
+	campaignServiceListCampaignsHandler := connect.NewUnaryHandler(
+		CampaignServiceListCampaignsProcedure,
+		svc.ListCampaigns,
+		connect.WithSchema(campaignServiceMethods.ByName("ListCampaigns")),
+		connect.WithHandlerOptions(opts...),
+	)
+	campaignServiceUpdateCampaignHandler := connect.NewUnaryHandler(
+		CampaignServiceUpdateCampaignProcedure,
+		svc.UpdateCampaign,
+		connect.WithSchema(campaignServiceMethods.ByName("UpdateCampaign")),
+		connect.WithHandlerOptions(opts...),
+	)
+	campaignServiceDeleteCampaignHandler := connect.NewUnaryHandler(
+		CampaignServiceDeleteCampaignProcedure,
+		svc.DeleteCampaign,
+		connect.WithSchema(campaignServiceMethods.ByName("DeleteCampaign")),
+		connect.WithHandlerOptions(opts...),
+	)
+	campaignServicePauseCampaignHandler := connect.NewUnaryHandler(
+		CampaignServicePauseCampaignProcedure,
+		svc.PauseCampaign,
+		connect.WithSchema(campaignServiceMethods.ByName("PauseCampaign")),
+		connect.WithHandlerOptions(opts...),
+	)
+	campaignServiceResumeCampaignHandler := connect.NewUnaryHandler(
+		CampaignServiceResumeCampaignProcedure,
+		svc.ResumeCampaign,
+		connect.WithSchema(campaignServiceMethods.ByName("ResumeCampaign")),
+		connect.WithHandlerOptions(opts...),
+	)
+	campaignServiceGetCampaignStatsHandler := connect.NewUnaryHandler(
+		CampaignServiceGetCampaignStatsProcedure,
+		svc.GetCampaignStats,
+		connect.WithSchema(campaignServiceMethods.ByName("GetCampaignStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	campaignServiceImportCouponsHandler := connect.NewClientStreamHandler(
+		CampaignServiceImportCouponsProcedure,
+		svc.ImportCoupons,
+		connect.WithSchema(campaignServiceMethods.ByName("ImportCoupons")),
+		connect.WithHandlerOptions(opts...),
+	)
+	campaignServiceExportCampaignHandler := connect.NewServerStreamHandler(
+		CampaignServiceExportCampaignProcedure,
+		svc.ExportCampaign,
+		connect.WithSchema(campaignServiceMethods.ByName("ExportCampaign")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/v1.CampaignService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case CampaignServiceCreateCampaignProcedure:
 			campaignServiceCreateCampaignHandler.ServeHTTP(w, r)
 		case CampaignServiceGetCampaignProcedure:
 			campaignServiceGetCampaignHandler.ServeHTTP(w, r)
+		case CampaignServiceListCampaignsProcedure:
+			campaignServiceListCampaignsHandler.ServeHTTP(w, r)
+		case CampaignServiceUpdateCampaignProcedure:
+			campaignServiceUpdateCampaignHandler.ServeHTTP(w, r)
+		case CampaignServiceDeleteCampaignProcedure:
+			campaignServiceDeleteCampaignHandler.ServeHTTP(w, r)
+		case CampaignServicePauseCampaignProcedure:
+			campaignServicePauseCampaignHandler.ServeHTTP(w, r)
+		case CampaignServiceResumeCampaignProcedure:
+			campaignServiceResumeCampaignHandler.ServeHTTP(w, r)
+		case CampaignServiceGetCampaignStatsProcedure:
+			campaignServiceGetCampaignStatsHandler.ServeHTTP(w, r)
+		case CampaignServiceImportCouponsProcedure:
+			campaignServiceImportCouponsHandler.ServeHTTP(w, r)
+		case CampaignServiceExportCampaignProcedure:
+			campaignServiceExportCampaignHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -136,3 +336,35 @@ func (UnimplementedCampaignServiceHandler) CreateCampaign(context.Context, *conn
 func (UnimplementedCampaignServiceHandler) GetCampaign(context.Context, *connect.Request[v1.GetCampaignReq]) (*connect.Response[v1.GetCampaignRes], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.CampaignService.GetCampaign is not implemented"))
 }
+
+func (UnimplementedCampaignServiceHandler) ListCampaigns(context.Context, *connect.Request[v1.ListCampaignsReq]) (*connect.Response[v1.ListCampaignsRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.CampaignService.ListCampaigns is not implemented"))
+}
+
+func (UnimplementedCampaignServiceHandler) UpdateCampaign(context.Context, *connect.Request[v1.UpdateCampaignReq]) (*connect.Response[v1.UpdateCampaignRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.CampaignService.UpdateCampaign is not implemented"))
+}
+
+func (UnimplementedCampaignServiceHandler) DeleteCampaign(context.Context, *connect.Request[v1.DeleteCampaignReq]) (*connect.Response[v1.DeleteCampaignRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.CampaignService.DeleteCampaign is not implemented"))
+}
+
+func (UnimplementedCampaignServiceHandler) PauseCampaign(context.Context, *connect.Request[v1.PauseCampaignReq]) (*connect.Response[v1.PauseCampaignRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.CampaignService.PauseCampaign is not implemented"))
+}
+
+func (UnimplementedCampaignServiceHandler) ResumeCampaign(context.Context, *connect.Request[v1.ResumeCampaignReq]) (*connect.Response[v1.ResumeCampaignRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.CampaignService.ResumeCampaign is not implemented"))
+}
+
+func (UnimplementedCampaignServiceHandler) GetCampaignStats(context.Context, *connect.Request[v1.GetCampaignStatsReq]) (*connect.Response[v1.GetCampaignStatsRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.CampaignService.GetCampaignStats is not implemented"))
+}
+
+func (UnimplementedCampaignServiceHandler) ImportCoupons(context.Context, *connect.ClientStream[v1.ImportCouponsReq]) (*connect.Response[v1.ImportCouponsRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.CampaignService.ImportCoupons is not implemented"))
+}
+
+func (UnimplementedCampaignServiceHandler) ExportCampaign(context.Context, *connect.Request[v1.ExportCampaignReq], *connect.ServerStream[v1.ExportCampaignRes]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("v1.CampaignService.ExportCampaign is not implemented"))
+}
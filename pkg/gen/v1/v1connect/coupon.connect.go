@@ -36,11 +36,51 @@ const (
 	// CouponServiceIssueCouponProcedure is the fully-qualified name of the CouponService's IssueCoupon
 	// RPC.
 	CouponServiceIssueCouponProcedure = "/v1.CouponService/IssueCoupon"
+	// CouponServiceEvaluateEligibilityProcedure is the fully-qualified name of the CouponService's
+	// EvaluateEligibility RPC.
+	CouponServiceEvaluateEligibilityProcedure = "/v1.CouponService/EvaluateEligibility"
+	// CouponServiceGetWaitlistPositionProcedure is the fully-qualified name of the CouponService's
+	// GetWaitlistPosition RPC.
+	CouponServiceGetWaitlistPositionProcedure = "/v1.CouponService/GetWaitlistPosition"
+	// CouponServiceLeaveWaitlistProcedure is the fully-qualified name of the CouponService's
+	// LeaveWaitlist RPC.
+	CouponServiceLeaveWaitlistProcedure = "/v1.CouponService/LeaveWaitlist"
+	// CouponServiceRedeemCouponProcedure is the fully-qualified name of the CouponService's
+	// RedeemCoupon RPC.
+	CouponServiceRedeemCouponProcedure = "/v1.CouponService/RedeemCoupon"
+	// CouponServiceQuoteDiscountProcedure is the fully-qualified name of the CouponService's
+	// QuoteDiscount RPC.
+	CouponServiceQuoteDiscountProcedure = "/v1.CouponService/QuoteDiscount"
+	// CouponServiceRevokeCouponProcedure is the fully-qualified name of the CouponService's
+	// RevokeCoupon RPC.
+	CouponServiceRevokeCouponProcedure = "/v1.CouponService/RevokeCoupon"
+	// CouponServiceUnredeemCouponProcedure is the fully-qualified name of the CouponService's
+	// UnredeemCoupon RPC.
+	CouponServiceUnredeemCouponProcedure = "/v1.CouponService/UnredeemCoupon"
+	// CouponServiceReserveCouponProcedure is the fully-qualified name of the CouponService's
+	// ReserveCoupon RPC.
+	CouponServiceReserveCouponProcedure = "/v1.CouponService/ReserveCoupon"
+	// CouponServiceConfirmRedemptionProcedure is the fully-qualified name of the CouponService's
+	// ConfirmRedemption RPC.
+	CouponServiceConfirmRedemptionProcedure = "/v1.CouponService/ConfirmRedemption"
+	// CouponServiceReleaseReservationProcedure is the fully-qualified name of the CouponService's
+	// ReleaseReservation RPC.
+	CouponServiceReleaseReservationProcedure = "/v1.CouponService/ReleaseReservation"
 )
 
 // CouponServiceClient is a client for the v1.CouponService service.
 type CouponServiceClient interface {
 	IssueCoupon(context.Context, *connect.Request[v1.IssueCouponReq]) (*connect.Response[v1.IssueCouponRes], error)
+	EvaluateEligibility(context.Context, *connect.Request[v1.EvaluateEligibilityReq]) (*connect.Response[v1.EvaluateEligibilityRes], error)
+	GetWaitlistPosition(context.Context, *connect.Request[v1.GetWaitlistPositionReq]) (*connect.Response[v1.GetWaitlistPositionRes], error)
+	LeaveWaitlist(context.Context, *connect.Request[v1.LeaveWaitlistReq]) (*connect.Response[v1.LeaveWaitlistRes], error)
+	RedeemCoupon(context.Context, *connect.Request[v1.RedeemCouponReq]) (*connect.Response[v1.RedeemCouponRes], error)
+	QuoteDiscount(context.Context, *connect.Request[v1.QuoteDiscountReq]) (*connect.Response[v1.QuoteDiscountRes], error)
+	RevokeCoupon(context.Context, *connect.Request[v1.RevokeCouponReq]) (*connect.Response[v1.RevokeCouponRes], error)
+	UnredeemCoupon(context.Context, *connect.Request[v1.UnredeemCouponReq]) (*connect.Response[v1.UnredeemCouponRes], error)
+	ReserveCoupon(context.Context, *connect.Request[v1.ReserveCouponReq]) (*connect.Response[v1.ReserveCouponRes], error)
+	ConfirmRedemption(context.Context, *connect.Request[v1.ConfirmRedemptionReq]) (*connect.Response[v1.ConfirmRedemptionRes], error)
+	ReleaseReservation(context.Context, *connect.Request[v1.ReleaseReservationReq]) (*connect.Response[v1.ReleaseReservationRes], error)
 }
 
 // NewCouponServiceClient constructs a client for the v1.CouponService service. By default, it uses
@@ -60,12 +100,82 @@ func NewCouponServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(couponServiceMethods.ByName("IssueCoupon")),
 			connect.WithClientOptions(opts...),
 		),
+		evaluateEligibility: connect.NewClient[v1.EvaluateEligibilityReq, v1.EvaluateEligibilityRes](
+			httpClient,
+			baseURL+CouponServiceEvaluateEligibilityProcedure,
+			connect.WithSchema(couponServiceMethods.ByName("EvaluateEligibility")),
+			connect.WithClientOptions(opts...),
+		),
+		getWaitlistPosition: connect.NewClient[v1.GetWaitlistPositionReq, v1.GetWaitlistPositionRes](
+			httpClient,
+			baseURL+CouponServiceGetWaitlistPositionProcedure,
+			connect.WithSchema(couponServiceMethods.ByName("GetWaitlistPosition")),
+			connect.WithClientOptions(opts...),
+		),
+		leaveWaitlist: connect.NewClient[v1.LeaveWaitlistReq, v1.LeaveWaitlistRes](
+			httpClient,
+			baseURL+CouponServiceLeaveWaitlistProcedure,
+			connect.WithSchema(couponServiceMethods.ByName("LeaveWaitlist")),
+			connect.WithClientOptions(opts...),
+		),
+		redeemCoupon: connect.NewClient[v1.RedeemCouponReq, v1.RedeemCouponRes](
+			httpClient,
+			baseURL+CouponServiceRedeemCouponProcedure,
+			connect.WithSchema(couponServiceMethods.ByName("RedeemCoupon")),
+			connect.WithClientOptions(opts...),
+		),
+		quoteDiscount: connect.NewClient[v1.QuoteDiscountReq, v1.QuoteDiscountRes](
+			httpClient,
+			baseURL+CouponServiceQuoteDiscountProcedure,
+			connect.WithSchema(couponServiceMethods.ByName("QuoteDiscount")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeCoupon: connect.NewClient[v1.RevokeCouponReq, v1.RevokeCouponRes](
+			httpClient,
+			baseURL+CouponServiceRevokeCouponProcedure,
+			connect.WithSchema(couponServiceMethods.ByName("RevokeCoupon")),
+			connect.WithClientOptions(opts...),
+		),
+		unredeemCoupon: connect.NewClient[v1.UnredeemCouponReq, v1.UnredeemCouponRes](
+			httpClient,
+			baseURL+CouponServiceUnredeemCouponProcedure,
+			connect.WithSchema(couponServiceMethods.ByName("UnredeemCoupon")),
+			connect.WithClientOptions(opts...),
+		),
+		reserveCoupon: connect.NewClient[v1.ReserveCouponReq, v1.ReserveCouponRes](
+			httpClient,
+			baseURL+CouponServiceReserveCouponProcedure,
+			connect.WithSchema(couponServiceMethods.ByName("ReserveCoupon")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmRedemption: connect.NewClient[v1.ConfirmRedemptionReq, v1.ConfirmRedemptionRes](
+			httpClient,
+			baseURL+CouponServiceConfirmRedemptionProcedure,
+			connect.WithSchema(couponServiceMethods.ByName("ConfirmRedemption")),
+			connect.WithClientOptions(opts...),
+		),
+		releaseReservation: connect.NewClient[v1.ReleaseReservationReq, v1.ReleaseReservationRes](
+			httpClient,
+			baseURL+CouponServiceReleaseReservationProcedure,
+			connect.WithSchema(couponServiceMethods.ByName("ReleaseReservation")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // couponServiceClient implements CouponServiceClient.
 type couponServiceClient struct {
-	issueCoupon *connect.Client[v1.IssueCouponReq, v1.IssueCouponRes]
+	issueCoupon         *connect.Client[v1.IssueCouponReq, v1.IssueCouponRes]
+	evaluateEligibility *connect.Client[v1.EvaluateEligibilityReq, v1.EvaluateEligibilityRes]
+	getWaitlistPosition *connect.Client[v1.GetWaitlistPositionReq, v1.GetWaitlistPositionRes]
+	leaveWaitlist       *connect.Client[v1.LeaveWaitlistReq, v1.LeaveWaitlistRes]
+	redeemCoupon        *connect.Client[v1.RedeemCouponReq, v1.RedeemCouponRes]
+	quoteDiscount       *connect.Client[v1.QuoteDiscountReq, v1.QuoteDiscountRes]
+	revokeCoupon        *connect.Client[v1.RevokeCouponReq, v1.RevokeCouponRes]
+	unredeemCoupon      *connect.Client[v1.UnredeemCouponReq, v1.UnredeemCouponRes]
+	reserveCoupon       *connect.Client[v1.ReserveCouponReq, v1.ReserveCouponRes]
+	confirmRedemption   *connect.Client[v1.ConfirmRedemptionReq, v1.ConfirmRedemptionRes]
+	releaseReservation  *connect.Client[v1.ReleaseReservationReq, v1.ReleaseReservationRes]
 }
 
 // IssueCoupon calls v1.CouponService.IssueCoupon.
@@ -73,9 +183,69 @@ func (c *couponServiceClient) IssueCoupon(ctx context.Context, req *connect.Requ
 	return c.issueCoupon.CallUnary(ctx, req)
 }
 
+// EvaluateEligibility calls v1.CouponService.EvaluateEligibility.
+func (c *couponServiceClient) EvaluateEligibility(ctx context.Context, req *connect.Request[v1.EvaluateEligibilityReq]) (*connect.Response[v1.EvaluateEligibilityRes], error) {
+	return c.evaluateEligibility.CallUnary(ctx, req)
+}
+
+// GetWaitlistPosition calls v1.CouponService.GetWaitlistPosition.
+func (c *couponServiceClient) GetWaitlistPosition(ctx context.Context, req *connect.Request[v1.GetWaitlistPositionReq]) (*connect.Response[v1.GetWaitlistPositionRes], error) {
+	return c.getWaitlistPosition.CallUnary(ctx, req)
+}
+
+// LeaveWaitlist calls v1.CouponService.LeaveWaitlist.
+func (c *couponServiceClient) LeaveWaitlist(ctx context.Context, req *connect.Request[v1.LeaveWaitlistReq]) (*connect.Response[v1.LeaveWaitlistRes], error) {
+	return c.leaveWaitlist.CallUnary(ctx, req)
+}
+
+// RedeemCoupon calls v1.CouponService.RedeemCoupon.
+func (c *couponServiceClient) RedeemCoupon(ctx context.Context, req *connect.Request[v1.RedeemCouponReq]) (*connect.Response[v1.RedeemCouponRes], error) {
+	return c.redeemCoupon.CallUnary(ctx, req)
+}
+
+// QuoteDiscount calls v1.CouponService.QuoteDiscount.
+func (c *couponServiceClient) QuoteDiscount(ctx context.Context, req *connect.Request[v1.QuoteDiscountReq]) (*connect.Response[v1.QuoteDiscountRes], error) {
+	return c.quoteDiscount.CallUnary(ctx, req)
+}
+
+// RevokeCoupon calls v1.CouponService.RevokeCoupon.
+func (c *couponServiceClient) RevokeCoupon(ctx context.Context, req *connect.Request[v1.RevokeCouponReq]) (*connect.Response[v1.RevokeCouponRes], error) {
+	return c.revokeCoupon.CallUnary(ctx, req)
+}
+
+// UnredeemCoupon calls v1.CouponService.UnredeemCoupon.
+func (c *couponServiceClient) UnredeemCoupon(ctx context.Context, req *connect.Request[v1.UnredeemCouponReq]) (*connect.Response[v1.UnredeemCouponRes], error) {
+	return c.unredeemCoupon.CallUnary(ctx, req)
+}
+
+// ReserveCoupon calls v1.CouponService.ReserveCoupon.
+func (c *couponServiceClient) ReserveCoupon(ctx context.Context, req *connect.Request[v1.ReserveCouponReq]) (*connect.Response[v1.ReserveCouponRes], error) {
+	return c.reserveCoupon.CallUnary(ctx, req)
+}
+
+// ConfirmRedemption calls v1.CouponService.ConfirmRedemption.
+func (c *couponServiceClient) ConfirmRedemption(ctx context.Context, req *connect.Request[v1.ConfirmRedemptionReq]) (*connect.Response[v1.ConfirmRedemptionRes], error) {
+	return c.confirmRedemption.CallUnary(ctx, req)
+}
+
+// ReleaseReservation calls v1.CouponService.ReleaseReservation.
+func (c *couponServiceClient) ReleaseReservation(ctx context.Context, req *connect.Request[v1.ReleaseReservationReq]) (*connect.Response[v1.ReleaseReservationRes], error) {
+	return c.releaseReservation.CallUnary(ctx, req)
+}
+
 // CouponServiceHandler is an implementation of the v1.CouponService service.
 type CouponServiceHandler interface {
 	IssueCoupon(context.Context, *connect.Request[v1.IssueCouponReq]) (*connect.Response[v1.IssueCouponRes], error)
+	EvaluateEligibility(context.Context, *connect.Request[v1.EvaluateEligibilityReq]) (*connect.Response[v1.EvaluateEligibilityRes], error)
+	GetWaitlistPosition(context.Context, *connect.Request[v1.GetWaitlistPositionReq]) (*connect.Response[v1.GetWaitlistPositionRes], error)
+	LeaveWaitlist(context.Context, *connect.Request[v1.LeaveWaitlistReq]) (*connect.Response[v1.LeaveWaitlistRes], error)
+	RedeemCoupon(context.Context, *connect.Request[v1.RedeemCouponReq]) (*connect.Response[v1.RedeemCouponRes], error)
+	QuoteDiscount(context.Context, *connect.Request[v1.QuoteDiscountReq]) (*connect.Response[v1.QuoteDiscountRes], error)
+	RevokeCoupon(context.Context, *connect.Request[v1.RevokeCouponReq]) (*connect.Response[v1.RevokeCouponRes], error)
+	UnredeemCoupon(context.Context, *connect.Request[v1.UnredeemCouponReq]) (*connect.Response[v1.UnredeemCouponRes], error)
+	ReserveCoupon(context.Context, *connect.Request[v1.ReserveCouponReq]) (*connect.Response[v1.ReserveCouponRes], error)
+	ConfirmRedemption(context.Context, *connect.Request[v1.ConfirmRedemptionReq]) (*connect.Response[v1.ConfirmRedemptionRes], error)
+	ReleaseReservation(context.Context, *connect.Request[v1.ReleaseReservationReq]) (*connect.Response[v1.ReleaseReservationRes], error)
 }
 
 // NewCouponServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -91,10 +261,90 @@ func NewCouponServiceHandler(svc CouponServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(couponServiceMethods.ByName("IssueCoupon")),
 		connect.WithHandlerOptions(opts...),
 	)
+	couponServiceEvaluateEligibilityHandler := connect.NewUnaryHandler(
+		CouponServiceEvaluateEligibilityProcedure,
+		svc.EvaluateEligibility,
+		connect.WithSchema(couponServiceMethods.ByName("EvaluateEligibility")),
+		connect.WithHandlerOptions(opts...),
+	)
+	couponServiceGetWaitlistPositionHandler := connect.NewUnaryHandler(
+		CouponServiceGetWaitlistPositionProcedure,
+		svc.GetWaitlistPosition,
+		connect.WithSchema(couponServiceMethods.ByName("GetWaitlistPosition")),
+		connect.WithHandlerOptions(opts...),
+	)
+	couponServiceLeaveWaitlistHandler := connect.NewUnaryHandler(
+		CouponServiceLeaveWaitlistProcedure,
+		svc.LeaveWaitlist,
+		connect.WithSchema(couponServiceMethods.ByName("LeaveWaitlist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	couponServiceRedeemCouponHandler := connect.NewUnaryHandler(
+		CouponServiceRedeemCouponProcedure,
+		svc.RedeemCoupon,
+		connect.WithSchema(couponServiceMethods.ByName("RedeemCoupon")),
+		connect.WithHandlerOptions(opts...),
+	)
+	couponServiceQuoteDiscountHandler := connect.NewUnaryHandler(
+		CouponServiceQuoteDiscountProcedure,
+		svc.QuoteDiscount,
+		connect.WithSchema(couponServiceMethods.ByName("QuoteDiscount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	couponServiceRevokeCouponHandler := connect.NewUnaryHandler(
+		CouponServiceRevokeCouponProcedure,
+		svc.RevokeCoupon,
+		connect.WithSchema(couponServiceMethods.ByName("RevokeCoupon")),
+		connect.WithHandlerOptions(opts...),
+	)
+	couponServiceUnredeemCouponHandler := connect.NewUnaryHandler(
+		CouponServiceUnredeemCouponProcedure,
+		svc.UnredeemCoupon,
+		connect.WithSchema(couponServiceMethods.ByName("UnredeemCoupon")),
+		connect.WithHandlerOptions(opts...),
+	)
+	couponServiceReserveCouponHandler := connect.NewUnaryHandler(
+		CouponServiceReserveCouponProcedure,
+		svc.ReserveCoupon,
+		connect.WithSchema(couponServiceMethods.ByName("ReserveCoupon")),
+		connect.WithHandlerOptions(opts...),
+	)
+	couponServiceConfirmRedemptionHandler := connect.NewUnaryHandler(
+		CouponServiceConfirmRedemptionProcedure,
+		svc.ConfirmRedemption,
+		connect.WithSchema(couponServiceMethods.ByName("ConfirmRedemption")),
+		connect.WithHandlerOptions(opts...),
+	)
+	couponServiceReleaseReservationHandler := connect.NewUnaryHandler(
+		CouponServiceReleaseReservationProcedure,
+		svc.ReleaseReservation,
+		connect.WithSchema(couponServiceMethods.ByName("ReleaseReservation")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/v1.CouponService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case CouponServiceIssueCouponProcedure:
 			couponServiceIssueCouponHandler.ServeHTTP(w, r)
+		case CouponServiceEvaluateEligibilityProcedure:
+			couponServiceEvaluateEligibilityHandler.ServeHTTP(w, r)
+		case CouponServiceGetWaitlistPositionProcedure:
+			couponServiceGetWaitlistPositionHandler.ServeHTTP(w, r)
+		case CouponServiceLeaveWaitlistProcedure:
+			couponServiceLeaveWaitlistHandler.ServeHTTP(w, r)
+		case CouponServiceRedeemCouponProcedure:
+			couponServiceRedeemCouponHandler.ServeHTTP(w, r)
+		case CouponServiceQuoteDiscountProcedure:
+			couponServiceQuoteDiscountHandler.ServeHTTP(w, r)
+		case CouponServiceRevokeCouponProcedure:
+			couponServiceRevokeCouponHandler.ServeHTTP(w, r)
+		case CouponServiceUnredeemCouponProcedure:
+			couponServiceUnredeemCouponHandler.ServeHTTP(w, r)
+		case CouponServiceReserveCouponProcedure:
+			couponServiceReserveCouponHandler.ServeHTTP(w, r)
+		case CouponServiceConfirmRedemptionProcedure:
+			couponServiceConfirmRedemptionHandler.ServeHTTP(w, r)
+		case CouponServiceReleaseReservationProcedure:
+			couponServiceReleaseReservationHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -107,3 +357,43 @@ type UnimplementedCouponServiceHandler struct{}
 func (UnimplementedCouponServiceHandler) IssueCoupon(context.Context, *connect.Request[v1.IssueCouponReq]) (*connect.Response[v1.IssueCouponRes], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.CouponService.IssueCoupon is not implemented"))
 }
+
+func (UnimplementedCouponServiceHandler) EvaluateEligibility(context.Context, *connect.Request[v1.EvaluateEligibilityReq]) (*connect.Response[v1.EvaluateEligibilityRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.CouponService.EvaluateEligibility is not implemented"))
+}
+
+func (UnimplementedCouponServiceHandler) GetWaitlistPosition(context.Context, *connect.Request[v1.GetWaitlistPositionReq]) (*connect.Response[v1.GetWaitlistPositionRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.CouponService.GetWaitlistPosition is not implemented"))
+}
+
+func (UnimplementedCouponServiceHandler) LeaveWaitlist(context.Context, *connect.Request[v1.LeaveWaitlistReq]) (*connect.Response[v1.LeaveWaitlistRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.CouponService.LeaveWaitlist is not implemented"))
+}
+
+func (UnimplementedCouponServiceHandler) RedeemCoupon(context.Context, *connect.Request[v1.RedeemCouponReq]) (*connect.Response[v1.RedeemCouponRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.CouponService.RedeemCoupon is not implemented"))
+}
+
+func (UnimplementedCouponServiceHandler) QuoteDiscount(context.Context, *connect.Request[v1.QuoteDiscountReq]) (*connect.Response[v1.QuoteDiscountRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.CouponService.QuoteDiscount is not implemented"))
+}
+
+func (UnimplementedCouponServiceHandler) RevokeCoupon(context.Context, *connect.Request[v1.RevokeCouponReq]) (*connect.Response[v1.RevokeCouponRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.CouponService.RevokeCoupon is not implemented"))
+}
+
+func (UnimplementedCouponServiceHandler) UnredeemCoupon(context.Context, *connect.Request[v1.UnredeemCouponReq]) (*connect.Response[v1.UnredeemCouponRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.CouponService.UnredeemCoupon is not implemented"))
+}
+
+func (UnimplementedCouponServiceHandler) ReserveCoupon(context.Context, *connect.Request[v1.ReserveCouponReq]) (*connect.Response[v1.ReserveCouponRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.CouponService.ReserveCoupon is not implemented"))
+}
+
+func (UnimplementedCouponServiceHandler) ConfirmRedemption(context.Context, *connect.Request[v1.ConfirmRedemptionReq]) (*connect.Response[v1.ConfirmRedemptionRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.CouponService.ConfirmRedemption is not implemented"))
+}
+
+func (UnimplementedCouponServiceHandler) ReleaseReservation(context.Context, *connect.Request[v1.ReleaseReservationReq]) (*connect.Response[v1.ReleaseReservationRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.CouponService.ReleaseReservation is not implemented"))
+}
@@ -0,0 +1,166 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: v1/raffle.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// RaffleServiceName is the fully-qualified name of the RaffleService service.
+	RaffleServiceName = "v1.RaffleService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// RaffleServiceEnterDrawProcedure is the fully-qualified name of the RaffleService's EnterDraw RPC.
+	RaffleServiceEnterDrawProcedure = "/v1.RaffleService/EnterDraw"
+	// RaffleServiceGetDrawResultProcedure is the fully-qualified name of the RaffleService's
+	// GetDrawResult RPC.
+	RaffleServiceGetDrawResultProcedure = "/v1.RaffleService/GetDrawResult"
+	// RaffleServiceExportDrawProcedure is the fully-qualified name of the RaffleService's ExportDraw
+	// RPC.
+	RaffleServiceExportDrawProcedure = "/v1.RaffleService/ExportDraw"
+)
+
+// RaffleServiceClient is a client for the v1.RaffleService service.
+type RaffleServiceClient interface {
+	EnterDraw(context.Context, *connect.Request[v1.EnterDrawReq]) (*connect.Response[v1.EnterDrawRes], error)
+	GetDrawResult(context.Context, *connect.Request[v1.GetDrawResultReq]) (*connect.Response[v1.GetDrawResultRes], error)
+	ExportDraw(context.Context, *connect.Request[v1.ExportDrawReq]) (*connect.Response[v1.ExportDrawRes], error)
+}
+
+// NewRaffleServiceClient constructs a client for the v1.RaffleService service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRaffleServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RaffleServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	raffleServiceMethods := v1.File_v1_raffle_proto.Services().ByName("RaffleService").Methods()
+	return &raffleServiceClient{
+		enterDraw: connect.NewClient[v1.EnterDrawReq, v1.EnterDrawRes](
+			httpClient,
+			baseURL+RaffleServiceEnterDrawProcedure,
+			connect.WithSchema(raffleServiceMethods.ByName("EnterDraw")),
+			connect.WithClientOptions(opts...),
+		),
+		getDrawResult: connect.NewClient[v1.GetDrawResultReq, v1.GetDrawResultRes](
+			httpClient,
+			baseURL+RaffleServiceGetDrawResultProcedure,
+			connect.WithSchema(raffleServiceMethods.ByName("GetDrawResult")),
+			connect.WithClientOptions(opts...),
+		),
+		exportDraw: connect.NewClient[v1.ExportDrawReq, v1.ExportDrawRes](
+			httpClient,
+			baseURL+RaffleServiceExportDrawProcedure,
+			connect.WithSchema(raffleServiceMethods.ByName("ExportDraw")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// raffleServiceClient implements RaffleServiceClient.
+type raffleServiceClient struct {
+	enterDraw     *connect.Client[v1.EnterDrawReq, v1.EnterDrawRes]
+	getDrawResult *connect.Client[v1.GetDrawResultReq, v1.GetDrawResultRes]
+	exportDraw    *connect.Client[v1.ExportDrawReq, v1.ExportDrawRes]
+}
+
+// EnterDraw calls v1.RaffleService.EnterDraw.
+func (c *raffleServiceClient) EnterDraw(ctx context.Context, req *connect.Request[v1.EnterDrawReq]) (*connect.Response[v1.EnterDrawRes], error) {
+	return c.enterDraw.CallUnary(ctx, req)
+}
+
+// GetDrawResult calls v1.RaffleService.GetDrawResult.
+func (c *raffleServiceClient) GetDrawResult(ctx context.Context, req *connect.Request[v1.GetDrawResultReq]) (*connect.Response[v1.GetDrawResultRes], error) {
+	return c.getDrawResult.CallUnary(ctx, req)
+}
+
+// ExportDraw calls v1.RaffleService.ExportDraw.
+func (c *raffleServiceClient) ExportDraw(ctx context.Context, req *connect.Request[v1.ExportDrawReq]) (*connect.Response[v1.ExportDrawRes], error) {
+	return c.exportDraw.CallUnary(ctx, req)
+}
+
+// RaffleServiceHandler is an implementation of the v1.RaffleService service.
+type RaffleServiceHandler interface {
+	EnterDraw(context.Context, *connect.Request[v1.EnterDrawReq]) (*connect.Response[v1.EnterDrawRes], error)
+	GetDrawResult(context.Context, *connect.Request[v1.GetDrawResultReq]) (*connect.Response[v1.GetDrawResultRes], error)
+	ExportDraw(context.Context, *connect.Request[v1.ExportDrawReq]) (*connect.Response[v1.ExportDrawRes], error)
+}
+
+// NewRaffleServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRaffleServiceHandler(svc RaffleServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	raffleServiceMethods := v1.File_v1_raffle_proto.Services().ByName("RaffleService").Methods()
+	raffleServiceEnterDrawHandler := connect.NewUnaryHandler(
+		RaffleServiceEnterDrawProcedure,
+		svc.EnterDraw,
+		connect.WithSchema(raffleServiceMethods.ByName("EnterDraw")),
+		connect.WithHandlerOptions(opts...),
+	)
+	raffleServiceGetDrawResultHandler := connect.NewUnaryHandler(
+		RaffleServiceGetDrawResultProcedure,
+		svc.GetDrawResult,
+		connect.WithSchema(raffleServiceMethods.ByName("GetDrawResult")),
+		connect.WithHandlerOptions(opts...),
+	)
+	raffleServiceExportDrawHandler := connect.NewUnaryHandler(
+		RaffleServiceExportDrawProcedure,
+		svc.ExportDraw,
+		connect.WithSchema(raffleServiceMethods.ByName("ExportDraw")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/v1.RaffleService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RaffleServiceEnterDrawProcedure:
+			raffleServiceEnterDrawHandler.ServeHTTP(w, r)
+		case RaffleServiceGetDrawResultProcedure:
+			raffleServiceGetDrawResultHandler.ServeHTTP(w, r)
+		case RaffleServiceExportDrawProcedure:
+			raffleServiceExportDrawHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRaffleServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRaffleServiceHandler struct{}
+
+func (UnimplementedRaffleServiceHandler) EnterDraw(context.Context, *connect.Request[v1.EnterDrawReq]) (*connect.Response[v1.EnterDrawRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.RaffleService.EnterDraw is not implemented"))
+}
+
+func (UnimplementedRaffleServiceHandler) GetDrawResult(context.Context, *connect.Request[v1.GetDrawResultReq]) (*connect.Response[v1.GetDrawResultRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.RaffleService.GetDrawResult is not implemented"))
+}
+
+func (UnimplementedRaffleServiceHandler) ExportDraw(context.Context, *connect.Request[v1.ExportDrawReq]) (*connect.Response[v1.ExportDrawRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.RaffleService.ExportDraw is not implemented"))
+}
@@ -0,0 +1,225 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: v1/webhook.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// WebhookServiceName is the fully-qualified name of the WebhookService service.
+	WebhookServiceName = "v1.WebhookService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WebhookServiceRegisterWebhookProcedure is the fully-qualified name of the WebhookService's
+	// RegisterWebhook RPC.
+	WebhookServiceRegisterWebhookProcedure = "/v1.WebhookService/RegisterWebhook"
+	// WebhookServiceListWebhooksProcedure is the fully-qualified name of the WebhookService's
+	// ListWebhooks RPC.
+	WebhookServiceListWebhooksProcedure = "/v1.WebhookService/ListWebhooks"
+	// WebhookServiceDeleteWebhookProcedure is the fully-qualified name of the WebhookService's
+	// DeleteWebhook RPC.
+	WebhookServiceDeleteWebhookProcedure = "/v1.WebhookService/DeleteWebhook"
+	// WebhookServiceListDeadLettersProcedure is the fully-qualified name of the WebhookService's
+	// ListDeadLetters RPC.
+	WebhookServiceListDeadLettersProcedure = "/v1.WebhookService/ListDeadLetters"
+	// WebhookServiceReplayDeadLettersProcedure is the fully-qualified name of the WebhookService's
+	// ReplayDeadLetters RPC.
+	WebhookServiceReplayDeadLettersProcedure = "/v1.WebhookService/ReplayDeadLetters"
+)
+
+// WebhookServiceClient is a client for the v1.WebhookService service.
+type WebhookServiceClient interface {
+	RegisterWebhook(context.Context, *connect.Request[v1.RegisterWebhookReq]) (*connect.Response[v1.RegisterWebhookRes], error)
+	ListWebhooks(context.Context, *connect.Request[v1.ListWebhooksReq]) (*connect.Response[v1.ListWebhooksRes], error)
+	DeleteWebhook(context.Context, *connect.Request[v1.DeleteWebhookReq]) (*connect.Response[v1.DeleteWebhookRes], error)
+	ListDeadLetters(context.Context, *connect.Request[v1.ListDeadLettersReq]) (*connect.Response[v1.ListDeadLettersRes], error)
+	ReplayDeadLetters(context.Context, *connect.Request[v1.ReplayDeadLettersReq]) (*connect.Response[v1.ReplayDeadLettersRes], error)
+}
+
+// NewWebhookServiceClient constructs a client for the v1.WebhookService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWebhookServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) WebhookServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	webhookServiceMethods := v1.File_v1_webhook_proto.Services().ByName("WebhookService").Methods()
+	return &webhookServiceClient{
+		registerWebhook: connect.NewClient[v1.RegisterWebhookReq, v1.RegisterWebhookRes](
+			httpClient,
+			baseURL+WebhookServiceRegisterWebhookProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("RegisterWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhooks: connect.NewClient[v1.ListWebhooksReq, v1.ListWebhooksRes](
+			httpClient,
+			baseURL+WebhookServiceListWebhooksProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("ListWebhooks")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebhook: connect.NewClient[v1.DeleteWebhookReq, v1.DeleteWebhookRes](
+			httpClient,
+			baseURL+WebhookServiceDeleteWebhookProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("DeleteWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		listDeadLetters: connect.NewClient[v1.ListDeadLettersReq, v1.ListDeadLettersRes](
+			httpClient,
+			baseURL+WebhookServiceListDeadLettersProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("ListDeadLetters")),
+			connect.WithClientOptions(opts...),
+		),
+		replayDeadLetters: connect.NewClient[v1.ReplayDeadLettersReq, v1.ReplayDeadLettersRes](
+			httpClient,
+			baseURL+WebhookServiceReplayDeadLettersProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("ReplayDeadLetters")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// webhookServiceClient implements WebhookServiceClient.
+type webhookServiceClient struct {
+	registerWebhook   *connect.Client[v1.RegisterWebhookReq, v1.RegisterWebhookRes]
+	listWebhooks      *connect.Client[v1.ListWebhooksReq, v1.ListWebhooksRes]
+	deleteWebhook     *connect.Client[v1.DeleteWebhookReq, v1.DeleteWebhookRes]
+	listDeadLetters   *connect.Client[v1.ListDeadLettersReq, v1.ListDeadLettersRes]
+	replayDeadLetters *connect.Client[v1.ReplayDeadLettersReq, v1.ReplayDeadLettersRes]
+}
+
+// RegisterWebhook calls v1.WebhookService.RegisterWebhook.
+func (c *webhookServiceClient) RegisterWebhook(ctx context.Context, req *connect.Request[v1.RegisterWebhookReq]) (*connect.Response[v1.RegisterWebhookRes], error) {
+	return c.registerWebhook.CallUnary(ctx, req)
+}
+
+// ListWebhooks calls v1.WebhookService.ListWebhooks.
+func (c *webhookServiceClient) ListWebhooks(ctx context.Context, req *connect.Request[v1.ListWebhooksReq]) (*connect.Response[v1.ListWebhooksRes], error) {
+	return c.listWebhooks.CallUnary(ctx, req)
+}
+
+// DeleteWebhook calls v1.WebhookService.DeleteWebhook.
+func (c *webhookServiceClient) DeleteWebhook(ctx context.Context, req *connect.Request[v1.DeleteWebhookReq]) (*connect.Response[v1.DeleteWebhookRes], error) {
+	return c.deleteWebhook.CallUnary(ctx, req)
+}
+
+// ListDeadLetters calls v1.WebhookService.ListDeadLetters.
+func (c *webhookServiceClient) ListDeadLetters(ctx context.Context, req *connect.Request[v1.ListDeadLettersReq]) (*connect.Response[v1.ListDeadLettersRes], error) {
+	return c.listDeadLetters.CallUnary(ctx, req)
+}
+
+// ReplayDeadLetters calls v1.WebhookService.ReplayDeadLetters.
+func (c *webhookServiceClient) ReplayDeadLetters(ctx context.Context, req *connect.Request[v1.ReplayDeadLettersReq]) (*connect.Response[v1.ReplayDeadLettersRes], error) {
+	return c.replayDeadLetters.CallUnary(ctx, req)
+}
+
+// WebhookServiceHandler is an implementation of the v1.WebhookService service.
+type WebhookServiceHandler interface {
+	RegisterWebhook(context.Context, *connect.Request[v1.RegisterWebhookReq]) (*connect.Response[v1.RegisterWebhookRes], error)
+	ListWebhooks(context.Context, *connect.Request[v1.ListWebhooksReq]) (*connect.Response[v1.ListWebhooksRes], error)
+	DeleteWebhook(context.Context, *connect.Request[v1.DeleteWebhookReq]) (*connect.Response[v1.DeleteWebhookRes], error)
+	ListDeadLetters(context.Context, *connect.Request[v1.ListDeadLettersReq]) (*connect.Response[v1.ListDeadLettersRes], error)
+	ReplayDeadLetters(context.Context, *connect.Request[v1.ReplayDeadLettersReq]) (*connect.Response[v1.ReplayDeadLettersRes], error)
+}
+
+// NewWebhookServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWebhookServiceHandler(svc WebhookServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	webhookServiceMethods := v1.File_v1_webhook_proto.Services().ByName("WebhookService").Methods()
+	webhookServiceRegisterWebhookHandler := connect.NewUnaryHandler(
+		WebhookServiceRegisterWebhookProcedure,
+		svc.RegisterWebhook,
+		connect.WithSchema(webhookServiceMethods.ByName("RegisterWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceListWebhooksHandler := connect.NewUnaryHandler(
+		WebhookServiceListWebhooksProcedure,
+		svc.ListWebhooks,
+		connect.WithSchema(webhookServiceMethods.ByName("ListWebhooks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceDeleteWebhookHandler := connect.NewUnaryHandler(
+		WebhookServiceDeleteWebhookProcedure,
+		svc.DeleteWebhook,
+		connect.WithSchema(webhookServiceMethods.ByName("DeleteWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceListDeadLettersHandler := connect.NewUnaryHandler(
+		WebhookServiceListDeadLettersProcedure,
+		svc.ListDeadLetters,
+		connect.WithSchema(webhookServiceMethods.ByName("ListDeadLetters")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceReplayDeadLettersHandler := connect.NewUnaryHandler(
+		WebhookServiceReplayDeadLettersProcedure,
+		svc.ReplayDeadLetters,
+		connect.WithSchema(webhookServiceMethods.ByName("ReplayDeadLetters")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/v1.WebhookService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WebhookServiceRegisterWebhookProcedure:
+			webhookServiceRegisterWebhookHandler.ServeHTTP(w, r)
+		case WebhookServiceListWebhooksProcedure:
+			webhookServiceListWebhooksHandler.ServeHTTP(w, r)
+		case WebhookServiceDeleteWebhookProcedure:
+			webhookServiceDeleteWebhookHandler.ServeHTTP(w, r)
+		case WebhookServiceListDeadLettersProcedure:
+			webhookServiceListDeadLettersHandler.ServeHTTP(w, r)
+		case WebhookServiceReplayDeadLettersProcedure:
+			webhookServiceReplayDeadLettersHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWebhookServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWebhookServiceHandler struct{}
+
+func (UnimplementedWebhookServiceHandler) RegisterWebhook(context.Context, *connect.Request[v1.RegisterWebhookReq]) (*connect.Response[v1.RegisterWebhookRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.WebhookService.RegisterWebhook is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) ListWebhooks(context.Context, *connect.Request[v1.ListWebhooksReq]) (*connect.Response[v1.ListWebhooksRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.WebhookService.ListWebhooks is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) DeleteWebhook(context.Context, *connect.Request[v1.DeleteWebhookReq]) (*connect.Response[v1.DeleteWebhookRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.WebhookService.DeleteWebhook is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) ListDeadLetters(context.Context, *connect.Request[v1.ListDeadLettersReq]) (*connect.Response[v1.ListDeadLettersRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.WebhookService.ListDeadLetters is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) ReplayDeadLetters(context.Context, *connect.Request[v1.ReplayDeadLettersReq]) (*connect.Response[v1.ReplayDeadLettersRes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.WebhookService.ReplayDeadLetters is not implemented"))
+}
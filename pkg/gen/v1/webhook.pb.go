@@ -0,0 +1,821 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: v1/webhook.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 쿠폰 이벤트를 받을 endpoint
+// 요청 body 는 이벤트 JSON, X-Coupon-Signature 헤더는 t=<unix>,v1=<hex HMAC-SHA256(secret, "<t>.<body>")>
+type WebhookEndpoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Events        []string               `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`       // 비어있으면 전체 : coupon.issued, coupon.redeemed, coupon.revoked, coupon.unredeemed, campaign.exhausted, waitlist.fulfilled
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"` // RFC3339
+	Secret        string                 `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`       // RegisterWebhook 응답에서만 채워짐
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookEndpoint) Reset() {
+	*x = WebhookEndpoint{}
+	mi := &file_v1_webhook_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEndpoint) ProtoMessage() {}
+
+func (x *WebhookEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_webhook_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEndpoint.ProtoReflect.Descriptor instead.
+func (*WebhookEndpoint) Descriptor() ([]byte, []int) {
+	return file_v1_webhook_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WebhookEndpoint) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookEndpoint) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookEndpoint) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *WebhookEndpoint) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *WebhookEndpoint) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// ========================================
+type RegisterWebhookReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Events        []string               `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	Secret        string                 `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"` // 비어있으면 서버에서 만듦
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterWebhookReq) Reset() {
+	*x = RegisterWebhookReq{}
+	mi := &file_v1_webhook_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterWebhookReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterWebhookReq) ProtoMessage() {}
+
+func (x *RegisterWebhookReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_webhook_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterWebhookReq.ProtoReflect.Descriptor instead.
+func (*RegisterWebhookReq) Descriptor() ([]byte, []int) {
+	return file_v1_webhook_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RegisterWebhookReq) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *RegisterWebhookReq) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *RegisterWebhookReq) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type RegisterWebhookRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Endpoint      *WebhookEndpoint       `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterWebhookRes) Reset() {
+	*x = RegisterWebhookRes{}
+	mi := &file_v1_webhook_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterWebhookRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterWebhookRes) ProtoMessage() {}
+
+func (x *RegisterWebhookRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_webhook_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterWebhookRes.ProtoReflect.Descriptor instead.
+func (*RegisterWebhookRes) Descriptor() ([]byte, []int) {
+	return file_v1_webhook_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RegisterWebhookRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *RegisterWebhookRes) GetEndpoint() *WebhookEndpoint {
+	if x != nil {
+		return x.Endpoint
+	}
+	return nil
+}
+
+type ListWebhooksReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksReq) Reset() {
+	*x = ListWebhooksReq{}
+	mi := &file_v1_webhook_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksReq) ProtoMessage() {}
+
+func (x *ListWebhooksReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_webhook_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksReq.ProtoReflect.Descriptor instead.
+func (*ListWebhooksReq) Descriptor() ([]byte, []int) {
+	return file_v1_webhook_proto_rawDescGZIP(), []int{3}
+}
+
+type ListWebhooksRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Endpoints     []*WebhookEndpoint     `protobuf:"bytes,2,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRes) Reset() {
+	*x = ListWebhooksRes{}
+	mi := &file_v1_webhook_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRes) ProtoMessage() {}
+
+func (x *ListWebhooksRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_webhook_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRes.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRes) Descriptor() ([]byte, []int) {
+	return file_v1_webhook_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListWebhooksRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ListWebhooksRes) GetEndpoints() []*WebhookEndpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+type DeleteWebhookReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookReq) Reset() {
+	*x = DeleteWebhookReq{}
+	mi := &file_v1_webhook_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookReq) ProtoMessage() {}
+
+func (x *DeleteWebhookReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_webhook_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookReq.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookReq) Descriptor() ([]byte, []int) {
+	return file_v1_webhook_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteWebhookReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteWebhookRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRes) Reset() {
+	*x = DeleteWebhookRes{}
+	mi := &file_v1_webhook_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRes) ProtoMessage() {}
+
+func (x *DeleteWebhookRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_webhook_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRes.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRes) Descriptor() ([]byte, []int) {
+	return file_v1_webhook_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteWebhookRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// ========================================
+// 재시도를 모두 실패한 전송
+type DeadLetter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EndpointId    string                 `protobuf:"bytes,2,opt,name=endpointId,proto3" json:"endpointId,omitempty"`
+	EventId       string                 `protobuf:"bytes,3,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	EventType     string                 `protobuf:"bytes,4,opt,name=eventType,proto3" json:"eventType,omitempty"`
+	CampaignId    string                 `protobuf:"bytes,5,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	CouponCode    string                 `protobuf:"bytes,6,opt,name=couponCode,proto3" json:"couponCode,omitempty"`
+	Attempts      int32                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string                 `protobuf:"bytes,8,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	DeadAt        string                 `protobuf:"bytes,9,opt,name=deadAt,proto3" json:"deadAt,omitempty"` // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
+	mi := &file_v1_webhook_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetter) ProtoMessage() {}
+
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_webhook_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return file_v1_webhook_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeadLetter) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetDeadAt() string {
+	if x != nil {
+		return x.DeadAt
+	}
+	return ""
+}
+
+type ListDeadLettersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLettersReq) Reset() {
+	*x = ListDeadLettersReq{}
+	mi := &file_v1_webhook_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLettersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersReq) ProtoMessage() {}
+
+func (x *ListDeadLettersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_webhook_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersReq.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersReq) Descriptor() ([]byte, []int) {
+	return file_v1_webhook_proto_rawDescGZIP(), []int{8}
+}
+
+type ListDeadLettersRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	DeadLetters   []*DeadLetter          `protobuf:"bytes,2,rep,name=deadLetters,proto3" json:"deadLetters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeadLettersRes) Reset() {
+	*x = ListDeadLettersRes{}
+	mi := &file_v1_webhook_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeadLettersRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersRes) ProtoMessage() {}
+
+func (x *ListDeadLettersRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_webhook_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersRes.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersRes) Descriptor() ([]byte, []int) {
+	return file_v1_webhook_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListDeadLettersRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ListDeadLettersRes) GetDeadLetters() []*DeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+type ReplayDeadLettersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"` // 비어있으면 호출한 tenant 의 전체
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLettersReq) Reset() {
+	*x = ReplayDeadLettersReq{}
+	mi := &file_v1_webhook_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLettersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersReq) ProtoMessage() {}
+
+func (x *ReplayDeadLettersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_webhook_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersReq.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersReq) Descriptor() ([]byte, []int) {
+	return file_v1_webhook_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReplayDeadLettersReq) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ReplayDeadLettersRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *BaseResponse          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Replayed      int32                  `protobuf:"varint,2,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLettersRes) Reset() {
+	*x = ReplayDeadLettersRes{}
+	mi := &file_v1_webhook_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLettersRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersRes) ProtoMessage() {}
+
+func (x *ReplayDeadLettersRes) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_webhook_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersRes.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersRes) Descriptor() ([]byte, []int) {
+	return file_v1_webhook_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReplayDeadLettersRes) GetResult() *BaseResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ReplayDeadLettersRes) GetReplayed() int32 {
+	if x != nil {
+		return x.Replayed
+	}
+	return 0
+}
+
+var File_v1_webhook_proto protoreflect.FileDescriptor
+
+const file_v1_webhook_proto_rawDesc = "" +
+	"\n" +
+	"\x10v1/webhook.proto\x12\x02v1\x1a\x0fv1/common.proto\"\x81\x01\n" +
+	"\x0fWebhookEndpoint\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06events\x18\x03 \x03(\tR\x06events\x12\x1c\n" +
+	"\tcreatedAt\x18\x04 \x01(\tR\tcreatedAt\x12\x16\n" +
+	"\x06secret\x18\x05 \x01(\tR\x06secret\"V\n" +
+	"\x12RegisterWebhookReq\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06events\x18\x02 \x03(\tR\x06events\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\"o\n" +
+	"\x12RegisterWebhookRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\x12/\n" +
+	"\bendpoint\x18\x02 \x01(\v2\x13.v1.WebhookEndpointR\bendpoint\"\x11\n" +
+	"\x0fListWebhooksReq\"n\n" +
+	"\x0fListWebhooksRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\x121\n" +
+	"\tendpoints\x18\x02 \x03(\v2\x13.v1.WebhookEndpointR\tendpoints\"\"\n" +
+	"\x10DeleteWebhookReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"<\n" +
+	"\x10DeleteWebhookRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\"\x86\x02\n" +
+	"\n" +
+	"DeadLetter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1e\n" +
+	"\n" +
+	"endpointId\x18\x02 \x01(\tR\n" +
+	"endpointId\x12\x18\n" +
+	"\aeventId\x18\x03 \x01(\tR\aeventId\x12\x1c\n" +
+	"\teventType\x18\x04 \x01(\tR\teventType\x12\x1e\n" +
+	"\n" +
+	"campaignId\x18\x05 \x01(\tR\n" +
+	"campaignId\x12\x1e\n" +
+	"\n" +
+	"couponCode\x18\x06 \x01(\tR\n" +
+	"couponCode\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12\x1c\n" +
+	"\tlastError\x18\b \x01(\tR\tlastError\x12\x16\n" +
+	"\x06deadAt\x18\t \x01(\tR\x06deadAt\"\x14\n" +
+	"\x12ListDeadLettersReq\"p\n" +
+	"\x12ListDeadLettersRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\x120\n" +
+	"\vdeadLetters\x18\x02 \x03(\v2\x0e.v1.DeadLetterR\vdeadLetters\"(\n" +
+	"\x14ReplayDeadLettersReq\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\"\\\n" +
+	"\x14ReplayDeadLettersRes\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.v1.BaseResponseR\x06result\x12\x1a\n" +
+	"\breplayed\x18\x02 \x01(\x05R\breplayed2\xe0\x02\n" +
+	"\x0eWebhookService\x12C\n" +
+	"\x0fRegisterWebhook\x12\x16.v1.RegisterWebhookReq\x1a\x16.v1.RegisterWebhookRes\"\x00\x12:\n" +
+	"\fListWebhooks\x12\x13.v1.ListWebhooksReq\x1a\x13.v1.ListWebhooksRes\"\x00\x12=\n" +
+	"\rDeleteWebhook\x12\x14.v1.DeleteWebhookReq\x1a\x14.v1.DeleteWebhookRes\"\x00\x12C\n" +
+	"\x0fListDeadLetters\x12\x16.v1.ListDeadLettersReq\x1a\x16.v1.ListDeadLettersRes\"\x00\x12I\n" +
+	"\x11ReplayDeadLetters\x12\x18.v1.ReplayDeadLettersReq\x1a\x18.v1.ReplayDeadLettersRes\"\x00B8Z6github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1;v1b\x06proto3"
+
+var (
+	file_v1_webhook_proto_rawDescOnce sync.Once
+	file_v1_webhook_proto_rawDescData []byte
+)
+
+func file_v1_webhook_proto_rawDescGZIP() []byte {
+	file_v1_webhook_proto_rawDescOnce.Do(func() {
+		file_v1_webhook_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_webhook_proto_rawDesc), len(file_v1_webhook_proto_rawDesc)))
+	})
+	return file_v1_webhook_proto_rawDescData
+}
+
+var file_v1_webhook_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_v1_webhook_proto_goTypes = []any{
+	(*WebhookEndpoint)(nil),      // 0: v1.WebhookEndpoint
+	(*RegisterWebhookReq)(nil),   // 1: v1.RegisterWebhookReq
+	(*RegisterWebhookRes)(nil),   // 2: v1.RegisterWebhookRes
+	(*ListWebhooksReq)(nil),      // 3: v1.ListWebhooksReq
+	(*ListWebhooksRes)(nil),      // 4: v1.ListWebhooksRes
+	(*DeleteWebhookReq)(nil),     // 5: v1.DeleteWebhookReq
+	(*DeleteWebhookRes)(nil),     // 6: v1.DeleteWebhookRes
+	(*DeadLetter)(nil),           // 7: v1.DeadLetter
+	(*ListDeadLettersReq)(nil),   // 8: v1.ListDeadLettersReq
+	(*ListDeadLettersRes)(nil),   // 9: v1.ListDeadLettersRes
+	(*ReplayDeadLettersReq)(nil), // 10: v1.ReplayDeadLettersReq
+	(*ReplayDeadLettersRes)(nil), // 11: v1.ReplayDeadLettersRes
+	(*BaseResponse)(nil),         // 12: v1.BaseResponse
+}
+var file_v1_webhook_proto_depIdxs = []int32{
+	12, // 0: v1.RegisterWebhookRes.result:type_name -> v1.BaseResponse
+	0,  // 1: v1.RegisterWebhookRes.endpoint:type_name -> v1.WebhookEndpoint
+	12, // 2: v1.ListWebhooksRes.result:type_name -> v1.BaseResponse
+	0,  // 3: v1.ListWebhooksRes.endpoints:type_name -> v1.WebhookEndpoint
+	12, // 4: v1.DeleteWebhookRes.result:type_name -> v1.BaseResponse
+	12, // 5: v1.ListDeadLettersRes.result:type_name -> v1.BaseResponse
+	7,  // 6: v1.ListDeadLettersRes.deadLetters:type_name -> v1.DeadLetter
+	12, // 7: v1.ReplayDeadLettersRes.result:type_name -> v1.BaseResponse
+	1,  // 8: v1.WebhookService.RegisterWebhook:input_type -> v1.RegisterWebhookReq
+	3,  // 9: v1.WebhookService.ListWebhooks:input_type -> v1.ListWebhooksReq
+	5,  // 10: v1.WebhookService.DeleteWebhook:input_type -> v1.DeleteWebhookReq
+	8,  // 11: v1.WebhookService.ListDeadLetters:input_type -> v1.ListDeadLettersReq
+	10, // 12: v1.WebhookService.ReplayDeadLetters:input_type -> v1.ReplayDeadLettersReq
+	2,  // 13: v1.WebhookService.RegisterWebhook:output_type -> v1.RegisterWebhookRes
+	4,  // 14: v1.WebhookService.ListWebhooks:output_type -> v1.ListWebhooksRes
+	6,  // 15: v1.WebhookService.DeleteWebhook:output_type -> v1.DeleteWebhookRes
+	9,  // 16: v1.WebhookService.ListDeadLetters:output_type -> v1.ListDeadLettersRes
+	11, // 17: v1.WebhookService.ReplayDeadLetters:output_type -> v1.ReplayDeadLettersRes
+	13, // [13:18] is the sub-list for method output_type
+	8,  // [8:13] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_v1_webhook_proto_init() }
+func file_v1_webhook_proto_init() {
+	if File_v1_webhook_proto != nil {
+		return
+	}
+	file_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_webhook_proto_rawDesc), len(file_v1_webhook_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_webhook_proto_goTypes,
+		DependencyIndexes: file_v1_webhook_proto_depIdxs,
+		MessageInfos:      file_v1_webhook_proto_msgTypes,
+	}.Build()
+	File_v1_webhook_proto = out.File
+	file_v1_webhook_proto_goTypes = nil
+	file_v1_webhook_proto_depIdxs = nil
+}
@@ -0,0 +1,221 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"connectrpc.com/connect"
+	healthv1 "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// grpc.health.v1 메시지는 grpc 모듈의 생성 코드를 그대로 사용함
+// 같은 proto 를 따로 생성하면 grpc 를 import 하는 다른 라이브러리(OTLP exporter 등)와 등록 이름이 충돌함
+const (
+	HealthCheckProcedure = "/grpc.health.v1.Health/Check"
+	HealthWatchProcedure = "/grpc.health.v1.Health/Watch"
+)
+
+// Checker : storage 복구가 끝나기 전이나 종료 중에는 ready 가 false
+// grpc.health.v1 의 서비스별 상태는 모두 ready 여부를 따라감
+type Checker struct {
+	services map[string]bool // 등록된 서비스 이름, "" 는 서버 전체
+	ready    bool
+	started  bool          // 한번이라도 ready 가 된 적 있는지 (storage 복구 완료)
+	changed  chan struct{} // 상태가 바뀌면 close 하고 새로 만듦 (Watch 용)
+	closed   chan struct{} // 서버 종료시 Watch stream 을 끝내기 위함
+
+	// h2c 연결은 hijack 되어서 http.Server.Shutdown 이 기다려주지 않음
+	// 처리중인 RPC 수를 직접 세고, 마지막 snapshot 전에 0 이 될때까지 기다림
+	inflight int
+	draining bool
+	idle     chan struct{} // draining 중 inflight 가 0 이 되면 close
+
+	mutex sync.RWMutex
+}
+
+func NewChecker(services ...string) *Checker {
+	c := &Checker{
+		services: map[string]bool{"": true},
+		changed:  make(chan struct{}),
+		closed:   make(chan struct{}),
+	}
+	for _, s := range services {
+		c.services[s] = true
+	}
+	return c
+}
+
+func (c *Checker) SetReady(ready bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.ready == ready {
+		return
+	}
+	c.ready = ready
+	c.started = c.started || ready
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+// Close : 열려있는 Watch stream 을 종료함, 서버 Shutdown 전에 호출
+func (c *Checker) Close() {
+	c.SetReady(false)
+	close(c.closed)
+}
+
+func (c *Checker) Ready() bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.ready
+}
+
+func (c *Checker) status(service string) (healthv1.HealthCheckResponse_ServingStatus, <-chan struct{}, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	if !c.services[service] {
+		return healthv1.HealthCheckResponse_SERVICE_UNKNOWN, c.changed, false
+	}
+	if !c.ready {
+		return healthv1.HealthCheckResponse_NOT_SERVING, c.changed, true
+	}
+	return healthv1.HealthCheckResponse_SERVING, c.changed, true
+}
+
+// Check implements grpc.health.v1.Health/Check
+func (c *Checker) Check(ctx context.Context, req *connect.Request[healthv1.HealthCheckRequest]) (*connect.Response[healthv1.HealthCheckResponse], error) {
+	status, _, known := c.status(req.Msg.Service)
+	if !known {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("unknown service %q", req.Msg.Service))
+	}
+	return connect.NewResponse(&healthv1.HealthCheckResponse{Status: status}), nil
+}
+
+// Watch implements grpc.health.v1.Health/Watch : 상태가 바뀔때마다 전송
+func (c *Checker) Watch(ctx context.Context, req *connect.Request[healthv1.HealthCheckRequest], stream *connect.ServerStream[healthv1.HealthCheckResponse]) error {
+	last := healthv1.HealthCheckResponse_ServingStatus(-1)
+
+	for {
+		status, changed, _ := c.status(req.Msg.Service)
+		if status != last {
+			if err := stream.Send(&healthv1.HealthCheckResponse{Status: status}); err != nil {
+				return err
+			}
+			last = status
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-c.closed:
+			return nil
+		case <-changed:
+		}
+	}
+}
+
+// NewHandler : 인증 interceptor 없이 등록함 (로드밸런서, k8s probe 용)
+func (c *Checker) NewHandler() (string, http.Handler) {
+	methods := healthv1.File_grpc_health_v1_health_proto.Services().ByName("Health").Methods()
+	check := connect.NewUnaryHandler(HealthCheckProcedure, c.Check, connect.WithSchema(methods.ByName("Check")))
+	watch := connect.NewServerStreamHandler(HealthWatchProcedure, c.Watch, connect.WithSchema(methods.ByName("Watch")))
+
+	return "/grpc.health.v1.Health/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case HealthCheckProcedure:
+			check.ServeHTTP(w, r)
+		case HealthWatchProcedure:
+			watch.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// Healthz : 프로세스가 살아서 요청을 받을 수 있으면 항상 200
+func (c *Checker) Healthz(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ok\n"))
+}
+
+// Readyz : storage 복구 전이나 종료 중이면 503
+func (c *Checker) Readyz(w http.ResponseWriter, r *http.Request) {
+	if !c.Ready() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("not ready\n"))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ready\n"))
+}
+
+var (
+	ErrNotReady     = errors.New("server is not ready")
+	ErrShuttingDown = errors.New("server is shutting down")
+)
+
+// RequireStarted : 복구가 끝나기 전에 들어온 RPC 는 빈 상태로 처리하지 않고 거절함
+// 종료 중(drain)에는 ready 가 false 여도 들어온 요청은 처리함, Drain 이 시작된 뒤에 들어온 요청은 거절함
+func (c *Checker) RequireStarted(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := c.enter(); err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		defer c.leave()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (c *Checker) enter() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if !c.started {
+		return ErrNotReady
+	}
+	if c.draining {
+		return ErrShuttingDown
+	}
+	c.inflight++
+	return nil
+}
+
+func (c *Checker) leave() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.inflight--
+	if c.draining && c.inflight == 0 && c.idle != nil {
+		close(c.idle)
+		c.idle = nil
+	}
+}
+
+// Drain : 새 RPC 를 막고 처리중인 RPC 가 모두 끝날때까지 기다림
+// 마지막 storage flush 전에 호출해야 종료 직전에 발급된 쿠폰이 snapshot 에 남음
+func (c *Checker) Drain(ctx context.Context) error {
+	c.mutex.Lock()
+	c.draining = true
+	if c.inflight == 0 {
+		c.mutex.Unlock()
+		return nil
+	}
+	if c.idle == nil {
+		c.idle = make(chan struct{})
+	}
+	idle := c.idle
+	c.mutex.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,122 @@
+package health
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/cache"
+)
+
+// 종료 signal 직전에 들어온 발급 요청도 마지막 snapshot 에 남아야 재시작 후 같은 쿠폰이 다시 발급되지 않음
+func TestDrainWaitsForInFlightIssue(t *testing.T) {
+	m := cache.NewCampaignManager()
+	now := time.Now()
+	if err := m.CreateCampaign(context.Background(), cache.DefaultTenant, "c1", now.Add(-time.Minute), now.Add(time.Hour), 1, cache.CampaignOptions{}); err != nil {
+		t.Fatalf("CreateCampaign: %v", err)
+	}
+
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	c := NewChecker()
+	c.SetReady(true)
+	server := httptest.NewServer(c.RequireStarted(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(entered)
+		<-release
+		if _, err := m.PublishCoupon(r.Context(), cache.DefaultTenant, "c1", "u1", cache.IssueOptions{}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})))
+	defer server.Close()
+
+	issued := make(chan int, 1)
+	go func() {
+		resp, err := http.Get(server.URL)
+		if err != nil {
+			issued <- 0
+			return
+		}
+		resp.Body.Close()
+		issued <- resp.StatusCode
+	}()
+	<-entered
+
+	drained := make(chan error, 1)
+	go func() { drained <- c.Drain(context.Background()) }()
+
+	// Drain 이 시작된 뒤 들어온 요청은 거절
+	deadline := time.Now().Add(time.Second)
+	for {
+		resp, err := http.Get(server.URL)
+		if err != nil {
+			t.Fatalf("GET: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode == http.StatusServiceUnavailable {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("request after Drain got %d, want 503", resp.StatusCode)
+		}
+	}
+
+	select {
+	case err := <-drained:
+		t.Fatalf("Drain returned %v before the in-flight RPC finished", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	if err := <-drained; err != nil {
+		t.Fatalf("Drain: %v", err)
+	}
+	if status := <-issued; status != http.StatusOK {
+		t.Fatalf("in-flight issue status = %d, want 200", status)
+	}
+
+	snapshot := m.Snapshot()
+	var owner string
+	for _, tenant := range snapshot.Tenants {
+		for _, campaign := range tenant.Campaigns {
+			for _, coupon := range campaign.Coupons {
+				if coupon.UserId != "" {
+					owner = coupon.UserId
+				}
+			}
+		}
+	}
+	if owner != "u1" {
+		t.Fatalf("final snapshot has no coupon issued to u1 (owner %q)", owner)
+	}
+}
+
+func TestDrainTimeout(t *testing.T) {
+	c := NewChecker()
+	c.SetReady(true)
+	if err := c.enter(); err != nil {
+		t.Fatalf("enter: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := c.Drain(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("Drain = %v, want deadline exceeded", err)
+	}
+	if err := c.enter(); err != ErrShuttingDown {
+		t.Fatalf("enter after Drain = %v, want ErrShuttingDown", err)
+	}
+	c.leave()
+}
+
+func TestRequireStartedBeforeRestore(t *testing.T) {
+	c := NewChecker()
+	rec := httptest.NewRecorder()
+	c.RequireStarted(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("handler called before storage restore")
+	})).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status = %d, want 503", rec.Code)
+	}
+}
@@ -0,0 +1,64 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+)
+
+// fields : 요청 하나에 붙는 로그 attribute
+// interceptor 가 만든 뒤 service 에서 userId 처럼 나중에 알게 된 값을 추가할 수 있게 pointer 로 ctx 에 넣음
+type fields struct {
+	values []slog.Attr
+	mutex  sync.Mutex
+}
+
+type fieldsKey struct{}
+
+func withFields(ctx context.Context, attrs ...slog.Attr) context.Context {
+	return context.WithValue(ctx, fieldsKey{}, &fields{values: attrs})
+}
+
+func fieldsFrom(ctx context.Context) *fields {
+	f, _ := ctx.Value(fieldsKey{}).(*fields)
+	return f
+}
+
+func (f *fields) attrs() []slog.Attr {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return append([]slog.Attr(nil), f.values...)
+}
+
+// Set : 같은 key 가 있으면 덮어씀, interceptor 를 거치지 않은 ctx 면 무시
+func Set(ctx context.Context, key string, value string) {
+	f := fieldsFrom(ctx)
+	if f == nil {
+		return
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for i, a := range f.values {
+		if a.Key == key {
+			f.values[i] = slog.String(key, value)
+			return
+		}
+	}
+	f.values = append(f.values, slog.String(key, value))
+}
+
+// Field : interceptor/service 에서 넣어둔 값 (requestId, rpc 등), 없으면 ""
+func Field(ctx context.Context, key string) string {
+	f := fieldsFrom(ctx)
+	if f == nil {
+		return ""
+	}
+	for _, a := range f.attrs() {
+		if a.Key == key {
+			return a.Value.String()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,126 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"regexp"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RequestIdHeader : client 가 보내면 그대로 쓰고, 없으면 만들어서 응답 헤더로 돌려줌
+const RequestIdHeader = "X-Request-Id"
+
+var validRequestId = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,64}$`)
+
+// 요청 메시지에서 로그에 붙일 값을 꺼내기 위한 getter (protoc 생성 코드에 있음)
+type campaignIdGetter interface{ GetCampaignId() string }
+type userIdGetter interface{ GetUserId() string }
+
+// Interceptor : 요청 ID, RPC 이름, campaignId, userId 를 ctx 에 넣고 RPC 가 끝나면 한줄 남김
+// 인증 실패 로그에도 요청 ID 가 남도록 인증 interceptor 보다 앞에 둠
+type Interceptor struct{}
+
+func NewInterceptor() *Interceptor {
+	return &Interceptor{}
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+
+		requestId := resolveRequestId(req.Header().Get(RequestIdHeader))
+		attrs := []slog.Attr{
+			slog.String("requestId", requestId),
+			slog.String("rpc", req.Spec().Procedure),
+		}
+		if m, ok := req.Any().(campaignIdGetter); ok && m.GetCampaignId() != "" {
+			attrs = append(attrs, slog.String("campaignId", m.GetCampaignId()))
+		}
+		if m, ok := req.Any().(userIdGetter); ok && m.GetUserId() != "" {
+			attrs = append(attrs, slog.String("userId", m.GetUserId()))
+		}
+		ctx = withFields(ctx, attrs...)
+		trace.SpanFromContext(ctx).SetAttributes(attribute.String("request.id", requestId))
+
+		start := time.Now()
+		res, err := next(ctx, req)
+		logResult(ctx, start, err)
+
+		if res != nil {
+			res.Header().Set(RequestIdHeader, requestId)
+		} else {
+			var connectErr *connect.Error
+			if errors.As(err, &connectErr) {
+				connectErr.Meta().Set(RequestIdHeader, requestId)
+			}
+		}
+		return res, err
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		requestId := resolveRequestId(conn.RequestHeader().Get(RequestIdHeader))
+		ctx = withFields(ctx, slog.String("requestId", requestId), slog.String("rpc", conn.Spec().Procedure))
+		conn.ResponseHeader().Set(RequestIdHeader, requestId)
+		trace.SpanFromContext(ctx).SetAttributes(attribute.String("request.id", requestId))
+
+		start := time.Now()
+		err := next(ctx, conn)
+		logResult(ctx, start, err)
+		return err
+	}
+}
+
+// logResult : 성공은 debug, 실패는 warn (서버 쪽 문제면 error) 로 남김
+// service 는 처리 실패를 BaseResponse(Success=false) 로 돌려주기 때문에 err 가 없어도 result 가 ok 가 아니면 실패로 봄
+func logResult(ctx context.Context, start time.Time, err error) {
+	elapsed := slog.Float64("elapsedMs", float64(time.Since(start).Microseconds())/1000)
+	if err != nil {
+		code := connect.CodeOf(err)
+		level := slog.LevelWarn
+		if serverFault(code) {
+			level = slog.LevelError
+		}
+		slog.Log(ctx, level, "rpc failed", elapsed, "code", code.String(), "error", err)
+		return
+	}
+
+	if result := Field(ctx, "result"); result != "" && result != "ok" {
+		slog.WarnContext(ctx, "rpc failed", elapsed, "code", result)
+		return
+	}
+	slog.DebugContext(ctx, "rpc finished", elapsed)
+}
+
+// serverFault : client 가 요청을 고쳐도 해결되지 않는 에러
+func serverFault(code connect.Code) bool {
+	switch code {
+	case connect.CodeUnknown, connect.CodeInternal, connect.CodeDataLoss, connect.CodeUnavailable, connect.CodeUnimplemented:
+		return true
+	}
+	return false
+}
+
+func resolveRequestId(header string) string {
+	if validRequestId.MatchString(header) {
+		return header
+	}
+
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,61 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+func TestLogResultLevel(t *testing.T) {
+	tests := []struct {
+		name   string
+		result string // service 가 logging.Set 으로 남긴 결과
+		err    error
+		level  string
+		code   string
+	}{
+		{name: "ok", result: "ok", level: "DEBUG"},
+		{name: "no result", level: "DEBUG"},
+		{name: "failed response", result: "no_more_coupon", level: "WARN", code: "no_more_coupon"},
+		{name: "client error", err: connect.NewError(connect.CodePermissionDenied, errors.New("denied")), level: "WARN", code: "permission_denied"},
+		{name: "server error", err: connect.NewError(connect.CodeInternal, errors.New("boom")), level: "ERROR", code: "internal"},
+		{name: "plain error", err: errors.New("boom"), level: "ERROR", code: "unknown"},
+	}
+
+	defer slog.SetDefault(slog.Default())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Setup(&buf, Options{Level: slog.LevelDebug}); err != nil {
+				t.Fatalf("Setup: %v", err)
+			}
+
+			ctx := withFields(context.Background(), slog.String("rpc", "/v1.CouponService/IssueCoupon"))
+			if tt.result != "" {
+				Set(ctx, "result", tt.result)
+			}
+			logResult(ctx, time.Now(), tt.err)
+
+			var line struct {
+				Level string `json:"level"`
+				Code  string `json:"code"`
+				RPC   string `json:"rpc"`
+			}
+			if err := json.Unmarshal(buf.Bytes(), &line); err != nil {
+				t.Fatalf("log line %q: %v", buf.String(), err)
+			}
+			if line.Level != tt.level || line.Code != tt.code {
+				t.Errorf("level %s, code %q, want %s, %q", line.Level, line.Code, tt.level, tt.code)
+			}
+			if line.RPC == "" {
+				t.Errorf("log line has no rpc field: %s", buf.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// 쿠폰 코드가 들어가는 attribute key : CouponCodes 설정에 따라 가리거나 빼고 출력함
+var couponCodeKeys = map[string]bool{
+	"couponCode": true,
+	"couponId":   true,
+}
+
+// Options : Format 은 json, text / CouponCodes 는 mask, omit, full
+type Options struct {
+	Format      string
+	Level       slog.Leveler
+	CouponCodes string
+}
+
+// Setup : slog 기본 logger 교체, log 패키지 출력도 slog 로 넘어감
+func Setup(w io.Writer, opts Options) error {
+	handlerOpts := &slog.HandlerOptions{Level: opts.Level}
+
+	switch opts.CouponCodes {
+	case "", "mask":
+		handlerOpts.ReplaceAttr = replaceCouponCode(MaskCode)
+	case "omit":
+		handlerOpts.ReplaceAttr = replaceCouponCode(nil)
+	case "full":
+	default:
+		return fmt.Errorf("unknown coupon code log mode %q (mask, omit, full)", opts.CouponCodes)
+	}
+
+	var handler slog.Handler
+	switch opts.Format {
+	case "", "json":
+		handler = slog.NewJSONHandler(w, handlerOpts)
+	case "text":
+		handler = slog.NewTextHandler(w, handlerOpts)
+	default:
+		return fmt.Errorf("unknown log format %q (json, text)", opts.Format)
+	}
+
+	slog.SetDefault(slog.New(&contextHandler{Handler: handler}))
+	return nil
+}
+
+// replaceCouponCode : mask 가 nil 이면 attribute 를 빼버림
+func replaceCouponCode(mask func(string) string) func([]string, slog.Attr) slog.Attr {
+	return func(groups []string, a slog.Attr) slog.Attr {
+		if !couponCodeKeys[a.Key] || a.Value.Kind() != slog.KindString {
+			return a
+		}
+		if mask == nil {
+			return slog.Attr{}
+		}
+		return slog.String(a.Key, mask(a.Value.String()))
+	}
+}
+
+// MaskCode : 앞 3글자만 남김, 문의 대응할때 어떤 쿠폰인지 대략 구분할 수 있는 정도
+func MaskCode(code string) string {
+	runes := []rune(code)
+	if len(runes) <= 3 {
+		return "***"
+	}
+	return string(runes[:3]) + "*******"
+}
+
+// contextHandler : interceptor 가 ctx 에 넣어둔 요청 정보와 trace id 를 모든 로그에 붙임
+// ctx 를 받는 slog.InfoContext 등으로 남긴 로그에만 적용됨
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if f := fieldsFrom(ctx); f != nil {
+		r.AddAttrs(f.attrs()...)
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttrs(slog.String("traceId", sc.TraceID().String()))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/cache"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// OtherLabel : series 제한을 넘은 캠페인들은 tenant, campaign label 을 이 값으로 합쳐서 내보냄
+const OtherLabel = "_other"
+
+var (
+	campaignRemainingDesc = prometheus.NewDesc(namespace+"_campaign_remaining_coupons",
+		"캠페인별 남은(발급되지 않은) 쿠폰 수", []string{"tenant", "campaign"}, nil)
+	campaignIssuedDesc = prometheus.NewDesc(namespace+"_campaign_issued_coupons",
+		"캠페인별 발급된 쿠폰 수", []string{"tenant", "campaign"}, nil)
+	campaignRedeemedDesc = prometheus.NewDesc(namespace+"_campaign_redeemed_coupons",
+		"캠페인별 사용된 쿠폰 수", []string{"tenant", "campaign"}, nil)
+	campaignsDesc = prometheus.NewDesc(namespace+"_campaigns",
+		"종료되지 않은 캠페인 수 (state: active, scheduled)", []string{"state"}, nil)
+)
+
+// CampaignCollector : scrape 할때마다 cache.Manager 에서 현황을 읽음
+// 캠페인 수만큼 series 가 생기므로 maxSeries 개까지만 따로 내보내고 나머지는 OtherLabel 로 합침
+type CampaignCollector struct {
+	manager   *cache.CampaignManager
+	maxSeries int
+}
+
+// RegisterCampaignCollector : maxSeries 가 0 이면 캠페인별 series 없이 합계만 내보냄
+func RegisterCampaignCollector(manager *cache.CampaignManager, maxSeries int) error {
+	return Registry.Register(&CampaignCollector{manager: manager, maxSeries: maxSeries})
+}
+
+func (c *CampaignCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- campaignRemainingDesc
+	ch <- campaignIssuedDesc
+	ch <- campaignRedeemedDesc
+	ch <- campaignsDesc
+}
+
+func (c *CampaignCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.manager.Stats(time.Now())
+
+	var active, scheduled int
+	var other cache.CampaignStats
+	hasOther := false
+
+	for i, s := range stats {
+		if s.Active {
+			active++
+		} else {
+			scheduled++
+		}
+
+		if i >= c.maxSeries {
+			other.Remaining += s.Remaining
+			other.Issued += s.Issued
+			other.Redeemed += s.Redeemed
+			hasOther = true
+			continue
+		}
+		collectCampaign(ch, s.TenantId, s.CampaignId, s)
+	}
+
+	if hasOther {
+		collectCampaign(ch, OtherLabel, OtherLabel, other)
+	}
+
+	ch <- prometheus.MustNewConstMetric(campaignsDesc, prometheus.GaugeValue, float64(active), "active")
+	ch <- prometheus.MustNewConstMetric(campaignsDesc, prometheus.GaugeValue, float64(scheduled), "scheduled")
+}
+
+func collectCampaign(ch chan<- prometheus.Metric, tenantId, campaignId string, s cache.CampaignStats) {
+	ch <- prometheus.MustNewConstMetric(campaignRemainingDesc, prometheus.GaugeValue, float64(s.Remaining), tenantId, campaignId)
+	ch <- prometheus.MustNewConstMetric(campaignIssuedDesc, prometheus.GaugeValue, float64(s.Issued), tenantId, campaignId)
+	ch <- prometheus.MustNewConstMetric(campaignRedeemedDesc, prometheus.GaugeValue, float64(s.Redeemed), tenantId, campaignId)
+}
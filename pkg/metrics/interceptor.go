@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// Interceptor : RPC 별 요청 수와 처리 시간 기록
+// 인증/tenant interceptor 에서 거절된 요청도 세기 위해 맨 앞에 둠
+type Interceptor struct{}
+
+func NewInterceptor() *Interceptor {
+	return &Interceptor{}
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+
+		start := time.Now()
+		res, err := next(ctx, req)
+		observeRPC(req.Spec().Procedure, start, err)
+		return res, err
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		start := time.Now()
+		err := next(ctx, conn)
+		observeRPC(conn.Spec().Procedure, start, err)
+		return err
+	}
+}
+
+// observeRPC : procedure 는 등록된 handler 경로만 들어오므로 label 수가 고정됨
+func observeRPC(procedure string, start time.Time, err error) {
+	rpcRequests.WithLabelValues(procedure, codeOf(err)).Inc()
+	rpcDuration.WithLabelValues(procedure).Observe(time.Since(start).Seconds())
+}
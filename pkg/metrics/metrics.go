@@ -0,0 +1,109 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/cache"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "coupon"
+
+// Registry : 기본 registry 대신 따로 만들어서 이 서버가 내보내는 metric 만 등록함
+var Registry = prometheus.NewRegistry()
+
+var (
+	rpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rpc_requests_total",
+		Help:      "처리한 RPC 수 (procedure, connect code 별)",
+	}, []string{"procedure", "code"})
+
+	rpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "rpc_duration_seconds",
+		Help:      "RPC 처리 시간",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"procedure"})
+
+	issueResults = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "issue_total",
+		Help:      "쿠폰 발급 요청 결과 (result: ok 또는 실패 사유)",
+	}, []string{"result"})
+
+	redeemResults = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "redeem_total",
+		Help:      "쿠폰 사용 요청 결과 (result: ok 또는 실패 사유)",
+	}, []string{"result"})
+
+	lockWait = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "campaign_lock_wait_seconds",
+		Help:      "캠페인 lock 을 잡기까지 기다린 시간 (operation: publish, use, revoke, unredeem)",
+		Buckets:   []float64{.00001, .00005, .0001, .0005, .001, .005, .01, .05, .1, .5},
+	}, []string{"operation"})
+
+	webhookDeliveries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "webhook_deliveries_total",
+		Help:      "webhook 전송 시도 결과 (result: ok, retry, dead)",
+	}, []string{"result"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		rpcRequests,
+		rpcDuration,
+		issueResults,
+		redeemResults,
+		lockWait,
+		webhookDeliveries,
+	)
+}
+
+// Handler : 인증 interceptor 없이 등록함 (scrape 용)
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// ObserveIssue : 발급 결과 기록, 실패 사유는 cache.ErrorReason 으로 묶어서 label 수를 제한함
+func ObserveIssue(err error) {
+	issueResults.WithLabelValues(cache.ErrorReason(err)).Inc()
+}
+
+// ObserveRedeem : 사용 결과 기록
+func ObserveRedeem(err error) {
+	redeemResults.WithLabelValues(cache.ErrorReason(err)).Inc()
+}
+
+// ObserveLockWait : cache.CampaignManager.SetLockWaitObserver 에 넘김
+func ObserveLockWait(operation string, wait time.Duration) {
+	lockWait.WithLabelValues(operation).Observe(wait.Seconds())
+}
+
+// ObserveWebhookDelivery : webhook.Dispatcher 의 observer 로 넘김
+func ObserveWebhookDelivery(result string) {
+	webhookDeliveries.WithLabelValues(result).Inc()
+}
+
+// codeOf : 성공이면 "ok", connect 에러가 아니면 "unknown"
+func codeOf(err error) string {
+	if err == nil {
+		return "ok"
+	}
+
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr.Code().String()
+	}
+	return connect.CodeUnknown.String()
+}
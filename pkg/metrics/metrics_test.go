@@ -0,0 +1,113 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/cache"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestCodeOf(t *testing.T) {
+	tests := []struct {
+		err  error
+		code string
+	}{
+		{err: nil, code: "ok"},
+		{err: connect.NewError(connect.CodePermissionDenied, errors.New("denied")), code: "permission_denied"},
+		{err: fmt.Errorf("wrapped: %w", connect.NewError(connect.CodeUnavailable, errors.New("not ready"))), code: "unavailable"},
+		{err: cache.ErrNoMoreCoupon, code: "unknown"},
+	}
+
+	for _, tt := range tests {
+		if got := codeOf(tt.err); got != tt.code {
+			t.Errorf("codeOf(%v) = %q, want %q", tt.err, got, tt.code)
+		}
+	}
+}
+
+// lockWaitCount : operation 별 lock 대기 기록 수
+func lockWaitCount(t *testing.T, operation string) uint64 {
+	t.Helper()
+
+	families, err := Registry.Gather()
+	if err != nil {
+		t.Fatalf("Gather: %v", err)
+	}
+	for _, family := range families {
+		if family.GetName() != namespace+"_campaign_lock_wait_seconds" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "operation" && label.GetValue() == operation {
+					return metric.GetHistogram().GetSampleCount()
+				}
+			}
+		}
+	}
+	return 0
+}
+
+func TestObserveLockWait(t *testing.T) {
+	m := cache.NewCampaignManager()
+	m.SetLockWaitObserver(ObserveLockWait)
+	now := time.Now()
+	if err := m.CreateCampaign(context.Background(), "brand", "spring", now.Add(-time.Minute), now.Add(time.Hour), 1, cache.CampaignOptions{}); err != nil {
+		t.Fatalf("CreateCampaign: %v", err)
+	}
+
+	before := lockWaitCount(t, "publish")
+	if _, err := m.PublishCoupon(context.Background(), "brand", "spring", "u1", cache.IssueOptions{}); err != nil {
+		t.Fatalf("PublishCoupon: %v", err)
+	}
+	if after := lockWaitCount(t, "publish"); after != before+1 {
+		t.Fatalf("publish lock wait count = %d, want %d", after, before+1)
+	}
+}
+
+func TestCampaignCollectorFoldsOther(t *testing.T) {
+	m := cache.NewCampaignManager()
+	now := time.Now()
+	for i, id := range []string{"a", "b", "c", "d"} {
+		if err := m.CreateCampaign(context.Background(), "brand", id, now.Add(-time.Minute), now.Add(time.Hour), int64(10*(i+1)), cache.CampaignOptions{}); err != nil {
+			t.Fatalf("CreateCampaign: %v", err)
+		}
+	}
+	if _, err := m.PublishCoupon(context.Background(), "brand", "d", "u1", cache.IssueOptions{}); err != nil {
+		t.Fatalf("PublishCoupon: %v", err)
+	}
+
+	// tenant, campaign id 순서로 앞의 2개만 따로, c(30) + d(40 - 1) 은 _other 로 합침
+	expected := `
+# HELP coupon_campaign_remaining_coupons 캠페인별 남은(발급되지 않은) 쿠폰 수
+# TYPE coupon_campaign_remaining_coupons gauge
+coupon_campaign_remaining_coupons{campaign="_other",tenant="_other"} 69
+coupon_campaign_remaining_coupons{campaign="a",tenant="brand"} 10
+coupon_campaign_remaining_coupons{campaign="b",tenant="brand"} 20
+# HELP coupon_campaign_issued_coupons 캠페인별 발급된 쿠폰 수
+# TYPE coupon_campaign_issued_coupons gauge
+coupon_campaign_issued_coupons{campaign="_other",tenant="_other"} 1
+coupon_campaign_issued_coupons{campaign="a",tenant="brand"} 0
+coupon_campaign_issued_coupons{campaign="b",tenant="brand"} 0
+# HELP coupon_campaigns 종료되지 않은 캠페인 수 (state: active, scheduled)
+# TYPE coupon_campaigns gauge
+coupon_campaigns{state="active"} 4
+coupon_campaigns{state="scheduled"} 0
+`
+	collector := &CampaignCollector{manager: m, maxSeries: 2}
+	if err := testutil.CollectAndCompare(collector, strings.NewReader(expected),
+		"coupon_campaign_remaining_coupons", "coupon_campaign_issued_coupons", "coupon_campaigns"); err != nil {
+		t.Fatal(err)
+	}
+
+	// maxSeries 가 0 이면 합계만
+	if n := testutil.CollectAndCount(&CampaignCollector{manager: m}, "coupon_campaign_remaining_coupons"); n != 1 {
+		t.Fatalf("remaining series with maxSeries 0 = %d, want 1", n)
+	}
+}
@@ -1,13 +1,82 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
+// CouponState : available -> issued -> (held ->) redeemed 순서로 바뀌고, revoked / expired 는 더 바뀌지 않음
+type CouponState string
+
+const (
+	CouponAvailable CouponState = "available" // 아직 발급 안됨
+	CouponIssued    CouponState = "issued"    // 사용자에게 발급됨
+	CouponHeld      CouponState = "held"      // 결제 진행중이라 예약됨 (HeldUntil 까지)
+	CouponRedeemed  CouponState = "redeemed"  // 사용됨
+	CouponRevoked   CouponState = "revoked"   // 관리자가 회수함
+	CouponExpired   CouponState = "expired"   // 사용하지 않고 기간이 끝남
+)
+
+// CouponStates : 모든 상태
+var CouponStates = []CouponState{CouponAvailable, CouponIssued, CouponHeld, CouponRedeemed, CouponRevoked, CouponExpired}
+
+// couponTransitions : 허용하는 상태 변경
+// issued -> available 은 회수하면서 코드를 다시 발급 가능하게 돌려놓는 경우
+// redeemed -> issued 는 주문 취소로 사용을 되돌리는 경우
+// held -> issued 는 결제 실패로 예약을 풀거나 예약 시간이 지난 경우
+var couponTransitions = map[CouponState][]CouponState{
+	CouponAvailable: {CouponIssued, CouponRevoked, CouponExpired},
+	CouponIssued:    {CouponRedeemed, CouponHeld, CouponAvailable, CouponRevoked, CouponExpired},
+	CouponHeld:      {CouponRedeemed, CouponIssued, CouponRevoked},
+	CouponRedeemed:  {CouponIssued},
+}
+
+func (s CouponState) CanTransitionTo(next CouponState) bool {
+	for _, allowed := range couponTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
 type Coupon struct {
 	CouponId    string
+	UserId      string // 발급받은 사용자
 	StartDate   time.Time
 	ExpiredDate time.Time
-	PublishYn   bool // 발행여부
-	UseYn       bool // 사용여부
+	State       CouponState
+	Tier        string    `json:",omitempty"` // tier 예약 수량에서 발급된 경우 tier 이름
+	IssuedAt    time.Time // 마지막으로 발급된 시각, 발급 대기 목록으로 돌아가면 zero
+	RedeemedAt  time.Time // 사용된 시각, 사용 취소되면 zero
+
+	// held 상태일때만 채워짐
+	ReservationId string `json:",omitempty"`
+	HeldUntil     time.Time
+}
+
+// UnmarshalJSON : State 가 없는 예전 snapshot 은 PublishYn / UseYn 으로 상태를 정함
+func (c *Coupon) UnmarshalJSON(data []byte) error {
+	type coupon Coupon
+	legacy := struct {
+		coupon
+		PublishYn bool
+		UseYn     bool
+	}{}
+	if err := json.Unmarshal(data, &legacy); err != nil {
+		return err
+	}
+
+	*c = Coupon(legacy.coupon)
+	if c.State == "" {
+		switch {
+		case legacy.UseYn:
+			c.State = CouponRedeemed
+		case legacy.PublishYn:
+			c.State = CouponIssued
+		default:
+			c.State = CouponAvailable
+		}
+	}
+	return nil
 }
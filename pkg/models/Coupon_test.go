@@ -0,0 +1,58 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCanTransitionTo(t *testing.T) {
+	allowed := map[[2]CouponState]bool{
+		{CouponAvailable, CouponIssued}:  true,
+		{CouponAvailable, CouponRevoked}: true,
+		{CouponAvailable, CouponExpired}: true,
+		{CouponIssued, CouponRedeemed}:   true,
+		{CouponIssued, CouponHeld}:       true,
+		{CouponIssued, CouponAvailable}:  true,
+		{CouponIssued, CouponRevoked}:    true,
+		{CouponIssued, CouponExpired}:    true,
+		{CouponHeld, CouponRedeemed}:     true,
+		{CouponHeld, CouponIssued}:       true,
+		{CouponHeld, CouponRevoked}:      true,
+		{CouponRedeemed, CouponIssued}:   true,
+	}
+
+	// 여기 없는 변경은 모두 거부 : revoked, expired 는 더 바뀌지 않음
+	for _, from := range CouponStates {
+		for _, to := range CouponStates {
+			want := allowed[[2]CouponState{from, to}]
+			if got := from.CanTransitionTo(to); got != want {
+				t.Errorf("%s -> %s = %v, want %v", from, to, got, want)
+			}
+		}
+	}
+}
+
+func TestCouponUnmarshalLegacy(t *testing.T) {
+	tests := []struct {
+		name  string
+		json  string
+		state CouponState
+	}{
+		{name: "unpublished", json: `{"CouponId":"A"}`, state: CouponAvailable},
+		{name: "published", json: `{"CouponId":"A","PublishYn":true}`, state: CouponIssued},
+		{name: "used", json: `{"CouponId":"A","PublishYn":true,"UseYn":true}`, state: CouponRedeemed},
+		{name: "state wins", json: `{"CouponId":"A","State":"revoked","PublishYn":true}`, state: CouponRevoked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c Coupon
+			if err := json.Unmarshal([]byte(tt.json), &c); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+			if c.CouponId != "A" || c.State != tt.state {
+				t.Errorf("coupon %s, state %s, want A, %s", c.CouponId, c.State, tt.state)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/audit"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/tenant"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1/v1connect"
+)
+
+const (
+	defaultAuditLimit = 100
+	maxAuditLimit     = 1000
+	exportChunkSize   = 64 * 1024
+)
+
+type AuditServer struct {
+	log *audit.Log
+}
+
+// NewAuditServer creates a new audit server
+func NewAuditServer(log *audit.Log) v1connect.AuditServiceHandler {
+	return &AuditServer{log: log}
+}
+
+func (s *AuditServer) QueryAuditLog(ctx context.Context, req *connect.Request[v1.QueryAuditLogReq]) (*connect.Response[v1.QueryAuditLogRes], error) {
+	auditRes := &v1.QueryAuditLogRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+	}
+
+	filter, err := auditFilter(ctx, req.Msg)
+	if err != nil {
+		auditRes.Result.Success = false
+		auditRes.Result.Message = err.Error()
+		return connect.NewResponse(auditRes), connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = defaultAuditLimit
+	}
+	limit = min(limit, maxAuditLimit)
+
+	entries, hasMore, err := s.log.Query(filter, limit)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	for i := range entries {
+		auditRes.Entries = append(auditRes.Entries, auditEntryMessage(&entries[i]))
+	}
+	if hasMore {
+		auditRes.NextAfterSeq = entries[len(entries)-1].Seq
+	}
+
+	return connect.NewResponse(auditRes), nil
+}
+
+// ExportAuditLog : 조건에 맞는 이력 전체를 저장 파일과 같은 JSON Lines 형식으로 보냄
+func (s *AuditServer) ExportAuditLog(ctx context.Context, req *connect.Request[v1.QueryAuditLogReq], stream *connect.ServerStream[v1.ExportAuditLogRes]) error {
+	filter, err := auditFilter(ctx, req.Msg)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+
+	err = s.log.Each(filter, func(e *audit.Entry) error {
+		if err := encoder.Encode(e); err != nil {
+			return err
+		}
+		if buf.Len() < exportChunkSize {
+			return ctx.Err()
+		}
+		if err := stream.Send(&v1.ExportAuditLogRes{Lines: buf.Bytes()}); err != nil {
+			return err
+		}
+		buf.Reset()
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if buf.Len() > 0 {
+		return stream.Send(&v1.ExportAuditLogRes{Lines: buf.Bytes()})
+	}
+	return nil
+}
+
+// auditFilter : 호출한 tenant 의 이력만 조회함
+func auditFilter(ctx context.Context, req *v1.QueryAuditLogReq) (audit.Filter, error) {
+	filter := audit.Filter{
+		TenantId:   tenant.FromContext(ctx),
+		CampaignId: req.CampaignId,
+		CouponCode: req.CouponCode,
+		Actor:      req.Actor,
+		AfterSeq:   req.AfterSeq,
+	}
+
+	var err error
+	if filter.Since, err = parseAuditTime(req.Since); err != nil {
+		return filter, fmt.Errorf("invalid since: %w", err)
+	}
+	if filter.Until, err = parseAuditTime(req.Until); err != nil {
+		return filter, fmt.Errorf("invalid until: %w", err)
+	}
+	return filter, nil
+}
+
+// parseAuditTime : RFC3339 또는 yyyy-mm-dd (서버 시간대 0시)
+func parseAuditTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	return time.ParseInLocation("2006-01-02", s, time.Local)
+}
+
+func auditEntryMessage(e *audit.Entry) *v1.AuditEntry {
+	return &v1.AuditEntry{
+		Seq:        e.Seq,
+		Time:       e.Time.Format(time.RFC3339Nano),
+		Actor:      e.Actor,
+		ActorRole:  e.ActorRole,
+		TenantId:   e.TenantId,
+		Rpc:        e.RPC,
+		RequestId:  e.RequestId,
+		Action:     e.Action,
+		CampaignId: e.CampaignId,
+		CouponCode: e.CouponCode,
+		Before:     auditValues(e.Before),
+		After:      auditValues(e.After),
+	}
+}
+
+func auditValues(values map[string]any) string {
+	if values == nil {
+		return ""
+	}
+	out, _ := json.Marshal(values)
+	return string(out)
+}
@@ -1,9 +1,20 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/auth"
 	"github.com/dev-jiemu/coupon-issuance-poc/pkg/cache"
-	"log"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/logging"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/models"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/tenant"
+	"log/slog"
+	"regexp"
+	"strconv"
 	"time"
 
 	"connectrpc.com/connect"
@@ -18,9 +29,7 @@ func NewCampaignServer() v1connect.CampaignServiceHandler {
 	return &CampaignServer{}
 }
 
-func (s *CampaignServer) CreateCampaign(context context.Context, req *connect.Request[v1.CreateCampaignReq]) (*connect.Response[v1.CreateCampaignRes], error) {
-	log.Printf("CreateCampaign called with campaignId: %s \n", req.Msg.CampaignId)
-
+func (s *CampaignServer) CreateCampaign(ctx context.Context, req *connect.Request[v1.CreateCampaignReq]) (*connect.Response[v1.CreateCampaignRes], error) {
 	campaignRes := &v1.CreateCampaignRes{
 		Result: &v1.BaseResponse{
 			Success: true,
@@ -32,37 +41,72 @@ func (s *CampaignServer) CreateCampaign(context context.Context, req *connect.Re
 	// TODO : 날짜포맷 예외케이스 개선 필요
 	start, startErr := time.Parse("2006-01-02", req.Msg.StartDate)
 	if startErr != nil {
-		log.Printf("CreateCampaign failed with error: %v \n", startErr)
+		logging.Set(ctx, "result", "invalid_date")
 		campaignRes.Result.Success = false
 		campaignRes.Result.Message = startErr.Error()
+		campaignRes.Result.ErrorCode = "invalid_date"
 		return connect.NewResponse(campaignRes), startErr
 	}
 
 	expired, endErr := time.Parse("2006-01-02", req.Msg.ExpiredDate)
 	if endErr != nil {
-		log.Printf("CreateCampaign failed with error: %v \n", endErr)
+		logging.Set(ctx, "result", "invalid_date")
 		campaignRes.Result.Success = false
 		campaignRes.Result.Message = endErr.Error()
+		campaignRes.Result.ErrorCode = "invalid_date"
 		return connect.NewResponse(campaignRes), endErr
 	}
 
 	startDate := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.Local)
 	expiredDate := time.Date(expired.Year(), expired.Month(), expired.Day(), 23, 59, 59, 0, time.Local)
 
-	err := cache.Manager.CreateCampaign(req.Msg.CampaignId, startDate, expiredDate, req.Msg.MaxCoupon)
+	schedule, err := scheduleFromMessage(req.Msg.Schedule)
+	if err != nil {
+		logging.Set(ctx, "result", "invalid_schedule")
+		campaignRes.Result.Success = false
+		campaignRes.Result.Message = err.Error()
+		campaignRes.Result.ErrorCode = "invalid_schedule"
+		return connect.NewResponse(campaignRes), connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	raffle, err := raffleFromMessage(req.Msg.Raffle)
+	if err != nil {
+		logging.Set(ctx, "result", "invalid_raffle")
+		campaignRes.Result.Success = false
+		campaignRes.Result.Message = err.Error()
+		campaignRes.Result.ErrorCode = "invalid_raffle"
+		return connect.NewResponse(campaignRes), connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tiers, err := tiersFromMessage(req.Msg.Tiers)
 	if err != nil {
-		log.Printf("CreateCampaign failed with error: %v \n", err)
+		logging.Set(ctx, "result", "invalid_tier")
 		campaignRes.Result.Success = false
 		campaignRes.Result.Message = err.Error()
+		campaignRes.Result.ErrorCode = "invalid_tier"
+		return connect.NewResponse(campaignRes), connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	err = cache.Manager.CreateCampaign(ctx, tenant.FromContext(ctx), req.Msg.CampaignId, startDate, expiredDate, req.Msg.MaxCoupon, cache.CampaignOptions{
+		Benefit:  benefitFromMessage(req.Msg.Benefit),
+		Rules:    rulesFromMessage(req.Msg.Rules),
+		Schedule: schedule,
+		Raffle:   raffle,
+		Tiers:    tiers,
+	})
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	if err != nil {
+		campaignRes.Result.Success = false
+		campaignRes.Result.Message = err.Error()
+		campaignRes.Result.ErrorCode = cache.ErrorReason(err)
+	} else {
+		slog.InfoContext(ctx, "campaign created", "startDate", req.Msg.StartDate, "expiredDate", req.Msg.ExpiredDate, "maxCoupon", req.Msg.MaxCoupon)
 	}
 
-	log.Printf("CreateCampaign result: %v \n", campaignRes)
 	return connect.NewResponse(campaignRes), nil
 }
 
-func (s *CampaignServer) GetCampaign(context context.Context, req *connect.Request[v1.GetCampaignReq]) (*connect.Response[v1.GetCampaignRes], error) {
-	log.Printf("GetCampaign called with campaignId: %s \n", req.Msg.CampaignId)
-
+func (s *CampaignServer) GetCampaign(ctx context.Context, req *connect.Request[v1.GetCampaignReq]) (*connect.Response[v1.GetCampaignRes], error) {
 	campaignRes := &v1.GetCampaignRes{
 		Result: &v1.BaseResponse{
 			Success: true,
@@ -73,11 +117,12 @@ func (s *CampaignServer) GetCampaign(context context.Context, req *connect.Reque
 		},
 	}
 
-	coupons, err := cache.Manager.GetCampaignInfo(req.Msg.CampaignId)
+	coupons, err := cache.Manager.GetCampaignInfo(tenant.FromContext(ctx), req.Msg.CampaignId)
+	logging.Set(ctx, "result", cache.ErrorReason(err))
 	if err != nil {
-		log.Printf("GetCampaign failed with error: %v \n", err)
 		campaignRes.Result.Success = false
 		campaignRes.Result.Message = err.Error()
+		campaignRes.Result.ErrorCode = cache.ErrorReason(err)
 		return connect.NewResponse(campaignRes), err
 	}
 
@@ -85,7 +130,571 @@ func (s *CampaignServer) GetCampaign(context context.Context, req *connect.Reque
 	campaignRes.Info.StartDate = coupons.StartDate
 	campaignRes.Info.ExpiredDate = coupons.ExpiredDate
 	campaignRes.Info.AllCouponIds = coupons.AllCouponIds
+	campaignRes.Info.CouponCount = int64(len(coupons.AllCouponIds))
+	campaignRes.Info.Benefit = benefitMessage(coupons.Benefit)
+	campaignRes.Info.Rules = ruleMessages(coupons.Rules)
+	campaignRes.Info.Schedule = scheduleMessage(coupons.Schedule)
+	campaignRes.Info.NextReleaseAt = formatReleaseTime(coupons.NextRelease)
+	campaignRes.Info.Raffle = raffleMessage(coupons.Raffle)
+	campaignRes.Info.Tiers = tierMessages(coupons.Tiers, coupons.TierReserved)
+	campaignRes.Info.Paused = coupons.Paused
+
+	// GetCampaign 은 client 도 호출할 수 있어서 쿠폰 코드, 발급 조건 값, tier 멤버는 admin 에게만 내려줌 (인증을 끄면 모두 내려줌)
+	if principal := auth.FromContext(ctx); principal != nil && !principal.IsAdmin() {
+		redactCampaignInfo(campaignRes.Info)
+	}
+
+	return connect.NewResponse(campaignRes), nil
+}
+
+// redactCampaignInfo : client 에게는 쿠폰 코드 목록 대신 쿠폰 수, 발급 조건은 실패시 응답에 내려가는 rule 이름만 남김
+// tier 는 멤버 목록 없이 이름, 예약 수량, 발급 시작 시각만 남김
+func redactCampaignInfo(info *v1.CampaignInfo) {
+	info.AllCouponIds = nil
+	for _, rule := range info.Rules {
+		rule.Attribute, rule.Op, rule.Values = "", "", nil
+	}
+	for _, tier := range info.Tiers {
+		tier.UserIds = nil
+	}
+}
+
+func (s *CampaignServer) ListCampaigns(ctx context.Context, req *connect.Request[v1.ListCampaignsReq]) (*connect.Response[v1.ListCampaignsRes], error) {
+	campaignRes := &v1.ListCampaignsRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+	}
+
+	for _, info := range cache.Manager.ListCampaigns(tenant.FromContext(ctx)) {
+		campaignRes.Campaigns = append(campaignRes.Campaigns, &v1.CampaignInfo{
+			CampaignId:  info.CampaignId,
+			StartDate:   info.StartDate,
+			ExpiredDate: info.ExpiredDate,
+			Benefit:     benefitMessage(info.Benefit),
+			Rules:       ruleMessages(info.Rules),
+			Schedule:    scheduleMessage(info.Schedule),
+			Raffle:      raffleMessage(info.Raffle),
+			Tiers:       tierMessages(info.Tiers, nil),
+		})
+	}
+
+	slog.DebugContext(ctx, "campaigns listed", "count", len(campaignRes.Campaigns))
+	return connect.NewResponse(campaignRes), nil
+}
+
+// UpdateCampaign : 지금은 쿠폰 수를 늘리는 것만 지원함, 늘어난 쿠폰은 대기자에게 먼저 발급됨
+func (s *CampaignServer) UpdateCampaign(ctx context.Context, req *connect.Request[v1.UpdateCampaignReq]) (*connect.Response[v1.UpdateCampaignRes], error) {
+	campaignRes := &v1.UpdateCampaignRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+	}
+
+	err := cache.Manager.RaiseMaxCoupons(ctx, tenant.FromContext(ctx), req.Msg.CampaignId, req.Msg.MaxCoupon)
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	if err != nil {
+		campaignRes.Result.Success = false
+		campaignRes.Result.Message = err.Error()
+		campaignRes.Result.ErrorCode = cache.ErrorReason(err)
+		if errors.Is(err, cache.ErrInvalidMaxCoupon) {
+			return connect.NewResponse(campaignRes), connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	} else {
+		slog.InfoContext(ctx, "campaign updated", "maxCoupon", req.Msg.MaxCoupon)
+	}
+
+	return connect.NewResponse(campaignRes), nil
+}
+
+func (s *CampaignServer) DeleteCampaign(ctx context.Context, req *connect.Request[v1.DeleteCampaignReq]) (*connect.Response[v1.DeleteCampaignRes], error) {
+	campaignRes := &v1.DeleteCampaignRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+	}
+
+	err := cache.Manager.DeleteCampaign(ctx, tenant.FromContext(ctx), req.Msg.CampaignId, req.Msg.Force)
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	if err != nil {
+		campaignRes.Result.Success = false
+		campaignRes.Result.Message = err.Error()
+		campaignRes.Result.ErrorCode = cache.ErrorReason(err)
+	} else {
+		slog.InfoContext(ctx, "campaign deleted", "force", req.Msg.Force)
+	}
 
-	log.Printf("GetCampaign result: %v \n", campaignRes)
 	return connect.NewResponse(campaignRes), nil
 }
+
+func (s *CampaignServer) PauseCampaign(ctx context.Context, req *connect.Request[v1.PauseCampaignReq]) (*connect.Response[v1.PauseCampaignRes], error) {
+	campaignRes := &v1.PauseCampaignRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+	}
+
+	err := cache.Manager.SetPaused(ctx, tenant.FromContext(ctx), req.Msg.CampaignId, true, req.Msg.Reason)
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	if err != nil {
+		campaignRes.Result.Success = false
+		campaignRes.Result.Message = err.Error()
+		campaignRes.Result.ErrorCode = cache.ErrorReason(err)
+	} else {
+		slog.InfoContext(ctx, "campaign paused", "reason", req.Msg.Reason)
+	}
+
+	return connect.NewResponse(campaignRes), nil
+}
+
+func (s *CampaignServer) ResumeCampaign(ctx context.Context, req *connect.Request[v1.ResumeCampaignReq]) (*connect.Response[v1.ResumeCampaignRes], error) {
+	campaignRes := &v1.ResumeCampaignRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+	}
+
+	err := cache.Manager.SetPaused(ctx, tenant.FromContext(ctx), req.Msg.CampaignId, false, "")
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	if err != nil {
+		campaignRes.Result.Success = false
+		campaignRes.Result.Message = err.Error()
+		campaignRes.Result.ErrorCode = cache.ErrorReason(err)
+	} else {
+		slog.InfoContext(ctx, "campaign resumed")
+	}
+
+	return connect.NewResponse(campaignRes), nil
+}
+
+// GetCampaignStats : 대시보드가 주기적으로 호출함, 쿠폰 코드 목록 없이 수치만 내려감
+func (s *CampaignServer) GetCampaignStats(ctx context.Context, req *connect.Request[v1.GetCampaignStatsReq]) (*connect.Response[v1.GetCampaignStatsRes], error) {
+	now := time.Now()
+	campaignRes := &v1.GetCampaignStatsRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+		Time: now.Format(time.RFC3339Nano),
+	}
+
+	tenantId := tenant.FromContext(ctx)
+	stats, err := cache.Manager.TenantStats(tenantId, req.Msg.CampaignId, now)
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	if err != nil {
+		campaignRes.Result.Success = false
+		campaignRes.Result.Message = err.Error()
+		campaignRes.Result.ErrorCode = cache.ErrorReason(err)
+		return connect.NewResponse(campaignRes), nil
+	}
+
+	for _, stat := range stats {
+		campaignRes.Campaigns = append(campaignRes.Campaigns, &v1.CampaignStats{
+			CampaignId:  stat.CampaignId,
+			StartDate:   stat.StartDate.Format(time.RFC3339),
+			ExpiredDate: stat.ExpiredDate.Format(time.RFC3339),
+			MaxCoupon:   stat.MaxCoupons,
+			Remaining:   stat.Remaining,
+			Issued:      stat.Issued,
+			Redeemed:    stat.Redeemed,
+			Held:        stat.Held,
+			Failures:    stat.Failures,
+			Waitlist:    int32(stat.Waitlist),
+			Paused:      stat.Paused,
+		})
+	}
+
+	if req.Msg.CampaignId != "" && len(campaignRes.Campaigns) == 1 {
+		events, err := cache.Manager.RecentActivity(tenantId, req.Msg.CampaignId)
+		if err == nil {
+			for _, event := range events {
+				campaignRes.Campaigns[0].RecentEvents = append(campaignRes.Campaigns[0].RecentEvents, &v1.CampaignEvent{
+					Time:       event.Time.Format(time.RFC3339Nano),
+					Type:       event.Type,
+					CouponCode: event.CouponCode,
+					UserId:     event.UserId,
+					Reason:     event.Reason,
+				})
+			}
+		}
+	}
+
+	return connect.NewResponse(campaignRes), nil
+}
+
+// maxImportRows : 한번에 가져올 수 있는 코드 수, 전부 메모리에 모은 뒤 한번에 반영함
+const maxImportRows = 1_000_000
+
+// ImportCoupons : 받은 코드를 모두 모은 뒤 한번에 가져옴, 형식이 맞지 않거나 중복인 행은 거절 목록으로 알려줌
+func (s *CampaignServer) ImportCoupons(ctx context.Context, stream *connect.ClientStream[v1.ImportCouponsReq]) (*connect.Response[v1.ImportCouponsRes], error) {
+	campaignRes := &v1.ImportCouponsRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+	}
+
+	var (
+		campaignId, pattern string
+		dryRun              bool
+		rows                []cache.ImportRow
+	)
+	for first := true; stream.Receive(); first = false {
+		msg := stream.Msg()
+		if first {
+			campaignId, pattern, dryRun = msg.CampaignId, msg.Pattern, msg.DryRun
+		} else if msg.CampaignId != "" && msg.CampaignId != campaignId {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("campaignId must not change within a stream"))
+		}
+
+		for _, row := range msg.Rows {
+			line := row.Line
+			if line == 0 {
+				line = int64(len(rows) + 1)
+			}
+			rows = append(rows, cache.ImportRow{Line: line, Code: row.Code})
+		}
+		if len(rows) > maxImportRows {
+			return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("too many rows (max %d)", maxImportRows))
+		}
+	}
+	if err := stream.Err(); err != nil {
+		return nil, err
+	}
+	logging.Set(ctx, "campaignId", campaignId)
+
+	var re *regexp.Regexp
+	if pattern != "" {
+		var err error
+		if re, err = regexp.Compile(pattern); err != nil {
+			logging.Set(ctx, "result", "invalid_pattern")
+			campaignRes.Result.Success = false
+			campaignRes.Result.Message = err.Error()
+			campaignRes.Result.ErrorCode = "invalid_pattern"
+			return connect.NewResponse(campaignRes), connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	result, err := cache.Manager.ImportCoupons(ctx, tenant.FromContext(ctx), campaignId, rows, re, dryRun)
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	if err != nil {
+		campaignRes.Result.Success = false
+		campaignRes.Result.Message = err.Error()
+		campaignRes.Result.ErrorCode = cache.ErrorReason(err)
+		return connect.NewResponse(campaignRes), nil
+	}
+
+	campaignRes.Imported = result.Imported
+	campaignRes.MaxCoupon = result.MaxCoupon
+	for _, r := range result.Rejected {
+		campaignRes.Rejected = append(campaignRes.Rejected, &v1.RejectedCoupon{Line: r.Line, Code: r.Code, Reason: r.Reason, Detail: r.Detail})
+	}
+	logging.Set(ctx, "imported", strconv.FormatInt(result.Imported, 10))
+	logging.Set(ctx, "rejected", strconv.Itoa(len(result.Rejected)))
+
+	return connect.NewResponse(campaignRes), nil
+}
+
+// exportedCoupon : ExportCampaign 한줄, csv 컬럼 순서와 같음
+type exportedCoupon struct {
+	CouponCode string `json:"couponCode"`
+	State      string `json:"state"`
+	UserId     string `json:"userId"`
+	Tier       string `json:"tier"`
+	IssuedAt   string `json:"issuedAt"`
+	RedeemedAt string `json:"redeemedAt"`
+}
+
+var exportedCouponHeader = []string{"couponCode", "state", "userId", "tier", "issuedAt", "redeemedAt"}
+
+// ExportCampaign : exportChunkSize 만큼 모이면 보내서 큰 캠페인도 메모리에 한번에 올리지 않음
+func (s *CampaignServer) ExportCampaign(ctx context.Context, req *connect.Request[v1.ExportCampaignReq], stream *connect.ServerStream[v1.ExportCampaignRes]) error {
+	logging.Set(ctx, "campaignId", req.Msg.CampaignId)
+
+	filter, err := exportFilter(req.Msg)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var buf bytes.Buffer
+	var write func(c exportedCoupon) error
+	switch req.Msg.Format {
+	case "", "csv":
+		w := csv.NewWriter(&buf)
+		w.Write(exportedCouponHeader)
+		write = func(c exportedCoupon) error {
+			w.Write([]string{c.CouponCode, c.State, c.UserId, c.Tier, c.IssuedAt, c.RedeemedAt})
+			w.Flush()
+			return w.Error()
+		}
+		w.Flush()
+	case "jsonl":
+		encoder := json.NewEncoder(&buf)
+		write = func(c exportedCoupon) error {
+			return encoder.Encode(c)
+		}
+	default:
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%w: format must be csv or jsonl", cache.ErrInvalidExportFilter))
+	}
+
+	count := 0
+	err = cache.Manager.ExportCoupons(ctx, tenant.FromContext(ctx), req.Msg.CampaignId, filter, func(coupon models.Coupon) error {
+		count++
+		err := write(exportedCoupon{
+			CouponCode: coupon.CouponId,
+			State:      string(coupon.State),
+			UserId:     coupon.UserId,
+			Tier:       coupon.Tier,
+			IssuedAt:   formatReleaseTime(coupon.IssuedAt),
+			RedeemedAt: formatReleaseTime(coupon.RedeemedAt),
+		})
+		if err != nil || buf.Len() < exportChunkSize {
+			return err
+		}
+		if err := stream.Send(&v1.ExportCampaignRes{Data: buf.Bytes()}); err != nil {
+			return err
+		}
+		buf.Reset()
+		return nil
+	})
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	logging.Set(ctx, "exported", strconv.Itoa(count))
+	if errors.Is(err, cache.ErrCampaignNotExists) {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	if buf.Len() > 0 {
+		return stream.Send(&v1.ExportCampaignRes{Data: buf.Bytes()})
+	}
+	return nil
+}
+
+func exportFilter(m *v1.ExportCampaignReq) (cache.ExportFilter, error) {
+	filter := cache.ExportFilter{TimeField: m.TimeField}
+	for _, state := range m.States {
+		filter.States = append(filter.States, models.CouponState(state))
+	}
+
+	var err error
+	if m.From != "" {
+		if filter.From, err = parseScheduleTime(m.From); err != nil {
+			return filter, fmt.Errorf("%w: from must be RFC3339 or yyyy-mm-dd hh:mm", cache.ErrInvalidExportFilter)
+		}
+	}
+	if m.To != "" {
+		if filter.To, err = parseScheduleTime(m.To); err != nil {
+			return filter, fmt.Errorf("%w: to must be RFC3339 or yyyy-mm-dd hh:mm", cache.ErrInvalidExportFilter)
+		}
+	}
+	err = filter.Validate()
+	return filter, err
+}
+
+func benefitFromMessage(m *v1.Benefit) *cache.Benefit {
+	if m == nil {
+		return nil
+	}
+	return &cache.Benefit{
+		Type:              m.Type,
+		PercentOff:        int(m.PercentOff),
+		AmountOff:         m.AmountOff,
+		Currency:          m.Currency,
+		BuyQuantity:       int(m.BuyQuantity),
+		GetQuantity:       int(m.GetQuantity),
+		MinOrderAmount:    m.MinOrderAmount,
+		MaxDiscountAmount: m.MaxDiscountAmount,
+	}
+}
+
+func benefitMessage(b *cache.Benefit) *v1.Benefit {
+	if b == nil {
+		return nil
+	}
+	return &v1.Benefit{
+		Type:              b.Type,
+		PercentOff:        int32(b.PercentOff),
+		AmountOff:         b.AmountOff,
+		Currency:          b.Currency,
+		BuyQuantity:       int32(b.BuyQuantity),
+		GetQuantity:       int32(b.GetQuantity),
+		MinOrderAmount:    b.MinOrderAmount,
+		MaxDiscountAmount: b.MaxDiscountAmount,
+	}
+}
+
+func rulesFromMessage(m []*v1.Rule) []cache.Rule {
+	rules := make([]cache.Rule, 0, len(m))
+	for _, r := range m {
+		rules = append(rules, cache.Rule{
+			Name:      r.Name,
+			Attribute: r.Attribute,
+			Op:        r.Op,
+			Values:    r.Values,
+		})
+	}
+	return rules
+}
+
+func ruleMessages(rules []cache.Rule) []*v1.Rule {
+	ret := make([]*v1.Rule, 0, len(rules))
+	for _, r := range rules {
+		ret = append(ret, &v1.Rule{
+			Name:      r.Name,
+			Attribute: r.Attribute,
+			Op:        r.Op,
+			Values:    r.Values,
+		})
+	}
+	return ret
+}
+
+// parseScheduleTime : 발급 일정, 추첨 시각은 RFC3339 또는 yyyy-mm-dd hh:mm (서버 local time)
+func parseScheduleTime(value string) (time.Time, error) {
+	if at, err := time.Parse(time.RFC3339, value); err == nil {
+		return at, nil
+	}
+	return time.ParseInLocation("2006-01-02 15:04", value, time.Local)
+}
+
+func scheduleFromMessage(m *v1.Schedule) (*cache.Schedule, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	schedule := &cache.Schedule{
+		RateLimit:  int(m.RateLimit),
+		RatePeriod: time.Duration(m.RatePeriodSeconds) * time.Second,
+	}
+
+	for i, w := range m.Waves {
+		at, err := parseScheduleTime(w.At)
+		if err != nil {
+			return nil, fmt.Errorf("%w: waves[%d]: at must be RFC3339 or yyyy-mm-dd hh:mm", cache.ErrInvalidSchedule, i)
+		}
+		schedule.Waves = append(schedule.Waves, cache.Wave{At: at, Quota: w.Quota})
+	}
+
+	for _, w := range m.Windows {
+		schedule.Windows = append(schedule.Windows, cache.Window{Start: w.Start, End: w.End})
+	}
+
+	return schedule, nil
+}
+
+func scheduleMessage(s *cache.Schedule) *v1.Schedule {
+	if s == nil {
+		return nil
+	}
+
+	ret := &v1.Schedule{
+		RateLimit:         int32(s.RateLimit),
+		RatePeriodSeconds: int32(s.RatePeriod / time.Second),
+	}
+	for _, w := range s.Waves {
+		ret.Waves = append(ret.Waves, &v1.Wave{At: w.At.Format(time.RFC3339), Quota: w.Quota})
+	}
+	for _, w := range s.Windows {
+		ret.Windows = append(ret.Windows, &v1.Window{Start: w.Start, End: w.End})
+	}
+	return ret
+}
+
+// formatReleaseTime : 다음 발급 시각이 없으면 빈 값
+func formatReleaseTime(at time.Time) string {
+	if at.IsZero() {
+		return ""
+	}
+	return at.Format(time.RFC3339)
+}
+
+func raffleFromMessage(m *v1.Raffle) (*cache.Raffle, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	raffle := &cache.Raffle{ClaimTTL: time.Duration(m.ClaimTtlSeconds) * time.Second}
+	fields := []struct {
+		name  string
+		value string
+		dst   *time.Time
+	}{
+		{"registrationStart", m.RegistrationStart, &raffle.RegistrationStart},
+		{"registrationEnd", m.RegistrationEnd, &raffle.RegistrationEnd},
+		{"drawAt", m.DrawAt, &raffle.DrawAt},
+	}
+	for _, f := range fields {
+		at, err := parseScheduleTime(f.value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s must be RFC3339 or yyyy-mm-dd hh:mm", cache.ErrInvalidRaffle, f.name)
+		}
+		*f.dst = at
+	}
+
+	return raffle, nil
+}
+
+func raffleMessage(r *cache.Raffle) *v1.Raffle {
+	if r == nil {
+		return nil
+	}
+	return &v1.Raffle{
+		RegistrationStart: r.RegistrationStart.Format(time.RFC3339),
+		RegistrationEnd:   r.RegistrationEnd.Format(time.RFC3339),
+		DrawAt:            r.DrawAt.Format(time.RFC3339),
+		ClaimTtlSeconds:   int32(r.ClaimTTL / time.Second),
+	}
+}
+
+func tiersFromMessage(m []*v1.Tier) ([]cache.Tier, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+
+	tiers := make([]cache.Tier, 0, len(m))
+	for i, t := range m {
+		startAt, err := parseScheduleTime(t.StartAt)
+		if err != nil {
+			return nil, fmt.Errorf("%w: tiers[%d]: startAt must be RFC3339 or yyyy-mm-dd hh:mm", cache.ErrInvalidTier, i)
+		}
+
+		var releaseAt time.Time
+		if t.ReleaseAt != "" {
+			if releaseAt, err = parseScheduleTime(t.ReleaseAt); err != nil {
+				return nil, fmt.Errorf("%w: tiers[%d]: releaseAt must be RFC3339 or yyyy-mm-dd hh:mm", cache.ErrInvalidTier, i)
+			}
+		}
+
+		tiers = append(tiers, cache.Tier{
+			Name:      t.Name,
+			StartAt:   startAt,
+			Quota:     t.Quota,
+			ReleaseAt: releaseAt,
+			UserIds:   t.UserIds,
+		})
+	}
+	return tiers, nil
+}
+
+// tierMessages : reserved 가 nil 이면 남은 예약 수량은 채우지 않음
+func tierMessages(tiers []cache.Tier, reserved map[string]int64) []*v1.Tier {
+	ret := make([]*v1.Tier, 0, len(tiers))
+	for _, t := range tiers {
+		ret = append(ret, &v1.Tier{
+			Name:      t.Name,
+			StartAt:   t.StartAt.Format(time.RFC3339),
+			Quota:     t.Quota,
+			ReleaseAt: formatReleaseTime(t.ReleaseAt),
+			UserIds:   t.UserIds,
+			Reserved:  reserved[t.Name],
+		})
+	}
+	return ret
+}
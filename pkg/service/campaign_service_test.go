@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/auth"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/cache"
+	v1 "github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1"
+)
+
+// newTestManager : 전역 cache.Manager 를 새로 만들고 테스트가 끝나면 되돌림
+func newTestManager(t *testing.T) {
+	t.Helper()
+
+	prev := cache.Manager
+	cache.Manager = cache.NewCampaignManager()
+	t.Cleanup(func() { cache.Manager = prev })
+}
+
+func TestGetCampaignRedactsForClient(t *testing.T) {
+	newTestManager(t)
+	now := time.Now()
+	opts := cache.CampaignOptions{
+		Rules: []cache.Rule{{Name: "vip", Attribute: "grade", Op: cache.RuleIn, Values: []string{"gold", "vip"}}},
+		Tiers: []cache.Tier{{Name: "gold", StartAt: now.Add(-time.Hour), Quota: 1, UserIds: []string{"u1", "u2"}}},
+	}
+	if err := cache.Manager.CreateCampaign(context.Background(), cache.DefaultTenant, "spring", now.Add(-time.Minute), now.Add(time.Hour), 3, opts); err != nil {
+		t.Fatalf("CreateCampaign: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		full      bool // 쿠폰 코드, 발급 조건 값, tier 멤버까지 내려가는지
+	}{
+		{name: "auth disabled", full: true},
+		{name: "admin", principal: &auth.Principal{Subject: "ops", Role: auth.RoleAdmin}, full: true},
+		{name: "client", principal: &auth.Principal{Subject: "u1", Role: auth.RoleClient, Method: "jwt"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = auth.WithPrincipal(ctx, tt.principal)
+			}
+
+			res, err := NewCampaignServer().GetCampaign(ctx, connect.NewRequest(&v1.GetCampaignReq{CampaignId: "spring"}))
+			if err != nil || !res.Msg.Result.Success {
+				t.Fatalf("GetCampaign: %v %+v", err, res.Msg.Result)
+			}
+			info := res.Msg.Info
+			if info.CouponCount != 3 || (len(info.AllCouponIds) == 3) != tt.full {
+				t.Errorf("AllCouponIds = %d, CouponCount = %d, full %v", len(info.AllCouponIds), info.CouponCount, tt.full)
+			}
+			if len(info.Rules) != 1 || info.Rules[0].Name != "vip" || (len(info.Rules[0].Values) == 2) != tt.full {
+				t.Errorf("Rules = %v, full %v", info.Rules, tt.full)
+			}
+			if len(info.Tiers) != 1 || info.Tiers[0].Name != "gold" || info.Tiers[0].Reserved != 1 || (len(info.Tiers[0].UserIds) == 2) != tt.full {
+				t.Errorf("Tiers = %v, full %v", info.Tiers, tt.full)
+			}
+		})
+	}
+}
@@ -2,8 +2,14 @@ package service
 
 import (
 	"context"
+	"errors"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/auth"
 	"github.com/dev-jiemu/coupon-issuance-poc/pkg/cache"
-	"log"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/logging"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/metrics"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/tenant"
+	"strconv"
+	"time"
 
 	"connectrpc.com/connect"
 	v1 "github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1"
@@ -18,8 +24,10 @@ func NewCouponServer() v1connect.CouponServiceHandler {
 }
 
 // IssueCoupon implements the IssueCoupon RPC
-func (s *CouponServer) IssueCoupon(context context.Context, req *connect.Request[v1.IssueCouponReq]) (*connect.Response[v1.IssueCouponRes], error) {
-	log.Printf("IssueCoupon called with campaignId: %s \n", req.Msg.CampaignId)
+// 요청 결과는 logging interceptor 가 남기는 한줄에 result, couponCode 로 같이 찍힘
+func (s *CouponServer) IssueCoupon(ctx context.Context, req *connect.Request[v1.IssueCouponReq]) (*connect.Response[v1.IssueCouponRes], error) {
+	userId := auth.ResolveUserId(ctx, req.Msg.UserId)
+	logging.Set(ctx, "userId", userId)
 
 	couponRes := &v1.IssueCouponRes{
 		Result: &v1.BaseResponse{
@@ -29,15 +37,340 @@ func (s *CouponServer) IssueCoupon(context context.Context, req *connect.Request
 	}
 
 	// 쿠폰 발행 요청
-	coupon, err := cache.Manager.PublishCoupon(req.Msg.CampaignId)
+	coupon, err := cache.Manager.PublishCoupon(ctx, tenant.FromContext(ctx), req.Msg.CampaignId, userId, cache.IssueOptions{
+		Attributes:   req.Msg.Attributes,
+		Tiers:        auth.ResolveTiers(ctx),
+		JoinWaitlist: req.Msg.JoinWaitlist,
+	})
+	metrics.ObserveIssue(err)
+	logging.Set(ctx, "result", cache.ErrorReason(err))
 	if err != nil {
-		log.Printf("IssueCoupon failed with error: %v \n", err)
 		couponRes.Result.Success = false
 		couponRes.Result.Message = err.Error()
+		couponRes.Result.ErrorCode = cache.ErrorReason(err)
+
+		var notEligible *cache.EligibilityError
+		if errors.As(err, &notEligible) {
+			couponRes.FailedRule = notEligible.Rule.Name
+			logging.Set(ctx, "failedRule", notEligible.Rule.Name)
+		}
+
+		var scheduled *cache.ScheduleError
+		if errors.As(err, &scheduled) {
+			couponRes.NextReleaseAt = formatReleaseTime(scheduled.NextRelease)
+		}
+
+		var waitlisted *cache.WaitlistError
+		if errors.As(err, &waitlisted) {
+			couponRes.WaitlistPosition = int32(waitlisted.Position)
+			logging.Set(ctx, "waitlistPosition", strconv.Itoa(waitlisted.Position))
+		}
 	} else {
 		couponRes.CouponCode = coupon.CouponId
+		couponRes.Tier = coupon.Tier
+		logging.Set(ctx, "couponCode", coupon.CouponId)
+	}
+
+	return connect.NewResponse(couponRes), nil
+}
+
+// EvaluateEligibility implements the EvaluateEligibility RPC : 쿠폰은 발급하지 않고 발급 조건만 평가함
+func (s *CouponServer) EvaluateEligibility(ctx context.Context, req *connect.Request[v1.EvaluateEligibilityReq]) (*connect.Response[v1.EvaluateEligibilityRes], error) {
+	userId := auth.ResolveUserId(ctx, req.Msg.UserId)
+	logging.Set(ctx, "userId", userId)
+
+	couponRes := &v1.EvaluateEligibilityRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+		Eligible: true,
+	}
+
+	results, err := cache.Manager.EvaluateEligibility(tenant.FromContext(ctx), req.Msg.CampaignId, userId, req.Msg.Attributes)
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	if err != nil {
+		couponRes.Result.Success = false
+		couponRes.Result.Message = err.Error()
+		couponRes.Result.ErrorCode = cache.ErrorReason(err)
+		couponRes.Eligible = false
+		return connect.NewResponse(couponRes), nil
+	}
+
+	for _, result := range results {
+		couponRes.Rules = append(couponRes.Rules, &v1.RuleResult{
+			Name:   result.Rule.Name,
+			Passed: result.Passed,
+			Reason: result.Reason,
+		})
+		if !result.Passed && couponRes.Eligible {
+			couponRes.Eligible = false
+			couponRes.FailedRule = result.Rule.Name
+		}
+	}
+	logging.Set(ctx, "eligible", strconv.FormatBool(couponRes.Eligible))
+
+	return connect.NewResponse(couponRes), nil
+}
+
+// GetWaitlistPosition implements the GetWaitlistPosition RPC
+func (s *CouponServer) GetWaitlistPosition(ctx context.Context, req *connect.Request[v1.GetWaitlistPositionReq]) (*connect.Response[v1.GetWaitlistPositionRes], error) {
+	userId := auth.ResolveUserId(ctx, req.Msg.UserId)
+	logging.Set(ctx, "userId", userId)
+
+	couponRes := &v1.GetWaitlistPositionRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+	}
+
+	status, err := cache.Manager.GetWaitlistStatus(tenant.FromContext(ctx), req.Msg.CampaignId, userId)
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	if err != nil {
+		couponRes.Result.Success = false
+		couponRes.Result.Message = err.Error()
+		couponRes.Result.ErrorCode = cache.ErrorReason(err)
+		return connect.NewResponse(couponRes), nil
 	}
 
-	log.Printf("IssueCoupon result: %v \n", couponRes)
+	couponRes.Position = int32(status.Position)
+	couponRes.Size = int32(status.Size)
+	couponRes.JoinedAt = status.JoinedAt.Format(time.RFC3339)
+
 	return connect.NewResponse(couponRes), nil
 }
+
+// LeaveWaitlist implements the LeaveWaitlist RPC
+func (s *CouponServer) LeaveWaitlist(ctx context.Context, req *connect.Request[v1.LeaveWaitlistReq]) (*connect.Response[v1.LeaveWaitlistRes], error) {
+	userId := auth.ResolveUserId(ctx, req.Msg.UserId)
+	logging.Set(ctx, "userId", userId)
+
+	couponRes := &v1.LeaveWaitlistRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+	}
+
+	err := cache.Manager.LeaveWaitlist(ctx, tenant.FromContext(ctx), req.Msg.CampaignId, userId)
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	if err != nil {
+		couponRes.Result.Success = false
+		couponRes.Result.Message = err.Error()
+		couponRes.Result.ErrorCode = cache.ErrorReason(err)
+	}
+
+	return connect.NewResponse(couponRes), nil
+}
+
+// RedeemCoupon implements the RedeemCoupon RPC
+func (s *CouponServer) RedeemCoupon(ctx context.Context, req *connect.Request[v1.RedeemCouponReq]) (*connect.Response[v1.RedeemCouponRes], error) {
+	userId := auth.ResolveUserId(ctx, req.Msg.UserId)
+	logging.Set(ctx, "userId", userId)
+	logging.Set(ctx, "couponCode", req.Msg.CouponCode)
+
+	couponRes := &v1.RedeemCouponRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+	}
+
+	discount, err := cache.Manager.UseCoupon(ctx, tenant.FromContext(ctx), req.Msg.CampaignId, req.Msg.CouponCode, userId, req.Msg.ReservationId, cartFromMessage(req.Msg.Cart))
+	metrics.ObserveRedeem(err)
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	if err != nil {
+		couponRes.Result.Success = false
+		couponRes.Result.Message = err.Error()
+		couponRes.Result.ErrorCode = cache.ErrorReason(err)
+	} else {
+		couponRes.Discount = discountMessage(discount)
+	}
+
+	return connect.NewResponse(couponRes), nil
+}
+
+// QuoteDiscount implements the QuoteDiscount RPC
+func (s *CouponServer) QuoteDiscount(ctx context.Context, req *connect.Request[v1.QuoteDiscountReq]) (*connect.Response[v1.QuoteDiscountRes], error) {
+	userId := auth.ResolveUserId(ctx, req.Msg.UserId)
+	logging.Set(ctx, "userId", userId)
+	logging.Set(ctx, "couponCode", req.Msg.CouponCode)
+
+	couponRes := &v1.QuoteDiscountRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+	}
+
+	cart := cartFromMessage(req.Msg.Cart)
+	if cart == nil {
+		cart = &cache.Cart{}
+	}
+
+	discount, err := cache.Manager.QuoteDiscount(ctx, tenant.FromContext(ctx), req.Msg.CampaignId, req.Msg.CouponCode, userId, req.Msg.ReservationId, cart)
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	if err != nil {
+		couponRes.Result.Success = false
+		couponRes.Result.Message = err.Error()
+		couponRes.Result.ErrorCode = cache.ErrorReason(err)
+	} else {
+		couponRes.Discount = discountMessage(discount)
+	}
+
+	return connect.NewResponse(couponRes), nil
+}
+
+// RevokeCoupon implements the RevokeCoupon RPC : admin 전용
+func (s *CouponServer) RevokeCoupon(ctx context.Context, req *connect.Request[v1.RevokeCouponReq]) (*connect.Response[v1.RevokeCouponRes], error) {
+	logging.Set(ctx, "couponCode", req.Msg.CouponCode)
+
+	couponRes := &v1.RevokeCouponRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+	}
+
+	coupon, err := cache.Manager.RevokeCoupon(ctx, tenant.FromContext(ctx), req.Msg.CampaignId, req.Msg.CouponCode, req.Msg.ReturnToPool, req.Msg.Reason)
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	if err != nil {
+		couponRes.Result.Success = false
+		couponRes.Result.Message = err.Error()
+		couponRes.Result.ErrorCode = cache.ErrorReason(err)
+	} else {
+		couponRes.State = string(coupon.State)
+	}
+
+	return connect.NewResponse(couponRes), nil
+}
+
+// UnredeemCoupon implements the UnredeemCoupon RPC : admin 전용
+func (s *CouponServer) UnredeemCoupon(ctx context.Context, req *connect.Request[v1.UnredeemCouponReq]) (*connect.Response[v1.UnredeemCouponRes], error) {
+	logging.Set(ctx, "couponCode", req.Msg.CouponCode)
+
+	couponRes := &v1.UnredeemCouponRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+	}
+
+	err := cache.Manager.UnredeemCoupon(ctx, tenant.FromContext(ctx), req.Msg.CampaignId, req.Msg.CouponCode, req.Msg.Reason)
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	if err != nil {
+		couponRes.Result.Success = false
+		couponRes.Result.Message = err.Error()
+		couponRes.Result.ErrorCode = cache.ErrorReason(err)
+	}
+
+	return connect.NewResponse(couponRes), nil
+}
+
+// ReserveCoupon implements the ReserveCoupon RPC
+func (s *CouponServer) ReserveCoupon(ctx context.Context, req *connect.Request[v1.ReserveCouponReq]) (*connect.Response[v1.ReserveCouponRes], error) {
+	userId := auth.ResolveUserId(ctx, req.Msg.UserId)
+	logging.Set(ctx, "userId", userId)
+	logging.Set(ctx, "couponCode", req.Msg.CouponCode)
+
+	couponRes := &v1.ReserveCouponRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+	}
+
+	ttl := time.Duration(req.Msg.TtlSeconds) * time.Second
+	coupon, err := cache.Manager.ReserveCoupon(ctx, tenant.FromContext(ctx), req.Msg.CampaignId, req.Msg.CouponCode, userId, ttl)
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	if err != nil {
+		couponRes.Result.Success = false
+		couponRes.Result.Message = err.Error()
+		couponRes.Result.ErrorCode = cache.ErrorReason(err)
+	} else {
+		couponRes.ReservationId = coupon.ReservationId
+		couponRes.HeldUntil = coupon.HeldUntil.Format(time.RFC3339)
+	}
+
+	return connect.NewResponse(couponRes), nil
+}
+
+// ConfirmRedemption implements the ConfirmRedemption RPC
+func (s *CouponServer) ConfirmRedemption(ctx context.Context, req *connect.Request[v1.ConfirmRedemptionReq]) (*connect.Response[v1.ConfirmRedemptionRes], error) {
+	logging.Set(ctx, "couponCode", req.Msg.CouponCode)
+
+	couponRes := &v1.ConfirmRedemptionRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+	}
+
+	discount, err := cache.Manager.ConfirmRedemption(ctx, tenant.FromContext(ctx), req.Msg.CampaignId, req.Msg.CouponCode, req.Msg.ReservationId, cartFromMessage(req.Msg.Cart))
+	metrics.ObserveRedeem(err)
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	if err != nil {
+		couponRes.Result.Success = false
+		couponRes.Result.Message = err.Error()
+		couponRes.Result.ErrorCode = cache.ErrorReason(err)
+	} else {
+		couponRes.Discount = discountMessage(discount)
+	}
+
+	return connect.NewResponse(couponRes), nil
+}
+
+// ReleaseReservation implements the ReleaseReservation RPC
+func (s *CouponServer) ReleaseReservation(ctx context.Context, req *connect.Request[v1.ReleaseReservationReq]) (*connect.Response[v1.ReleaseReservationRes], error) {
+	logging.Set(ctx, "couponCode", req.Msg.CouponCode)
+
+	couponRes := &v1.ReleaseReservationRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+	}
+
+	err := cache.Manager.ReleaseReservation(ctx, tenant.FromContext(ctx), req.Msg.CampaignId, req.Msg.CouponCode, req.Msg.ReservationId)
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	if err != nil {
+		couponRes.Result.Success = false
+		couponRes.Result.Message = err.Error()
+		couponRes.Result.ErrorCode = cache.ErrorReason(err)
+	}
+
+	return connect.NewResponse(couponRes), nil
+}
+
+func cartFromMessage(m *v1.Cart) *cache.Cart {
+	if m == nil {
+		return nil
+	}
+
+	cart := &cache.Cart{
+		OrderAmount:    m.OrderAmount,
+		ShippingAmount: m.ShippingAmount,
+		Currency:       m.Currency,
+	}
+	for _, item := range m.Items {
+		cart.Items = append(cart.Items, cache.CartItem{
+			Sku:       item.Sku,
+			UnitPrice: item.UnitPrice,
+			Quantity:  int(item.Quantity),
+		})
+	}
+	return cart
+}
+
+func discountMessage(d *cache.Discount) *v1.Discount {
+	if d == nil {
+		return nil
+	}
+	return &v1.Discount{
+		Amount:       d.Amount,
+		Currency:     d.Currency,
+		FreeShipping: d.FreeShipping,
+		FinalAmount:  d.FinalAmount,
+	}
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/auth"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/cache"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/logging"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/tenant"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1/v1connect"
+)
+
+type RaffleServer struct{}
+
+// NewRaffleServer creates a new raffle server
+func NewRaffleServer() v1connect.RaffleServiceHandler {
+	return &RaffleServer{}
+}
+
+// EnterDraw implements the EnterDraw RPC
+func (s *RaffleServer) EnterDraw(ctx context.Context, req *connect.Request[v1.EnterDrawReq]) (*connect.Response[v1.EnterDrawRes], error) {
+	userId := auth.ResolveUserId(ctx, req.Msg.UserId)
+	logging.Set(ctx, "userId", userId)
+
+	raffleRes := &v1.EnterDrawRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+	}
+
+	err := cache.Manager.EnterDraw(ctx, tenant.FromContext(ctx), req.Msg.CampaignId, userId, req.Msg.Attributes)
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	if err != nil {
+		raffleRes.Result.Success = false
+		raffleRes.Result.Message = err.Error()
+		raffleRes.Result.ErrorCode = cache.ErrorReason(err)
+
+		var notEligible *cache.EligibilityError
+		if errors.As(err, &notEligible) {
+			raffleRes.FailedRule = notEligible.Rule.Name
+			logging.Set(ctx, "failedRule", notEligible.Rule.Name)
+		}
+	}
+
+	return connect.NewResponse(raffleRes), nil
+}
+
+// GetDrawResult implements the GetDrawResult RPC
+func (s *RaffleServer) GetDrawResult(ctx context.Context, req *connect.Request[v1.GetDrawResultReq]) (*connect.Response[v1.GetDrawResultRes], error) {
+	userId := auth.ResolveUserId(ctx, req.Msg.UserId)
+	logging.Set(ctx, "userId", userId)
+
+	raffleRes := &v1.GetDrawResultRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+	}
+
+	result, err := cache.Manager.GetDrawResult(tenant.FromContext(ctx), req.Msg.CampaignId, userId)
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	if err != nil {
+		raffleRes.Result.Success = false
+		raffleRes.Result.Message = err.Error()
+		raffleRes.Result.ErrorCode = cache.ErrorReason(err)
+		return connect.NewResponse(raffleRes), nil
+	}
+
+	raffleRes.SeedCommitment = result.SeedCommitment
+	raffleRes.Seed = result.Seed
+	raffleRes.DrawnAt = formatReleaseTime(result.DrawnAt)
+	raffleRes.Entrants = int32(result.Entrants)
+	raffleRes.Winners = int32(result.Winners)
+	raffleRes.Waitlist = int32(result.Waitlist)
+	raffleRes.Status = result.Status
+	raffleRes.CouponCode = result.CouponCode
+	raffleRes.Rank = int32(result.Rank)
+	raffleRes.WaitlistPosition = int32(result.WaitlistPosition)
+
+	return connect.NewResponse(raffleRes), nil
+}
+
+// ExportDraw implements the ExportDraw RPC : 누구나 추첨 결과를 검증할 수 있도록 client 도 호출 가능
+// admin 이 아니면 추첨이 끝난 뒤에만 내려가고 당첨자의 쿠폰 코드는 빠짐
+func (s *RaffleServer) ExportDraw(ctx context.Context, req *connect.Request[v1.ExportDrawReq]) (*connect.Response[v1.ExportDrawRes], error) {
+	raffleRes := &v1.ExportDrawRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+	}
+
+	principal := auth.FromContext(ctx)
+	public := principal != nil && !principal.IsAdmin()
+
+	record, err := cache.Manager.ExportDraw(tenant.FromContext(ctx), req.Msg.CampaignId, public)
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	if err != nil {
+		raffleRes.Result.Success = false
+		raffleRes.Result.Message = err.Error()
+		raffleRes.Result.ErrorCode = cache.ErrorReason(err)
+		return connect.NewResponse(raffleRes), nil
+	}
+
+	raffleRes.Algorithm = record.Algorithm
+	raffleRes.SeedCommitment = record.SeedCommitment
+	raffleRes.Seed = record.Seed
+	raffleRes.DrawnAt = formatReleaseTime(record.DrawnAt)
+	raffleRes.Coupons = int32(record.Coupons)
+	raffleRes.Ranking = record.Ranking
+
+	for _, entrant := range record.Entrants {
+		raffleRes.Entrants = append(raffleRes.Entrants, &v1.DrawEntrant{
+			UserId:    entrant.UserId,
+			EnteredAt: entrant.EnteredAt.Format(time.RFC3339Nano),
+		})
+	}
+	for _, winner := range record.Winners {
+		raffleRes.Winners = append(raffleRes.Winners, &v1.DrawWinner{
+			UserId:     winner.UserId,
+			CouponCode: winner.CouponCode,
+			Rank:       int32(winner.Rank),
+			IssuedAt:   winner.IssuedAt.Format(time.RFC3339),
+			Backfill:   winner.Backfill,
+			Reclaimed:  winner.Reclaimed,
+		})
+	}
+
+	return connect.NewResponse(raffleRes), nil
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/cache"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/logging"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/tenant"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/gen/v1/v1connect"
+)
+
+type WebhookServer struct{}
+
+// NewWebhookServer creates a new webhook server
+func NewWebhookServer() v1connect.WebhookServiceHandler {
+	return &WebhookServer{}
+}
+
+// RegisterWebhook : secret 은 이 응답에서만 내려감
+func (s *WebhookServer) RegisterWebhook(ctx context.Context, req *connect.Request[v1.RegisterWebhookReq]) (*connect.Response[v1.RegisterWebhookRes], error) {
+	webhookRes := &v1.RegisterWebhookRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+	}
+
+	endpoint, err := cache.Manager.RegisterWebhook(tenant.FromContext(ctx), req.Msg.Url, req.Msg.Secret, req.Msg.Events)
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	if err != nil {
+		webhookRes.Result.Success = false
+		webhookRes.Result.Message = err.Error()
+		webhookRes.Result.ErrorCode = cache.ErrorReason(err)
+		return connect.NewResponse(webhookRes), connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	webhookRes.Endpoint = webhookEndpointMessage(endpoint)
+	webhookRes.Endpoint.Secret = endpoint.Secret
+
+	return connect.NewResponse(webhookRes), nil
+}
+
+func (s *WebhookServer) ListWebhooks(ctx context.Context, req *connect.Request[v1.ListWebhooksReq]) (*connect.Response[v1.ListWebhooksRes], error) {
+	webhookRes := &v1.ListWebhooksRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+		Endpoints: make([]*v1.WebhookEndpoint, 0),
+	}
+
+	for _, endpoint := range cache.Manager.ListWebhooks(tenant.FromContext(ctx)) {
+		webhookRes.Endpoints = append(webhookRes.Endpoints, webhookEndpointMessage(endpoint))
+	}
+
+	return connect.NewResponse(webhookRes), nil
+}
+
+func (s *WebhookServer) DeleteWebhook(ctx context.Context, req *connect.Request[v1.DeleteWebhookReq]) (*connect.Response[v1.DeleteWebhookRes], error) {
+	webhookRes := &v1.DeleteWebhookRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+	}
+
+	err := cache.Manager.DeleteWebhook(tenant.FromContext(ctx), req.Msg.Id)
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	if err != nil {
+		webhookRes.Result.Success = false
+		webhookRes.Result.Message = err.Error()
+		webhookRes.Result.ErrorCode = cache.ErrorReason(err)
+	}
+
+	return connect.NewResponse(webhookRes), nil
+}
+
+func (s *WebhookServer) ListDeadLetters(ctx context.Context, req *connect.Request[v1.ListDeadLettersReq]) (*connect.Response[v1.ListDeadLettersRes], error) {
+	webhookRes := &v1.ListDeadLettersRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+		DeadLetters: make([]*v1.DeadLetter, 0),
+	}
+
+	for _, d := range cache.Manager.DeadLetters(tenant.FromContext(ctx)) {
+		deadLetter := &v1.DeadLetter{
+			Id:         d.Id,
+			EndpointId: d.EndpointId,
+			EventId:    d.Event.Id,
+			EventType:  d.Event.Type,
+			CampaignId: d.Event.CampaignId,
+			CouponCode: d.Event.CouponCode,
+			Attempts:   int32(d.Attempts),
+			LastError:  d.LastError,
+		}
+		if d.DeadAt != nil {
+			deadLetter.DeadAt = d.DeadAt.Format(time.RFC3339)
+		}
+		webhookRes.DeadLetters = append(webhookRes.DeadLetters, deadLetter)
+	}
+
+	return connect.NewResponse(webhookRes), nil
+}
+
+// ReplayDeadLetters : 하나라도 없는 id 가 있으면 아무것도 다시 보내지 않음
+func (s *WebhookServer) ReplayDeadLetters(ctx context.Context, req *connect.Request[v1.ReplayDeadLettersReq]) (*connect.Response[v1.ReplayDeadLettersRes], error) {
+	webhookRes := &v1.ReplayDeadLettersRes{
+		Result: &v1.BaseResponse{
+			Success: true,
+			Message: "",
+		},
+	}
+
+	replayed, err := cache.Manager.ReplayDeadLetters(tenant.FromContext(ctx), req.Msg.Ids)
+	logging.Set(ctx, "result", cache.ErrorReason(err))
+	if err != nil {
+		webhookRes.Result.Success = false
+		webhookRes.Result.Message = err.Error()
+		webhookRes.Result.ErrorCode = cache.ErrorReason(err)
+		return connect.NewResponse(webhookRes), connect.NewError(connect.CodeNotFound, err)
+	}
+
+	webhookRes.Replayed = int32(replayed)
+	return connect.NewResponse(webhookRes), nil
+}
+
+func webhookEndpointMessage(endpoint *cache.WebhookEndpoint) *v1.WebhookEndpoint {
+	return &v1.WebhookEndpoint{
+		Id:        endpoint.Id,
+		Url:       endpoint.URL,
+		Events:    endpoint.Events,
+		CreatedAt: endpoint.CreatedAt.Format(time.RFC3339),
+	}
+}
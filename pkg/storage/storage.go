@@ -0,0 +1,85 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/cache"
+)
+
+// Store : CampaignManager 상태를 저장/복구하는 backend
+type Store interface {
+	// Load : 저장된 상태가 없으면 nil, nil
+	Load() (*cache.Snapshot, error)
+	Save(snapshot *cache.Snapshot) error
+}
+
+func New(backend, path string) (Store, error) {
+	switch backend {
+	case "memory":
+		return MemoryStore{}, nil
+	case "file":
+		return &FileStore{Path: path}, nil
+	}
+	return nil, fmt.Errorf("unknown storage backend %q", backend)
+}
+
+// MemoryStore : 아무것도 저장하지 않음, 서버를 내리면 상태가 사라짐
+type MemoryStore struct{}
+
+func (MemoryStore) Load() (*cache.Snapshot, error)      { return nil, nil }
+func (MemoryStore) Save(snapshot *cache.Snapshot) error { return nil }
+
+// FileStore : JSON snapshot 파일 하나에 전체 상태를 저장함
+// 마지막 저장 이후의 변경은 비정상 종료시 유실됨
+type FileStore struct {
+	Path string
+}
+
+func (s *FileStore) Load() (*cache.Snapshot, error) {
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &cache.Snapshot{}
+	if err := json.Unmarshal(data, snapshot); err != nil {
+		return nil, fmt.Errorf("%s: %w", s.Path, err)
+	}
+
+	return snapshot, nil
+}
+
+// Save : 임시 파일에 쓰고 rename 해서 저장 도중 죽어도 이전 snapshot 이 남게 함
+func (s *FileStore) Save(snapshot *cache.Snapshot) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.Path)
+}
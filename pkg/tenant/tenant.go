@@ -0,0 +1,110 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"connectrpc.com/connect"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/auth"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/cache"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/logging"
+)
+
+const Header = "X-Tenant-Id"
+
+var validId = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+var (
+	ErrTenantMismatch = errors.New("tenant does not match credentials")
+	ErrInvalidTenant  = errors.New("invalid tenant id")
+)
+
+type tenantKey struct{}
+
+func WithTenant(ctx context.Context, tenantId string) context.Context {
+	logging.Set(ctx, "tenantId", tenantId)
+	return context.WithValue(ctx, tenantKey{}, tenantId)
+}
+
+// FromContext : interceptor 를 거치지 않은 경우 기본 tenant
+func FromContext(ctx context.Context) string {
+	if tenantId, ok := ctx.Value(tenantKey{}).(string); ok {
+		return tenantId
+	}
+	return cache.DefaultTenant
+}
+
+// Resolve : 인증정보에 tenant 가 있으면 그것만 허용
+// 헤더로 tenant 를 고를 수 있는건 플랫폼 운영자(tenant 없는 admin)나 인증을 끈 경우 뿐이고,
+// tenant 없는 client 인증정보는 기본 tenant 로 고정함
+func Resolve(ctx context.Context, header http.Header) (string, error) {
+	requested := header.Get(Header)
+
+	if p := auth.FromContext(ctx); p != nil {
+		tenantId := p.Tenant
+		if tenantId == "" && !p.IsAdmin() {
+			tenantId = cache.DefaultTenant
+		}
+		if tenantId != "" {
+			if requested != "" && requested != tenantId {
+				return "", ErrTenantMismatch
+			}
+			return tenantId, nil
+		}
+	}
+
+	if requested == "" {
+		return cache.DefaultTenant, nil
+	}
+
+	if !validId.MatchString(requested) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidTenant, requested)
+	}
+
+	return requested, nil
+}
+
+// Interceptor : 인증 interceptor 뒤에 등록해야 인증정보의 tenant 를 사용할 수 있음
+type Interceptor struct{}
+
+func NewInterceptor() *Interceptor {
+	return &Interceptor{}
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+
+		tenantId, err := Resolve(ctx, req.Header())
+		if err != nil {
+			return nil, resolveError(err)
+		}
+		return next(WithTenant(ctx, tenantId), req)
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		tenantId, err := Resolve(ctx, conn.RequestHeader())
+		if err != nil {
+			return resolveError(err)
+		}
+		return next(WithTenant(ctx, tenantId), conn)
+	}
+}
+
+func resolveError(err error) error {
+	if errors.Is(err, ErrInvalidTenant) {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return connect.NewError(connect.CodePermissionDenied, err)
+}
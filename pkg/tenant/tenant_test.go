@@ -0,0 +1,54 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/auth"
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/cache"
+)
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		header    string
+		tenantId  string
+		err       error
+	}{
+		{name: "default", tenantId: cache.DefaultTenant},
+		{name: "header", header: "brandA", tenantId: "brandA"},
+		{name: "invalid header", header: "brand A", err: ErrInvalidTenant},
+		{name: "credential tenant", principal: &auth.Principal{Tenant: "brandA"}, tenantId: "brandA"},
+		{name: "credential tenant with same header", principal: &auth.Principal{Tenant: "brandA"}, header: "brandA", tenantId: "brandA"},
+		{name: "credential tenant with other header", principal: &auth.Principal{Tenant: "brandA"}, header: "brandB", err: ErrTenantMismatch},
+		{name: "platform operator picks tenant", principal: &auth.Principal{Role: auth.RoleAdmin}, header: "brandB", tenantId: "brandB"},
+		{name: "platform operator without header", principal: &auth.Principal{Role: auth.RoleAdmin}, tenantId: cache.DefaultTenant},
+		{name: "client without tenant is pinned to default", principal: &auth.Principal{Role: auth.RoleClient}, tenantId: cache.DefaultTenant},
+		{name: "client without tenant with default header", principal: &auth.Principal{Role: auth.RoleClient}, header: cache.DefaultTenant, tenantId: cache.DefaultTenant},
+		{name: "client without tenant cannot pick tenant", principal: &auth.Principal{Role: auth.RoleClient}, header: "brandB", err: ErrTenantMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = auth.WithPrincipal(ctx, tt.principal)
+			}
+			header := http.Header{}
+			if tt.header != "" {
+				header.Set(Header, tt.header)
+			}
+
+			tenantId, err := Resolve(ctx, header)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if tenantId != tt.tenantId {
+				t.Errorf("tenant = %q, want %q", tenantId, tt.tenantId)
+			}
+		})
+	}
+}
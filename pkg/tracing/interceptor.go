@@ -0,0 +1,86 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Interceptor : handler 에서는 요청 헤더의 trace context 를 이어받아 server span 을 만들고,
+// client 에서는 client span 을 만들어 헤더에 trace context 를 넣음
+// 인증 실패로 거절된 요청도 trace 에 남도록 interceptor 중 맨 앞에 둠
+type Interceptor struct{}
+
+func NewInterceptor() *Interceptor {
+	return &Interceptor{}
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		carrier := propagation.HeaderCarrier(req.Header())
+
+		if req.Spec().IsClient {
+			ctx, span := startSpan(ctx, req.Spec(), trace.SpanKindClient)
+			otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+			res, err := next(ctx, req)
+			endSpan(span, err)
+			return res, err
+		}
+
+		ctx = otel.GetTextMapPropagator().Extract(ctx, carrier)
+		ctx, span := startSpan(ctx, req.Spec(), trace.SpanKindServer)
+		res, err := next(ctx, req)
+		endSpan(span, err)
+		return res, err
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(conn.RequestHeader()))
+		ctx, span := startSpan(ctx, conn.Spec(), trace.SpanKindServer)
+		err := next(ctx, conn)
+		endSpan(span, err)
+		return err
+	}
+}
+
+// startSpan : span 이름은 "v1.CouponService/IssueCoupon" 형식
+func startSpan(ctx context.Context, spec connect.Spec, kind trace.SpanKind) (context.Context, trace.Span) {
+	name := strings.TrimPrefix(spec.Procedure, "/")
+	service, method, _ := strings.Cut(name, "/")
+
+	return Tracer().Start(ctx, name,
+		trace.WithSpanKind(kind),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "connect_rpc"),
+			attribute.String("rpc.service", service),
+			attribute.String("rpc.method", method),
+		),
+	)
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		code := connect.CodeUnknown
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			code = connectErr.Code()
+		}
+		span.SetAttributes(attribute.String("rpc.connect_rpc.error_code", code.String()))
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
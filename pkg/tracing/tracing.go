@@ -0,0 +1,93 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName : 이 repo 에서 만드는 span 의 tracer 이름
+const InstrumentationName = "github.com/dev-jiemu/coupon-issuance-poc"
+
+// Options : Exporter 가 none 이면 span 을 만들지 않음 (no-op)
+type Options struct {
+	ServiceName string
+	Exporter    string  // none, stdout, otlp
+	Endpoint    string  // otlp : host:port, 비어있으면 OTEL_EXPORTER_OTLP_* 환경변수 또는 localhost:4318
+	Insecure    bool    // otlp : TLS 없이 전송
+	SampleRatio float64 // 부모 span 이 없는 요청 중 기록할 비율
+}
+
+// Setup : 전역 TracerProvider 와 W3C trace context propagator 를 설정함
+// 반환된 shutdown 은 종료 시점에 남은 span 을 내보내고 exporter 를 닫음
+func Setup(ctx context.Context, opts Options) (func(context.Context) error, error) {
+	// exporter 가 없어도 client 가 보낸 trace context 는 그대로 넘겨받을 수 있게 propagator 는 항상 설정
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+
+	switch opts.Exporter {
+	case "", "none":
+		return func(context.Context) error { return nil }, nil
+	case "stdout":
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case "otlp":
+		var httpOpts []otlptracehttp.Option
+		if opts.Endpoint != "" {
+			httpOpts = append(httpOpts, otlptracehttp.WithEndpoint(opts.Endpoint))
+		}
+		if opts.Insecure {
+			httpOpts = append(httpOpts, otlptracehttp.WithInsecure())
+		}
+		exporter, err = otlptracehttp.New(ctx, httpOpts...)
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q (none, stdout, otlp)", opts.Exporter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(semconv.ServiceName(opts.ServiceName)))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// Tracer : Setup 전에 호출해도 전역 provider 가 바뀌면 따라감
+func Tracer() trace.Tracer {
+	return otel.Tracer(InstrumentationName)
+}
+
+// Start : 내부 작업용 child span
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, opts...)
+}
+
+// End : err 가 있으면 span 에 기록하고 종료
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
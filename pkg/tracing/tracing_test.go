@@ -0,0 +1,119 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// newRecorder : 전역 provider 를 span recorder 로 바꾸고 테스트가 끝나면 되돌림
+func newRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	prev := otel.GetTracerProvider()
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	if _, err := Setup(context.Background(), Options{Exporter: "none"}); err != nil {
+		t.Fatalf("Setup: %v", err)
+	}
+	return recorder
+}
+
+func attributeOf(span sdktrace.ReadOnlySpan, key attribute.Key) string {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value.Emit()
+		}
+	}
+	return ""
+}
+
+func TestInterceptorPropagatesTraceContext(t *testing.T) {
+	recorder := newRecorder(t)
+
+	const procedure = "/v1.TestService/Echo"
+	mux := http.NewServeMux()
+	mux.Handle(procedure, connect.NewUnaryHandler(procedure,
+		func(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+			if req.Header().Get("fail") != "" {
+				return nil, connect.NewError(connect.CodeNotFound, errors.New("missing"))
+			}
+			return connect.NewResponse(&emptypb.Empty{}), nil
+		},
+		connect.WithInterceptors(NewInterceptor()),
+	))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := connect.NewClient[emptypb.Empty, emptypb.Empty](server.Client(), server.URL+procedure, connect.WithInterceptors(NewInterceptor()))
+	if _, err := client.CallUnary(context.Background(), connect.NewRequest(&emptypb.Empty{})); err != nil {
+		t.Fatalf("CallUnary: %v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("recorded %d spans, want client and server", len(spans))
+	}
+	serverSpan, clientSpan := spans[0], spans[1]
+	if serverSpan.SpanKind() != trace.SpanKindServer || clientSpan.SpanKind() != trace.SpanKindClient {
+		t.Fatalf("span kinds = %v, %v", serverSpan.SpanKind(), clientSpan.SpanKind())
+	}
+	// server span 은 헤더로 받은 client span 의 child
+	if serverSpan.Parent().SpanID() != clientSpan.SpanContext().SpanID() || serverSpan.SpanContext().TraceID() != clientSpan.SpanContext().TraceID() {
+		t.Fatal("server span is not a child of the client span")
+	}
+	if serverSpan.Name() != "v1.TestService/Echo" || attributeOf(serverSpan, "rpc.service") != "v1.TestService" || attributeOf(serverSpan, "rpc.method") != "Echo" {
+		t.Errorf("server span %q, attributes %v", serverSpan.Name(), serverSpan.Attributes())
+	}
+
+	// 실패한 RPC 는 connect code 와 함께 error 로 남음
+	req := connect.NewRequest(&emptypb.Empty{})
+	req.Header().Set("fail", "1")
+	if _, err := client.CallUnary(context.Background(), req); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("CallUnary = %v, want not_found", err)
+	}
+	failed := recorder.Ended()[2]
+	if failed.Status().Code != codes.Error || attributeOf(failed, "rpc.connect_rpc.error_code") != "not_found" {
+		t.Errorf("failed server span status %v, attributes %v", failed.Status(), failed.Attributes())
+	}
+}
+
+func TestSetup(t *testing.T) {
+	shutdown, err := Setup(context.Background(), Options{Exporter: "none"})
+	if err != nil || shutdown(context.Background()) != nil {
+		t.Fatalf("Setup(none) = %v", err)
+	}
+	if _, err := Setup(context.Background(), Options{Exporter: "jaeger"}); err == nil {
+		t.Fatal("Setup accepted unknown exporter")
+	}
+}
+
+func TestEndRecordsError(t *testing.T) {
+	recorder := newRecorder(t)
+
+	_, span := Start(context.Background(), "work")
+	End(span, errors.New("boom"))
+	_, span = Start(context.Background(), "ok")
+	End(span, nil)
+
+	spans := recorder.Ended()
+	if spans[0].Status().Code != codes.Error || len(spans[0].Events()) != 1 {
+		t.Errorf("failed span status %v, events %v", spans[0].Status(), spans[0].Events())
+	}
+	if spans[1].Status().Code != codes.Unset {
+		t.Errorf("ok span status %v", spans[1].Status())
+	}
+}
@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	MinCouponCodeLength = 4
+	MaxCouponCodeLength = 64
+)
+
+// ValidateCouponCode : 외부에서 받은 쿠폰 코드 형식 확인
+// 문자(한글 포함), 숫자, '-', '_' 만 허용함 : 공백이나 구분자가 섞인 코드는 CSV 파싱 오류일 가능성이 큼
+func ValidateCouponCode(code string) error {
+	length := utf8.RuneCountInString(code)
+	if length < MinCouponCodeLength || length > MaxCouponCodeLength {
+		return fmt.Errorf("code length must be %d ~ %d", MinCouponCodeLength, MaxCouponCodeLength)
+	}
+
+	for _, r := range code {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_' {
+			return fmt.Errorf("code contains invalid character %q", r)
+		}
+	}
+	return nil
+}
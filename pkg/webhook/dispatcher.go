@@ -0,0 +1,191 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/cache"
+)
+
+const (
+	SignatureHeader = "X-Coupon-Signature" // t=<unix seconds>,v1=<hex HMAC-SHA256(secret, "<t>.<body>")>
+	EventHeader     = "X-Coupon-Event"
+	DeliveryHeader  = "X-Coupon-Delivery" // 재시도해도 같은 값이라 수신측에서 중복 제거에 사용
+)
+
+// 전송 결과 : metric label 로 사용
+const (
+	ResultOK    = "ok"
+	ResultRetry = "retry"
+	ResultDead  = "dead"
+)
+
+type Options struct {
+	Interval       time.Duration // outbox 확인 주기
+	Timeout        time.Duration // 요청 하나의 timeout
+	MaxAttempts    int           // 이 횟수만큼 실패하면 dead letter
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Concurrency    int // 동시에 보내는 요청 수
+}
+
+// Dispatcher : outbox 에 쌓인 delivery 를 HMAC 서명한 POST 로 보냄
+// 2xx 가 아니면 지수 backoff(+jitter) 로 재시도하고 MaxAttempts 를 넘기면 dead letter 로 옮김
+type Dispatcher struct {
+	manager *cache.CampaignManager
+	opts    Options
+	client  *http.Client
+	observe func(result string)
+}
+
+func NewDispatcher(manager *cache.CampaignManager, opts Options) *Dispatcher {
+	return &Dispatcher{
+		manager: manager,
+		opts:    opts,
+		client:  &http.Client{Timeout: opts.Timeout},
+	}
+}
+
+// SetObserver : 전송 결과를 받음 (metric 용), Run 전에 설정
+func (d *Dispatcher) SetObserver(observe func(result string)) {
+	d.observe = observe
+}
+
+// Run : ctx 가 끝날때까지 interval 마다 보낼 delivery 를 꺼내서 전송
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			d.dispatch(ctx, now)
+		}
+	}
+}
+
+// dispatch : 한번에 Concurrency*4 건씩 꺼내서, 밀린 delivery 가 없을때까지 이어서 보냄
+// 실패한 delivery 는 now 이후로 다시 예약되기 때문에 같은 tick 에서 다시 꺼내지 않음
+func (d *Dispatcher) dispatch(ctx context.Context, now time.Time) {
+	batch := d.opts.Concurrency * 4
+	for ctx.Err() == nil {
+		deliveries, endpoints := d.manager.DueDeliveries(now, batch)
+		d.sendBatch(ctx, deliveries, endpoints)
+		if len(deliveries) < batch {
+			return
+		}
+	}
+}
+
+func (d *Dispatcher) sendBatch(ctx context.Context, deliveries []cache.Delivery, endpoints map[string]cache.WebhookEndpoint) {
+	if len(deliveries) == 0 {
+		return
+	}
+
+	sem := make(chan struct{}, d.opts.Concurrency)
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(delivery cache.Delivery) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			d.deliver(ctx, delivery, endpoints[delivery.EndpointId])
+		}(delivery)
+	}
+	wg.Wait()
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, delivery cache.Delivery, endpoint cache.WebhookEndpoint) {
+	err := d.send(ctx, delivery, endpoint)
+	if err == nil {
+		d.manager.CompleteDelivery(delivery.Id)
+		d.result(ResultOK)
+		return
+	}
+
+	attempts := delivery.Attempts + 1
+	if attempts >= d.opts.MaxAttempts {
+		d.manager.FailDelivery(delivery.Id, err, time.Time{})
+		d.result(ResultDead)
+		slog.Warn("webhook delivery moved to dead letter", "deliveryId", delivery.Id, "endpointId", endpoint.Id,
+			"event", delivery.Event.Type, "attempts", attempts, "error", err)
+		return
+	}
+
+	d.manager.FailDelivery(delivery.Id, err, time.Now().Add(d.backoff(attempts)))
+	d.result(ResultRetry)
+	slog.Debug("webhook delivery failed, retry later", "deliveryId", delivery.Id, "endpointId", endpoint.Id, "attempts", attempts, "error", err)
+}
+
+func (d *Dispatcher) send(ctx context.Context, delivery cache.Delivery, endpoint cache.WebhookEndpoint) error {
+	body, err := json.Marshal(delivery.Event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, delivery.Event.Type)
+	req.Header.Set(DeliveryHeader, strconv.FormatInt(delivery.Id, 10))
+	req.Header.Set(SignatureHeader, Sign(endpoint.Secret, time.Now(), body))
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", res.Status)
+	}
+	return nil
+}
+
+// backoff : InitialBackoff * 2^(attempts-1) 에 ±20% jitter, MaxBackoff 를 넘지 않음
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	wait := d.opts.InitialBackoff
+	for i := 1; i < attempts && wait < d.opts.MaxBackoff; i++ {
+		wait *= 2
+	}
+	wait = min(wait, d.opts.MaxBackoff)
+
+	jitter := time.Duration(float64(wait) * (rand.Float64()*0.4 - 0.2))
+	return wait + jitter
+}
+
+func (d *Dispatcher) result(result string) {
+	if d.observe != nil {
+		d.observe(result)
+	}
+}
+
+// Sign : 수신측은 같은 방식으로 계산한 값과 v1 을 비교하고, t 가 너무 오래됐으면 거절하면 됨
+func Sign(secret string, t time.Time, body []byte) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,188 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dev-jiemu/coupon-issuance-poc/pkg/cache"
+)
+
+func testOptions() Options {
+	return Options{
+		Interval:       time.Second,
+		Timeout:        time.Second,
+		MaxAttempts:    3,
+		InitialBackoff: time.Second,
+		MaxBackoff:     4 * time.Second,
+		Concurrency:    2,
+	}
+}
+
+// newTestManager : 쿠폰 1개짜리 캠페인에서 발급해서 coupon.issued 이벤트를 쌓아둠
+func newTestManager(t *testing.T, url string) *cache.CampaignManager {
+	t.Helper()
+
+	m := cache.NewCampaignManager()
+	if _, err := m.RegisterWebhook("brand", url, "secret", []string{cache.EventCouponIssued}); err != nil {
+		t.Fatalf("RegisterWebhook: %v", err)
+	}
+	now := time.Now()
+	if err := m.CreateCampaign(context.Background(), "brand", "spring", now.Add(-time.Minute), now.Add(time.Hour), 1, cache.CampaignOptions{}); err != nil {
+		t.Fatalf("CreateCampaign: %v", err)
+	}
+	if _, err := m.PublishCoupon(context.Background(), "brand", "spring", "u1", cache.IssueOptions{}); err != nil {
+		t.Fatalf("PublishCoupon: %v", err)
+	}
+	return m
+}
+
+func TestDispatcherDelivers(t *testing.T) {
+	var received atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		signature := r.Header.Get(SignatureHeader)
+		ts, _, _ := strings.Cut(strings.TrimPrefix(signature, "t="), ",")
+		unix, _ := strconv.ParseInt(ts, 10, 64)
+		if signature != Sign("secret", time.Unix(unix, 0), body) {
+			t.Errorf("signature %q does not match body", signature)
+		}
+
+		var event cache.Event
+		if err := json.Unmarshal(body, &event); err != nil || event.Type != cache.EventCouponIssued || r.Header.Get(EventHeader) != event.Type {
+			t.Errorf("event %+v (%v), header %s", event, err, r.Header.Get(EventHeader))
+		}
+		if r.Header.Get(DeliveryHeader) == "" {
+			t.Errorf("no %s header", DeliveryHeader)
+		}
+		received.Add(1)
+	}))
+	defer server.Close()
+
+	m := newTestManager(t, server.URL)
+	d := NewDispatcher(m, testOptions())
+	var results []string
+	d.SetObserver(func(result string) { results = append(results, result) })
+
+	d.dispatch(context.Background(), time.Now())
+	if received.Load() != 1 || len(results) != 1 || results[0] != ResultOK {
+		t.Fatalf("received %d, results %v", received.Load(), results)
+	}
+
+	// 성공한 delivery 는 다시 보내지 않음
+	d.dispatch(context.Background(), time.Now().Add(time.Hour))
+	if received.Load() != 1 {
+		t.Errorf("delivery was sent again")
+	}
+}
+
+// 한 tick 에 꺼내는 양(Concurrency*4)보다 많이 밀려 있으면 다음 tick 을 기다리지 않고 이어서 보냄
+func TestDispatcherDrainsBacklog(t *testing.T) {
+	var received atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.Add(1)
+	}))
+	defer server.Close()
+
+	opts := testOptions()
+	backlog := opts.Concurrency*4*2 + 3
+
+	m := cache.NewCampaignManager()
+	if _, err := m.RegisterWebhook("brand", server.URL, "secret", []string{cache.EventCouponIssued}); err != nil {
+		t.Fatalf("RegisterWebhook: %v", err)
+	}
+	now := time.Now()
+	if err := m.CreateCampaign(context.Background(), "brand", "spring", now.Add(-time.Minute), now.Add(time.Hour), int64(backlog), cache.CampaignOptions{}); err != nil {
+		t.Fatalf("CreateCampaign: %v", err)
+	}
+	for i := 0; i < backlog; i++ {
+		if _, err := m.PublishCoupon(context.Background(), "brand", "spring", "u"+strconv.Itoa(i), cache.IssueOptions{}); err != nil {
+			t.Fatalf("PublishCoupon: %v", err)
+		}
+	}
+
+	NewDispatcher(m, opts).dispatch(context.Background(), time.Now())
+	if got := int(received.Load()); got != backlog {
+		t.Fatalf("received %d deliveries in one tick, want %d", got, backlog)
+	}
+	if due, _ := m.DueDeliveries(time.Now(), backlog); len(due) != 0 {
+		t.Fatalf("%d deliveries left in outbox", len(due))
+	}
+}
+
+func TestDispatcherRetriesThenDeadLetter(t *testing.T) {
+	var received atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	m := newTestManager(t, server.URL)
+	opts := testOptions()
+	d := NewDispatcher(m, opts)
+	var results []string
+	d.SetObserver(func(result string) { results = append(results, result) })
+
+	now := time.Now()
+	for i := 0; i < opts.MaxAttempts+1; i++ {
+		d.dispatch(context.Background(), now.Add(time.Duration(i)*time.Hour))
+	}
+
+	if int(received.Load()) != opts.MaxAttempts {
+		t.Errorf("sent %d times, want %d", received.Load(), opts.MaxAttempts)
+	}
+	if strings.Join(results, ",") != "retry,retry,dead" {
+		t.Errorf("results = %v", results)
+	}
+	dead := m.DeadLetters("brand")
+	if len(dead) != 1 || dead[0].Attempts != opts.MaxAttempts || !strings.Contains(dead[0].LastError, "503") {
+		t.Errorf("DeadLetters = %+v", dead)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	d := NewDispatcher(nil, testOptions())
+
+	tests := []struct {
+		attempts int
+		wait     time.Duration // jitter 전
+	}{
+		{attempts: 1, wait: time.Second},
+		{attempts: 2, wait: 2 * time.Second},
+		{attempts: 3, wait: 4 * time.Second},
+		{attempts: 10, wait: 4 * time.Second}, // MaxBackoff
+	}
+
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.attempts), func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				got := d.backoff(tt.attempts)
+				if got < tt.wait*8/10 || got > tt.wait*12/10 {
+					t.Fatalf("backoff(%d) = %s, want %s ±20%%", tt.attempts, got, tt.wait)
+				}
+			}
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	at := time.Unix(1747000000, 0)
+	body := []byte(`{"type":"coupon.issued"}`)
+
+	signature := Sign("secret", at, body)
+	if !strings.HasPrefix(signature, "t=1747000000,v1=") {
+		t.Errorf("Sign = %s", signature)
+	}
+	if Sign("other", at, body) == signature || Sign("secret", at.Add(time.Second), body) == signature {
+		t.Errorf("signature does not depend on secret and timestamp")
+	}
+}